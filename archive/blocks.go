@@ -0,0 +1,123 @@
+package archive
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	dbImport = "import"
+)
+
+var (
+	ErrExportInterrupted = errors.New("export interrupted")
+	ErrImportInterrupted = errors.New("import interrupted")
+)
+
+type blockGetter interface {
+	GetBlockByNumber(uint64, bool) (*types.Block, bool)
+}
+
+// ExportBlocks writes the blocks in the range to the output as the concatenated RLP-encoded blocks,
+// without the metadata of the backup. It's the format of the block files of geth
+func ExportBlocks(chain blockGetter, output io.Writer, from, to uint64) error {
+	shutdownCh := common.GetTerminationSignalCh()
+
+	for num := from; num <= to; num++ {
+		select {
+		case <-shutdownCh:
+			return ErrExportInterrupted
+		default:
+		}
+
+		block, ok := chain.GetBlockByNumber(num, true)
+		if !ok {
+			return fmt.Errorf("block %d not found", num)
+		}
+
+		if _, err := output.Write(block.MarshalRLP()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ImportBlocks reads the concatenated RLP-encoded blocks from the input and writes them to the chain,
+// up to the given block if any. The blocks the chain already has are skipped,
+// so an interrupted import is resumed by running it again on the same input.
+// It returns the numbers of the first and the last written blocks, zero if none was written
+func ImportBlocks(
+	chain blockchainInterface,
+	input io.Reader,
+	to *uint64,
+	progression *progress.ProgressionWrapper,
+) (uint64, uint64, error) {
+	shutdownCh := common.GetTerminationSignalCh()
+	blockStream := newBlockStream(input)
+
+	var first, last uint64
+
+	defer func() {
+		if first != 0 {
+			progression.StopProgression()
+		}
+	}()
+
+	for {
+		select {
+		case <-shutdownCh:
+			return first, last, ErrImportInterrupted
+		default:
+		}
+
+		block, err := blockStream.nextBlock()
+		if err != nil {
+			return first, last, err
+		}
+
+		if block == nil || (to != nil && block.Number() > *to) {
+			return first, last, nil
+		}
+
+		if block.Number() == 0 {
+			if err := verifyGenesis(chain, block); err != nil {
+				return first, last, err
+			}
+
+			continue
+		}
+
+		// skip the blocks written by the previous run
+		if chain.GetHashByNumber(block.Number()) == block.Hash() {
+			continue
+		}
+
+		if first == 0 {
+			first = block.Number()
+
+			progression.StartProgression(first, chain.SubscribeEvents())
+
+			if to != nil {
+				progression.UpdateHighestProgression(*to)
+			}
+		}
+
+		if err := chain.VerifyFinalizedBlock(block); err != nil {
+			return first, last, fmt.Errorf("unable to verify block %d, %w", block.Number(), err)
+		}
+
+		if err := chain.WriteBlock(block, dbImport); err != nil {
+			return first, last, fmt.Errorf("unable to write block %d, %w", block.Number(), err)
+		}
+
+		progression.UpdateCurrentProgression(block.Number())
+
+		last = block.Number()
+	}
+}
@@ -0,0 +1,171 @@
+package archive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestBlocksFile(blocks ...*types.Block) *bytes.Buffer {
+	var buf bytes.Buffer
+
+	for _, b := range blocks {
+		buf.Write(b.MarshalRLP())
+	}
+
+	return &buf
+}
+
+func TestExportBlocks(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to uint64
+		output   []byte
+		err      error
+	}{
+		{
+			name:   "should write all blocks in range",
+			from:   1,
+			to:     3,
+			output: newTestBlocksFile(blocks[0], blocks[1], blocks[2]).Bytes(),
+		},
+		{
+			name:   "should write part of chain",
+			from:   2,
+			to:     2,
+			output: newTestBlocksFile(blocks[1]).Bytes(),
+		},
+		{
+			name:   "should return error if block is missing",
+			from:   3,
+			to:     4,
+			output: newTestBlocksFile(blocks[2]).Bytes(),
+			err:    errors.New("block 4 not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := &mockChain{
+				genesis: genesis,
+				blocks:  blocks,
+			}
+
+			var output bytes.Buffer
+
+			err := ExportBlocks(chain, &output, tt.from, tt.to)
+
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, tt.output, output.Bytes())
+		})
+	}
+}
+
+func TestImportBlocks(t *testing.T) {
+	uint64Ptr := func(v uint64) *uint64 {
+		return &v
+	}
+
+	tests := []struct {
+		name   string
+		blocks []*types.Block
+		chain  *mockChain
+		to     *uint64
+		// result
+		first, last uint64
+		chainBlocks []*types.Block
+		err         error
+	}{
+		{
+			name:        "should write all blocks",
+			blocks:      []*types.Block{genesis, blocks[0], blocks[1], blocks[2]},
+			chain:       &mockChain{genesis: genesis},
+			first:       1,
+			last:        3,
+			chainBlocks: []*types.Block{blocks[0], blocks[1], blocks[2]},
+		},
+		{
+			name:        "should resume after existing blocks",
+			blocks:      []*types.Block{genesis, blocks[0], blocks[1], blocks[2]},
+			chain:       &mockChain{genesis: genesis, blocks: []*types.Block{blocks[0], blocks[1]}},
+			first:       3,
+			last:        3,
+			chainBlocks: []*types.Block{blocks[0], blocks[1], blocks[2]},
+		},
+		{
+			name:        "should stop at the end of range",
+			blocks:      []*types.Block{blocks[0], blocks[1], blocks[2]},
+			chain:       &mockChain{genesis: genesis},
+			to:          uint64Ptr(2),
+			first:       1,
+			last:        2,
+			chainBlocks: []*types.Block{blocks[0], blocks[1]},
+		},
+		{
+			name:        "should write nothing if chain has all blocks",
+			blocks:      []*types.Block{blocks[0], blocks[1]},
+			chain:       &mockChain{genesis: genesis, blocks: []*types.Block{blocks[0], blocks[1]}},
+			chainBlocks: []*types.Block{blocks[0], blocks[1]},
+		},
+		{
+			name:   "should return error in case of genesis mismatch",
+			blocks: []*types.Block{genesis, blocks[0], blocks[1]},
+			chain:  &mockChain{genesis: blocks[0]},
+			err: fmt.Errorf(
+				"the hash of genesis block (%s) does not match blockchain genesis (%s)",
+				genesis.Hash(),
+				blocks[0].Hash(),
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := newTestBlocksFile(tt.blocks...)
+
+			first, last, err := ImportBlocks(
+				tt.chain,
+				input,
+				tt.to,
+				progress.NewProgressionWrapper(progress.ChainSyncRestore),
+			)
+
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, tt.first, first)
+			assert.Equal(t, tt.last, last)
+			assert.Equal(t, tt.chainBlocks, tt.chain.blocks)
+		})
+	}
+}
+
+func TestImportBlocks_Gzip(t *testing.T) {
+	var compressed bytes.Buffer
+
+	writer := gzip.NewWriter(&compressed)
+
+	assert.NoError(t, ExportBlocks(&mockChain{blocks: blocks}, writer, 1, 3))
+	assert.NoError(t, writer.Close())
+
+	reader, err := gzip.NewReader(&compressed)
+	assert.NoError(t, err)
+
+	chain := &mockChain{genesis: genesis}
+
+	first, last, err := ImportBlocks(
+		chain,
+		reader,
+		nil,
+		progress.NewProgressionWrapper(progress.ChainSyncRestore),
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), first)
+	assert.Equal(t, uint64(3), last)
+	assert.Equal(t, blocks, chain.blocks)
+}
@@ -125,12 +125,8 @@ func consumeCommonBlocks(
 		}
 
 		if block.Number() == 0 {
-			if block.Hash() != chain.Genesis() {
-				return nil, fmt.Errorf(
-					"the hash of genesis block (%s) does not match blockchain genesis (%s)",
-					block.Hash(),
-					chain.Genesis(),
-				)
+			if err := verifyGenesis(chain, block); err != nil {
+				return nil, err
 			}
 
 			continue
@@ -148,6 +144,19 @@ func consumeCommonBlocks(
 	}
 }
 
+// verifyGenesis checks the genesis block in the stream is the genesis of the chain
+func verifyGenesis(chain blockchainInterface, block *types.Block) error {
+	if block.Hash() != chain.Genesis() {
+		return fmt.Errorf(
+			"the hash of genesis block (%s) does not match blockchain genesis (%s)",
+			block.Hash(),
+			chain.Genesis(),
+		)
+	}
+
+	return nil
+}
+
 // blockStream parse RLP-encoded block from stream and consumed the used bytes
 type blockStream struct {
 	input  io.Reader
@@ -219,7 +228,7 @@ func (b *blockStream) loadRLPArray() (uint64, error) {
 // loadRLPPrefix loads first byte of RLP encoded data from input
 func (b *blockStream) loadRLPPrefix() (byte, error) {
 	buf := b.buffer[:1]
-	if _, err := b.input.Read(buf); err != nil {
+	if _, err := io.ReadFull(b.input, buf); err != nil {
 		return 0, err
 	}
 
@@ -241,9 +250,9 @@ func (b *blockStream) loadPrefixSize(offset uint64, prefix byte) (uint64, uint64
 
 		b.reserveCap(offset + payloadSizeSize)
 		payloadSizeBytes := b.buffer[offset : offset+payloadSizeSize]
-		n, err := b.input.Read(payloadSizeBytes)
+		n, err := io.ReadFull(b.input, payloadSizeBytes)
 
-		if err != nil {
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
 			return 0, 0, err
 		}
 
@@ -265,7 +274,7 @@ func (b *blockStream) loadPayload(offset uint64, size uint64) error {
 	b.reserveCap(offset + size)
 	buf := b.buffer[offset : offset+size]
 
-	if _, err := b.input.Read(buf); err != nil {
+	if _, err := io.ReadFull(b.input, buf); err != nil {
 		return err
 	}
 
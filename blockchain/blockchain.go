@@ -1,22 +1,26 @@
 package blockchain
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
 	"path/filepath"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain/storage"
 	"github.com/0xPolygon/polygon-edge/blockchain/storage/leveldb"
 	"github.com/0xPolygon/polygon-edge/blockchain/storage/memory"
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/0xPolygon/polygon-edge/types/buildroot"
 
+	"github.com/armon/go-metrics"
 	"github.com/hashicorp/go-hclog"
 	lru "github.com/hashicorp/golang-lru"
 )
@@ -24,8 +28,12 @@ import (
 const (
 	BlockGasTargetDivisor uint64 = 1024 // The bound divisor of the gas limit, used in update calculations
 	defaultCacheSize      int    = 100  // The default size for Blockchain LRU cache structures
+
+	blockchainMetrics = "blockchain"
 )
 
+var otelTracer = tracing.Tracer("blockchain")
+
 var (
 	ErrNoBlock              = errors.New("no block data passed in")
 	ErrParentNotFound       = errors.New("parent block not found")
@@ -49,6 +57,8 @@ type Blockchain struct {
 	executor  Executor
 	txSigner  TxSigner
 
+	chainParams ChainParameters // The chain parameters governed on chain, nil if not enabled
+
 	config  *chain.Chain // Config containing chain information
 	genesis types.Hash   // The hash of the genesis block
 
@@ -91,6 +101,12 @@ type Verifier interface {
 	PreCommitState(header *types.Header, txn *state.Transition) error
 }
 
+// ChainParameters is the interface of the chain parameters governed on chain
+type ChainParameters interface {
+	// BlockGasTarget returns the block gas target in the state of the given block, false if not set
+	BlockGasTarget(*types.Header) (uint64, bool, error)
+}
+
 // 	TODO: this should be part of Verifier (consensus)
 type Executor interface {
 	ProcessBlock(parentRoot types.Hash, block *types.Block, blockCreator types.Address) (*state.Transition, error)
@@ -316,6 +332,11 @@ func (b *Blockchain) SetConsensus(c Verifier) {
 	b.consensus = c
 }
 
+// SetChainParameters sets the chain parameters governed on chain
+func (b *Blockchain) SetChainParameters(p ChainParameters) {
+	b.chainParams = p
+}
+
 // setCurrentHeader sets the current header
 func (b *Blockchain) setCurrentHeader(h *types.Header, diff *big.Int) {
 	// Update the header (atomic)
@@ -379,14 +400,35 @@ func (b *Blockchain) CalculateGasLimit(number uint64) (uint64, error) {
 		return 0, fmt.Errorf("parent of block %d not found", number)
 	}
 
-	return b.calculateGasLimit(parent.GasLimit), nil
+	blockGasTarget, err := b.getBlockGasTarget(parent)
+	if err != nil {
+		return 0, err
+	}
+
+	return calculateGasLimit(parent.GasLimit, blockGasTarget), nil
+}
+
+// getBlockGasTarget returns the block gas target for the next block after parent,
+// the one governed on chain takes precedence over the one in the chain config
+func (b *Blockchain) getBlockGasTarget(parent *types.Header) (uint64, error) {
+	if b.chainParams != nil {
+		blockGasTarget, ok, err := b.chainParams.BlockGasTarget(parent)
+		if err != nil {
+			return 0, fmt.Errorf("unable to get block gas target of block %d, %w", parent.Number+1, err)
+		}
+
+		if ok {
+			return blockGasTarget, nil
+		}
+	}
+
+	return b.Config().BlockGasTarget, nil
 }
 
 // calculateGasLimit calculates gas limit in reference to the block gas target
-func (b *Blockchain) calculateGasLimit(parentGasLimit uint64) uint64 {
+func calculateGasLimit(parentGasLimit, blockGasTarget uint64) uint64 {
 	// The gas limit cannot move more than 1/1024 * parentGasLimit
 	// in either direction per block
-	blockGasTarget := b.Config().BlockGasTarget
 
 	// Check if the gas limit target has been set
 	if blockGasTarget == 0 {
@@ -686,6 +728,8 @@ func (b *Blockchain) VerifyPotentialBlock(block *types.Block) error {
 // VerifyFinalizedBlock verifies that the block is valid by performing a series of checks.
 // It is assumed that the block status is sealed (committed)
 func (b *Blockchain) VerifyFinalizedBlock(block *types.Block) error {
+	defer metrics.MeasureSince([]string{blockchainMetrics, "block_verification_time"}, time.Now())
+
 	// Make sure the consensus layer verifies this block header
 	if err := b.consensus.VerifyHeader(block.Header); err != nil {
 		return fmt.Errorf("failed to verify the header: %w", err)
@@ -877,7 +921,15 @@ func (b *Blockchain) executeBlockTransactions(block *types.Block) (*BlockResult,
 
 // WriteBlock writes a single block to the local blockchain.
 // It doesn't do any kind of verification, only commits the block to the DB
-func (b *Blockchain) WriteBlock(block *types.Block, source string) error {
+func (b *Blockchain) WriteBlock(block *types.Block, source string) (err error) {
+	_, span := otelTracer.Start(context.Background(), "Blockchain.WriteBlock")
+	span.SetAttributes(tracing.Block(block.Header)...)
+	span.SetAttributes(tracing.TxHashes(block.Transactions))
+
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
 	b.writeLock.Lock()
 	defer b.writeLock.Unlock()
 
@@ -887,6 +939,7 @@ func (b *Blockchain) WriteBlock(block *types.Block, source string) error {
 		return nil
 	}
 
+	start := time.Now()
 	header := block.Header
 
 	if err := b.writeBody(block); err != nil {
@@ -936,9 +989,18 @@ func (b *Blockchain) WriteBlock(block *types.Block, source string) error {
 
 	b.logger.Info("new block", logArgs...)
 
+	b.updateWriteMetrics(block, start)
+
 	return nil
 }
 
+// updateWriteMetrics updates the metrics of the written block
+func (b *Blockchain) updateWriteMetrics(block *types.Block, start time.Time) {
+	metrics.MeasureSince([]string{blockchainMetrics, "block_write_time"}, start)
+	metrics.SetGauge([]string{blockchainMetrics, "block_height"}, float32(b.Header().Number))
+	metrics.IncrCounter([]string{blockchainMetrics, "transactions"}, float32(len(block.Transactions)))
+}
+
 // extractBlockReceipts extracts the receipts from the passed in block
 func (b *Blockchain) extractBlockReceipts(block *types.Block) ([]*types.Receipt, error) {
 	// Check the cache for the block receipts
@@ -1299,6 +1361,10 @@ func (b *Blockchain) handleReorg(
 	evnt.Type = EventReorg
 	evnt.SetDifficulty(diff)
 
+	// the old chain is the blocks removed from the canonical chain
+	metrics.IncrCounter([]string{blockchainMetrics, "reorgs"}, 1)
+	metrics.SetGauge([]string{blockchainMetrics, "reorg_depth"}, float32(len(oldChain)))
+
 	return nil
 }
 
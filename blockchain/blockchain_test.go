@@ -898,10 +898,19 @@ func TestBlockchainReadBody(t *testing.T) {
 	assert.Equal(t, addr, readBody.Transactions[0].From)
 }
 
+type mockChainParameters struct {
+	blockGasTarget uint64
+}
+
+func (m *mockChainParameters) BlockGasTarget(*types.Header) (uint64, bool, error) {
+	return m.blockGasTarget, true, nil
+}
+
 func TestCalculateGasLimit(t *testing.T) {
 	tests := []struct {
 		name             string
 		blockGasTarget   uint64
+		governedTarget   uint64
 		parentGasLimit   uint64
 		expectedGasLimit uint64
 	}{
@@ -935,6 +944,13 @@ func TestCalculateGasLimit(t *testing.T) {
 			parentGasLimit:   25000000,
 			expectedGasLimit: 25000000 - 25000000/1024 + 100,
 		},
+		{
+			name:             "should move next gas limit towards the target governed on chain",
+			blockGasTarget:   25000000,
+			governedTarget:   15000000,
+			parentGasLimit:   20000000,
+			expectedGasLimit: 20000000 - 20000000/1024,
+		},
 	}
 
 	for _, tt := range tests {
@@ -959,6 +975,12 @@ func TestCalculateGasLimit(t *testing.T) {
 				BlockGasTarget: tt.blockGasTarget,
 			}
 
+			if tt.governedTarget != 0 {
+				b.SetChainParameters(&mockChainParameters{
+					blockGasTarget: tt.governedTarget,
+				})
+			}
+
 			nextGas, err := b.CalculateGasLimit(1)
 			assert.NoError(t, err)
 			assert.Equal(t, tt.expectedGasLimit, nextGas)
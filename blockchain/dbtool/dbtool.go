@@ -0,0 +1,59 @@
+// Package dbtool holds the offline tools inspecting and repairing
+// the blockchain database and the trie store of a stopped node
+package dbtool
+
+import (
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// maxIssues is the maximum number of the reported issues,
+// a corrupted database could have an issue for every block
+const maxIssues = 100
+
+// TrieStorage is the store of the state tries
+type TrieStorage interface {
+	Get(k []byte) ([]byte, bool)
+
+	// Iterate calls the function for every key-value pair in the store,
+	// the iteration stops once the function returns false
+	Iterate(fn func(k, v []byte) bool) error
+}
+
+// stateExists checks whether the state with the given root is in the trie store
+func stateExists(trie TrieStorage, root types.Hash) bool {
+	// the root of the empty state isn't stored
+	if root == types.EmptyRootHash {
+		return true
+	}
+
+	_, ok := trie.Get(root.Bytes())
+
+	return ok
+}
+
+// issues collects the issues found in the database
+type issues struct {
+	list    []string
+	omitted int
+}
+
+func (i *issues) add(format string, args ...interface{}) {
+	if len(i.list) >= maxIssues {
+		i.omitted++
+
+		return
+	}
+
+	i.list = append(i.list, fmt.Sprintf(format, args...))
+}
+
+// result returns the reported issues
+func (i *issues) result() []string {
+	if i.omitted == 0 {
+		return i.list
+	}
+
+	return append(i.list, fmt.Sprintf("%d more issues omitted", i.omitted))
+}
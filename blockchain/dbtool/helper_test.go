@@ -0,0 +1,118 @@
+package dbtool
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/memory"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/types/buildroot"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+// mockTrie is the trie store with the given keys
+type mockTrie map[string][]byte
+
+func (m mockTrie) Get(k []byte) ([]byte, bool) {
+	v, ok := m[hex.EncodeToHex(k)]
+
+	return v, ok
+}
+
+func (m mockTrie) Iterate(fn func(k, v []byte) bool) error {
+	for k, v := range m {
+		if !fn(hex.MustDecodeHex(k), v) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// stateRoot returns the state root of the test block
+func stateRoot(num uint64) types.Hash {
+	return types.BytesToHash(new(big.Int).SetUint64(num + 1).Bytes())
+}
+
+// newTestChain writes the canonical chain with the given number of blocks after the genesis,
+// every block has one transaction. The trie store has the state roots of all the blocks
+func newTestChain(t *testing.T, blocks uint64) (*storage.KeyValueStorage, mockTrie, []*types.Block) {
+	t.Helper()
+
+	s, err := memory.NewMemoryStorage(hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	db, ok := s.(*storage.KeyValueStorage)
+	require.True(t, ok)
+
+	trie := mockTrie{}
+	chain := make([]*types.Block, 0, blocks+1)
+
+	var (
+		parentHash types.Hash
+		td         = big.NewInt(0)
+	)
+
+	for num := uint64(0); num <= blocks; num++ {
+		header := &types.Header{
+			Number:       num,
+			ParentHash:   parentHash,
+			Difficulty:   num + 1,
+			StateRoot:    stateRoot(num),
+			Sha3Uncles:   types.EmptyUncleHash,
+			TxRoot:       types.EmptyRootHash,
+			ReceiptsRoot: types.EmptyRootHash,
+		}
+
+		block := &types.Block{Header: header}
+		receipts := []*types.Receipt{}
+
+		if num > 0 {
+			tx := &types.Transaction{
+				Nonce:    num - 1,
+				GasPrice: big.NewInt(1),
+				Gas:      21000,
+				To:       &types.ZeroAddress,
+				Value:    big.NewInt(1),
+				V:        big.NewInt(27),
+				R:        big.NewInt(1),
+				S:        big.NewInt(1),
+			}
+			tx.ComputeHash()
+
+			receipt := &types.Receipt{
+				CumulativeGasUsed: 21000,
+				GasUsed:           21000,
+				TxHash:            tx.Hash,
+			}
+			receipt.SetStatus(types.ReceiptSuccess)
+
+			block.Transactions = []*types.Transaction{tx}
+			receipts = append(receipts, receipt)
+			header.TxRoot = buildroot.CalculateTransactionsRoot(block.Transactions)
+			header.ReceiptsRoot = buildroot.CalculateReceiptsRoot(receipts)
+			header.GasUsed = 21000
+		}
+
+		header.ComputeHash()
+
+		td = new(big.Int).Add(td, new(big.Int).SetUint64(header.Difficulty))
+
+		require.NoError(t, db.WriteCanonicalHeader(header, td))
+
+		if num > 0 {
+			require.NoError(t, db.WriteBody(header.Hash, block.Body()))
+			require.NoError(t, db.WriteReceipts(header.Hash, receipts))
+			require.NoError(t, db.WriteTxLookup(block.Transactions[0].Hash, header.Hash))
+		}
+
+		trie[hex.EncodeToHex(header.StateRoot.Bytes())] = []byte{0x1}
+		chain = append(chain, block)
+		parentHash = header.Hash
+	}
+
+	return db, trie, chain
+}
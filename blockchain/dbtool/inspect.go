@@ -0,0 +1,231 @@
+package dbtool
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// codePrefix is the key prefix of the contract code in the trie store
+var codePrefix = []byte("code")
+
+// keyPrefixes are the known key prefixes of the blockchain database
+var keyPrefixes = []struct {
+	name   string
+	prefix []byte
+}{
+	{"headers", storage.HEADER},
+	{"bodies", storage.BODY},
+	{"receipts", storage.RECEIPTS},
+	{"total difficulty", storage.DIFFICULTY},
+	{"canonical", storage.CANONICAL},
+	{"tx lookups", storage.TX_LOOKUP_PREFIX},
+	{"head", storage.HEAD},
+	{"forks", storage.FORK},
+	{"snapshots", storage.SNAPSHOTS},
+}
+
+// PrefixStats is the number and the size of the entries under the key prefix
+type PrefixStats struct {
+	Name    string `json:"name"`
+	Entries uint64 `json:"entries"`
+	Size    uint64 `json:"size"`
+}
+
+func (s *PrefixStats) add(k, v []byte) {
+	s.Entries++
+	s.Size += uint64(len(k) + len(v))
+}
+
+// InspectReport is the result of the database inspection
+type InspectReport struct {
+	// Prefixes are the stats of the key prefixes of the blockchain database
+	Prefixes []*PrefixStats `json:"prefixes"`
+
+	// Trie are the stats of the trie nodes and the contract code in the trie store
+	Trie []*PrefixStats `json:"trie"`
+
+	HeadNumber uint64     `json:"headNumber"`
+	HeadHash   types.Hash `json:"headHash"`
+
+	// Issues are the inconsistencies of the head, the canonical chain and the total difficulty
+	Issues []string `json:"issues"`
+}
+
+// Inspect reports the sizes of the key prefixes and checks the head,
+// the canonical chain and the total difficulty are consistent
+func Inspect(db *storage.KeyValueStorage, trie TrieStorage) (*InspectReport, error) {
+	report := &InspectReport{}
+
+	var err error
+
+	if report.Prefixes, err = blockchainStats(db); err != nil {
+		return nil, err
+	}
+
+	if report.Trie, err = trieStats(trie); err != nil {
+		return nil, err
+	}
+
+	found := &issues{}
+	report.HeadNumber, report.HeadHash = checkChain(db, trie, found)
+	report.Issues = found.result()
+
+	return report, nil
+}
+
+// blockchainStats returns the stats of the key prefixes of the blockchain database
+func blockchainStats(db *storage.KeyValueStorage) ([]*PrefixStats, error) {
+	stats := make([]*PrefixStats, len(keyPrefixes))
+	for i, keyPrefix := range keyPrefixes {
+		stats[i] = &PrefixStats{Name: keyPrefix.name}
+	}
+
+	unknown := &PrefixStats{Name: "unknown"}
+
+	err := db.Iterate(func(k, v []byte) bool {
+		for i, keyPrefix := range keyPrefixes {
+			if bytes.HasPrefix(k, keyPrefix.prefix) {
+				stats[i].add(k, v)
+
+				return true
+			}
+		}
+
+		unknown.add(k, v)
+
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to iterate blockchain database, %w", err)
+	}
+
+	if unknown.Entries > 0 {
+		stats = append(stats, unknown)
+	}
+
+	return stats, nil
+}
+
+// trieStats returns the stats of the trie nodes and the contract code in the trie store
+func trieStats(trie TrieStorage) ([]*PrefixStats, error) {
+	nodes := &PrefixStats{Name: "trie nodes"}
+	code := &PrefixStats{Name: "contract code"}
+
+	err := trie.Iterate(func(k, v []byte) bool {
+		if bytes.HasPrefix(k, codePrefix) {
+			code.add(k, v)
+		} else {
+			nodes.add(k, v)
+		}
+
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to iterate trie store, %w", err)
+	}
+
+	return []*PrefixStats{nodes, code}, nil
+}
+
+// checkChain walks the canonical chain up to the head and reports the inconsistencies,
+// it returns the number and the hash of the head
+func checkChain(db *storage.KeyValueStorage, trie TrieStorage, found *issues) (uint64, types.Hash) {
+	headHash, ok := db.ReadHeadHash()
+	if !ok {
+		found.add("head hash not found")
+	}
+
+	headNumber, ok := db.ReadHeadNumber()
+	if !ok {
+		found.add("head number not found")
+
+		return 0, headHash
+	}
+
+	if canonicalHash, ok := db.ReadCanonicalHash(headNumber); !ok || canonicalHash != headHash {
+		found.add("head hash %s is not the canonical hash of block %d", headHash, headNumber)
+	}
+
+	var (
+		parentHash *types.Hash
+		parentTD   = big.NewInt(0)
+	)
+
+	for num := uint64(0); num <= headNumber; num++ {
+		hash, header := readCanonicalHeader(db, num, found)
+		if header == nil {
+			parentHash, parentTD = nil, nil
+
+			continue
+		}
+
+		if parentHash != nil && header.ParentHash != *parentHash {
+			found.add("parent hash of block %d is %s, expected %s", num, header.ParentHash, parentHash)
+		}
+
+		td, ok := db.ReadTotalDifficulty(hash)
+
+		switch {
+		case !ok:
+			found.add("total difficulty of block %d not found", num)
+		case parentTD != nil:
+			expected := new(big.Int).Add(parentTD, new(big.Int).SetUint64(header.Difficulty))
+			if td.Cmp(expected) != 0 {
+				found.add("total difficulty of block %d is %s, expected %s", num, td, expected)
+			}
+		}
+
+		parentHash, parentTD = &hash, td
+
+		if num == headNumber && !stateExists(trie, header.StateRoot) {
+			found.add("state root %s of head block %d not found in trie store", header.StateRoot, num)
+		}
+	}
+
+	if dangling := countCanonicalAbove(db, headNumber); dangling > 0 {
+		found.add("%d canonical hashes above head block %d", dangling, headNumber)
+	}
+
+	return headNumber, headHash
+}
+
+// readCanonicalHeader reads the header of the canonical block,
+// the header is nil if the canonical hash or the header is missing
+func readCanonicalHeader(db *storage.KeyValueStorage, num uint64, found *issues) (types.Hash, *types.Header) {
+	hash, ok := db.ReadCanonicalHash(num)
+	if !ok {
+		found.add("canonical hash of block %d not found", num)
+
+		return hash, nil
+	}
+
+	header, err := db.ReadHeader(hash)
+	if err != nil {
+		found.add("header %s of block %d not found: %v", hash, num, err)
+
+		return hash, nil
+	}
+
+	if header.Number != num {
+		found.add("header %s of block %d has number %d", hash, num, header.Number)
+	}
+
+	return hash, header
+}
+
+// countCanonicalAbove returns the number of the canonical hashes above the block
+func countCanonicalAbove(db *storage.KeyValueStorage, num uint64) uint64 {
+	count := uint64(0)
+
+	for {
+		if _, ok := db.ReadCanonicalHash(num + count + 1); !ok {
+			return count
+		}
+
+		count++
+	}
+}
@@ -0,0 +1,130 @@
+package dbtool
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInspect(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name           string
+		corrupt        func(*storage.KeyValueStorage, mockTrie, []*types.Block)
+		expectedIssues []string
+	}{
+		{
+			"consistent chain",
+			func(*storage.KeyValueStorage, mockTrie, []*types.Block) {},
+			nil,
+		},
+		{
+			"head hash is not canonical",
+			func(db *storage.KeyValueStorage, _ mockTrie, _ []*types.Block) {
+				_ = db.WriteHeadHash(types.ZeroHash)
+			},
+			[]string{
+				"head hash " + types.ZeroHash.String() + " is not the canonical hash of block 3",
+			},
+		},
+		{
+			"missing canonical hash",
+			func(db *storage.KeyValueStorage, _ mockTrie, _ []*types.Block) {
+				_ = db.DeleteCanonicalHash(1)
+			},
+			[]string{
+				"canonical hash of block 1 not found",
+			},
+		},
+		{
+			"wrong total difficulty",
+			func(db *storage.KeyValueStorage, _ mockTrie, chain []*types.Block) {
+				_ = db.WriteTotalDifficulty(chain[2].Hash(), big.NewInt(100))
+			},
+			[]string{
+				"total difficulty of block 2 is 100, expected 6",
+				"total difficulty of block 3 is 10, expected 104",
+			},
+		},
+		{
+			"canonical hash above head",
+			func(db *storage.KeyValueStorage, _ mockTrie, _ []*types.Block) {
+				_ = db.WriteHeadNumber(1)
+				_ = db.WriteHeadHash(mustCanonicalHash(db, 1))
+			},
+			[]string{
+				"2 canonical hashes above head block 1",
+			},
+		},
+		{
+			"missing head state",
+			func(_ *storage.KeyValueStorage, trie mockTrie, _ []*types.Block) {
+				delete(trie, hex.EncodeToHex(stateRoot(3).Bytes()))
+			},
+			[]string{
+				"state root " + stateRoot(3).String() + " of head block 3 not found in trie store",
+			},
+		},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, trie, chain := newTestChain(t, 3)
+			testCase.corrupt(db, trie, chain)
+
+			report, err := Inspect(db, trie)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.expectedIssues, report.Issues)
+		})
+	}
+}
+
+func TestInspect_PrefixStats(t *testing.T) {
+	t.Parallel()
+
+	db, trie, chain := newTestChain(t, 3)
+
+	report, err := Inspect(db, trie)
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(3), report.HeadNumber)
+	assert.Equal(t, chain[3].Hash(), report.HeadHash)
+
+	entries := map[string]uint64{}
+	for _, stats := range report.Prefixes {
+		entries[stats.Name] = stats.Entries
+	}
+
+	assert.Equal(t, map[string]uint64{
+		"headers":          4,
+		"bodies":           3,
+		"receipts":         3,
+		"total difficulty": 4,
+		"canonical":        4,
+		"tx lookups":       3,
+		"head":             2,
+		"forks":            0,
+		"snapshots":        0,
+	}, entries)
+
+	assert.Equal(t, "trie nodes", report.Trie[0].Name)
+	assert.Equal(t, uint64(4), report.Trie[0].Entries)
+	assert.Equal(t, uint64(0), report.Trie[1].Entries)
+}
+
+func mustCanonicalHash(db *storage.KeyValueStorage, num uint64) types.Hash {
+	hash, _ := db.ReadCanonicalHash(num)
+
+	return hash
+}
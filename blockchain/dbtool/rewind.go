@@ -0,0 +1,124 @@
+package dbtool
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	ErrHeadNotFound  = errors.New("head not found")
+	ErrStateNotFound = errors.New("state not found in trie store")
+)
+
+// RewindResult is the result of the head rewind
+type RewindResult struct {
+	OldHead          uint64     `json:"oldHead"`
+	NewHead          uint64     `json:"newHead"`
+	NewHeadHash      types.Hash `json:"newHeadHash"`
+	StateRoot        types.Hash `json:"stateRoot"`
+	RemovedCanonical uint64     `json:"removedCanonical"`
+	RemovedTxLookups uint64     `json:"removedTxLookups"`
+}
+
+// Rewind sets the head back to the given block and drops the canonical hashes
+// and the tx lookups of the blocks above it. The state of the new head needs
+// to be in the trie store. The headers, the bodies and the receipts are kept,
+// so the dropped blocks are synced again without downloading the kept ones
+func Rewind(db *storage.KeyValueStorage, trie TrieStorage, to uint64) (*RewindResult, error) {
+	oldHead, ok := db.ReadHeadNumber()
+	if !ok {
+		return nil, ErrHeadNotFound
+	}
+
+	if to > oldHead {
+		return nil, fmt.Errorf("block %d is beyond the head block %d", to, oldHead)
+	}
+
+	hash, ok := db.ReadCanonicalHash(to)
+	if !ok {
+		return nil, fmt.Errorf("canonical hash of block %d not found", to)
+	}
+
+	header, err := db.ReadHeader(hash)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read header %s of block %d, %w", hash, to, err)
+	}
+
+	if !stateExists(trie, header.StateRoot) {
+		return nil, fmt.Errorf("%w: state root %s of block %d", ErrStateNotFound, header.StateRoot, to)
+	}
+
+	res := &RewindResult{
+		OldHead:     oldHead,
+		NewHead:     to,
+		NewHeadHash: hash,
+		StateRoot:   header.StateRoot,
+	}
+
+	// write the new head first, so an interrupted rewind leaves only
+	// the dangling canonical hashes which are dropped by running it again
+	if err := db.WriteHeadHash(hash); err != nil {
+		return nil, fmt.Errorf("unable to write head hash, %w", err)
+	}
+
+	if err := db.WriteHeadNumber(to); err != nil {
+		return nil, fmt.Errorf("unable to write head number, %w", err)
+	}
+
+	for num := to + 1; ; num++ {
+		hash, ok := db.ReadCanonicalHash(num)
+		if !ok {
+			// the missing canonical hashes up to the old head are skipped,
+			// the ones above it are left only by an interrupted rewind
+			if num > oldHead {
+				break
+			}
+
+			continue
+		}
+
+		removed, err := deleteTxLookups(db, hash)
+		if err != nil {
+			return nil, fmt.Errorf("unable to delete tx lookups of block %d, %w", num, err)
+		}
+
+		res.RemovedTxLookups += removed
+
+		if err := db.DeleteCanonicalHash(num); err != nil {
+			return nil, fmt.Errorf("unable to delete canonical hash of block %d, %w", num, err)
+		}
+
+		res.RemovedCanonical++
+	}
+
+	return res, nil
+}
+
+// deleteTxLookups deletes the lookups of the transactions of the block,
+// the lookups pointing to another block are kept
+func deleteTxLookups(db *storage.KeyValueStorage, blockHash types.Hash) (uint64, error) {
+	body, err := db.ReadBody(blockHash)
+	if err != nil {
+		// the block without the body has no lookups
+		return 0, nil //nolint:nilerr
+	}
+
+	removed := uint64(0)
+
+	for _, tx := range body.Transactions {
+		if lookup, ok := db.ReadTxLookup(tx.Hash); !ok || lookup != blockHash {
+			continue
+		}
+
+		if err := db.DeleteTxLookup(tx.Hash); err != nil {
+			return removed, err
+		}
+
+		removed++
+	}
+
+	return removed, nil
+}
@@ -0,0 +1,114 @@
+package dbtool
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewind(t *testing.T) {
+	t.Parallel()
+
+	db, trie, chain := newTestChain(t, 5)
+
+	res, err := Rewind(db, trie, 2)
+	require.NoError(t, err)
+
+	assert.Equal(t, &RewindResult{
+		OldHead:          5,
+		NewHead:          2,
+		NewHeadHash:      chain[2].Hash(),
+		StateRoot:        stateRoot(2),
+		RemovedCanonical: 3,
+		RemovedTxLookups: 3,
+	}, res)
+
+	headNumber, _ := db.ReadHeadNumber()
+	headHash, _ := db.ReadHeadHash()
+
+	assert.Equal(t, uint64(2), headNumber)
+	assert.Equal(t, chain[2].Hash(), headHash)
+
+	for num, block := range chain {
+		_, canonical := db.ReadCanonicalHash(uint64(num))
+		lookup := false
+
+		if num > 0 {
+			_, lookup = db.ReadTxLookup(block.Transactions[0].Hash)
+		}
+
+		assert.Equal(t, num <= 2, canonical)
+		assert.Equal(t, num > 0 && num <= 2, lookup)
+
+		// the blocks are kept
+		_, err := db.ReadHeader(block.Hash())
+		assert.NoError(t, err)
+	}
+
+	// the rewound chain is consistent
+	report, err := Inspect(db, trie)
+	require.NoError(t, err)
+	assert.Empty(t, report.Issues)
+
+	// rewinding again to the head is a no-op
+	res, err = Rewind(db, trie, 2)
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(0), res.RemovedCanonical)
+	assert.Equal(t, uint64(0), res.RemovedTxLookups)
+}
+
+func TestRewind_CanonicalGap(t *testing.T) {
+	t.Parallel()
+
+	db, trie, chain := newTestChain(t, 6)
+
+	// the canonical hash of the block 3 is missing
+	require.NoError(t, db.DeleteCanonicalHash(3))
+
+	res, err := Rewind(db, trie, 1)
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(6), res.OldHead)
+	assert.Equal(t, uint64(4), res.RemovedCanonical)
+	assert.Equal(t, uint64(4), res.RemovedTxLookups)
+
+	for num := range chain {
+		_, canonical := db.ReadCanonicalHash(uint64(num))
+		assert.Equal(t, num <= 1, canonical)
+	}
+
+	// the lookups of the block without the canonical hash are kept
+	_, lookup := db.ReadTxLookup(chain[3].Transactions[0].Hash)
+	assert.True(t, lookup)
+}
+
+func TestRewind_Errors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("beyond head", func(t *testing.T) {
+		t.Parallel()
+
+		db, trie, _ := newTestChain(t, 3)
+
+		_, err := Rewind(db, trie, 4)
+		assert.EqualError(t, err, "block 4 is beyond the head block 3")
+	})
+
+	t.Run("missing state", func(t *testing.T) {
+		t.Parallel()
+
+		db, trie, _ := newTestChain(t, 3)
+		delete(trie, hex.EncodeToHex(stateRoot(1).Bytes()))
+
+		_, err := Rewind(db, trie, 1)
+		assert.True(t, errors.Is(err, ErrStateNotFound))
+
+		// the head is untouched
+		headNumber, _ := db.ReadHeadNumber()
+		assert.Equal(t, uint64(3), headNumber)
+	})
+}
@@ -0,0 +1,104 @@
+package dbtool
+
+import (
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/types/buildroot"
+)
+
+// VerifyReport is the result of the block verification
+type VerifyReport struct {
+	From    uint64 `json:"from"`
+	To      uint64 `json:"to"`
+	Checked uint64 `json:"checked"`
+
+	// Issues are the inconsistencies of the headers, the bodies and the receipts
+	Issues []string `json:"issues"`
+}
+
+// VerifyBlocks checks the headers of the canonical blocks in the range are consistent
+// with their bodies and receipts
+func VerifyBlocks(db *storage.KeyValueStorage, from, to uint64) *VerifyReport {
+	report := &VerifyReport{
+		From: from,
+		To:   to,
+	}
+
+	found := &issues{}
+
+	var parentHash *types.Hash
+
+	for num := from; num <= to; num++ {
+		hash, header := readCanonicalHeader(db, num, found)
+
+		report.Checked++
+
+		if header == nil {
+			parentHash = nil
+
+			continue
+		}
+
+		if parentHash != nil && header.ParentHash != *parentHash {
+			found.add("parent hash of block %d is %s, expected %s", num, header.ParentHash, parentHash)
+		}
+
+		parentHash = &hash
+
+		// the genesis has no body and no receipts stored
+		if num > 0 {
+			verifyBlock(db, hash, header, found)
+		}
+	}
+
+	report.Issues = found.result()
+
+	return report
+}
+
+// verifyBlock checks the roots and the gas used of the header
+// against the body and the receipts of the block
+func verifyBlock(db *storage.KeyValueStorage, hash types.Hash, header *types.Header, found *issues) {
+	num := header.Number
+
+	body, err := db.ReadBody(hash)
+	if err != nil {
+		found.add("body of block %d not found: %v", num, err)
+
+		return
+	}
+
+	if root := buildroot.CalculateTransactionsRoot(body.Transactions); root != header.TxRoot {
+		found.add("transactions root of block %d is %s, expected %s", num, root, header.TxRoot)
+	}
+
+	if root := buildroot.CalculateUncleRoot(body.Uncles); root != header.Sha3Uncles {
+		found.add("uncles root of block %d is %s, expected %s", num, root, header.Sha3Uncles)
+	}
+
+	receipts, err := db.ReadReceipts(hash)
+	if err != nil {
+		found.add("receipts of block %d not found: %v", num, err)
+
+		return
+	}
+
+	if len(receipts) != len(body.Transactions) {
+		found.add("block %d has %d receipts for %d transactions", num, len(receipts), len(body.Transactions))
+
+		return
+	}
+
+	if root := buildroot.CalculateReceiptsRoot(receipts); root != header.ReceiptsRoot {
+		found.add("receipts root of block %d is %s, expected %s", num, root, header.ReceiptsRoot)
+	}
+
+	gasUsed := uint64(0)
+	if len(receipts) > 0 {
+		gasUsed = receipts[len(receipts)-1].CumulativeGasUsed
+	}
+
+	if gasUsed != header.GasUsed {
+		found.add("gas used of block %d is %d, expected %d", num, gasUsed, header.GasUsed)
+	}
+}
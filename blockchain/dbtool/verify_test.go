@@ -0,0 +1,77 @@
+package dbtool
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/types/buildroot"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyBlocks(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name           string
+		from           uint64
+		corrupt        func(*storage.KeyValueStorage, []*types.Block)
+		expectedIssues func([]*types.Block) []string
+	}{
+		{
+			"consistent chain",
+			0,
+			func(*storage.KeyValueStorage, []*types.Block) {},
+			func([]*types.Block) []string { return nil },
+		},
+		{
+			"empty body",
+			1,
+			func(db *storage.KeyValueStorage, chain []*types.Block) {
+				_ = db.WriteBody(chain[2].Hash(), &types.Body{})
+			},
+			func(chain []*types.Block) []string {
+				return []string{
+					"transactions root of block 2 is " + types.EmptyRootHash.String() +
+						", expected " + chain[2].Header.TxRoot.String(),
+					"block 2 has 1 receipts for 0 transactions",
+				}
+			},
+		},
+		{
+			"wrong receipts",
+			1,
+			func(db *storage.KeyValueStorage, chain []*types.Block) {
+				receipts, _ := db.ReadReceipts(chain[3].Hash())
+				receipts[0].CumulativeGasUsed = 42000
+				_ = db.WriteReceipts(chain[3].Hash(), receipts)
+			},
+			func(chain []*types.Block) []string {
+				receipt := &types.Receipt{CumulativeGasUsed: 42000}
+				receipt.SetStatus(types.ReceiptSuccess)
+
+				return []string{
+					"receipts root of block 3 is " + buildroot.CalculateReceiptsRoot([]*types.Receipt{receipt}).String() +
+						", expected " + chain[3].Header.ReceiptsRoot.String(),
+					"gas used of block 3 is 42000, expected 21000",
+				}
+			},
+		},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			db, _, chain := newTestChain(t, 3)
+			testCase.corrupt(db, chain)
+
+			report := VerifyBlocks(db, testCase.from, 3)
+
+			assert.Equal(t, 4-testCase.from, report.Checked)
+			assert.Equal(t, testCase.expectedIssues(chain), report.Issues)
+		})
+	}
+}
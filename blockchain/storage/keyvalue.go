@@ -55,6 +55,11 @@ type KV interface {
 	Close() error
 	Set(p []byte, v []byte) error
 	Get(p []byte) ([]byte, bool, error)
+	Delete(p []byte) error
+
+	// Iterate calls the function for every key-value pair with the key prefix,
+	// the iteration stops once the function returns false
+	Iterate(prefix []byte, fn func(k, v []byte) bool) error
 }
 
 // KeyValueStorage is a generic storage for kv databases
@@ -96,6 +101,11 @@ func (s *KeyValueStorage) WriteCanonicalHash(n uint64, hash types.Hash) error {
 	return s.set(CANONICAL, s.encodeUint(n), hash.Bytes())
 }
 
+// DeleteCanonicalHash removes the number block from the canonical chain
+func (s *KeyValueStorage) DeleteCanonicalHash(n uint64) error {
+	return s.delete(CANONICAL, s.encodeUint(n))
+}
+
 // HEAD //
 
 // ReadHeadHash returns the hash of the head
@@ -267,6 +277,19 @@ func (s *KeyValueStorage) ReadTxLookup(hash types.Hash) (types.Hash, bool) {
 	return types.BytesToHash(blockHash), true
 }
 
+// DeleteTxLookup removes the lookup of the transaction
+func (s *KeyValueStorage) DeleteTxLookup(hash types.Hash) error {
+	return s.delete(TX_LOOKUP_PREFIX, hash.Bytes())
+}
+
+// ITERATION //
+
+// Iterate calls the function for every key-value pair in the db,
+// the iteration stops once the function returns false
+func (s *KeyValueStorage) Iterate(fn func(k, v []byte) bool) error {
+	return s.db.Iterate(nil, fn)
+}
+
 // WRITE OPERATIONS //
 
 func (s *KeyValueStorage) writeRLP(p, k []byte, raw types.RLPMarshaler) error {
@@ -335,6 +358,12 @@ func (s *KeyValueStorage) set(p []byte, k []byte, v []byte) error {
 	return s.db.Set(p, v)
 }
 
+func (s *KeyValueStorage) delete(p []byte, k []byte) error {
+	p = append(p, k...)
+
+	return s.db.Delete(p)
+}
+
 func (s *KeyValueStorage) get(p []byte, k []byte) ([]byte, bool) {
 	p = append(p, k...)
 	data, ok, err := s.db.Get(p)
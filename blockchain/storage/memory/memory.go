@@ -1,6 +1,9 @@
 package memory
 
 import (
+	"bytes"
+	"sort"
+
 	"github.com/0xPolygon/polygon-edge/blockchain/storage"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/hashicorp/go-hclog"
@@ -33,6 +36,40 @@ func (m *memoryKV) Get(p []byte) ([]byte, bool, error) {
 	return v, true, nil
 }
 
+func (m *memoryKV) Delete(p []byte) error {
+	delete(m.db, hex.EncodeToHex(p))
+
+	return nil
+}
+
+func (m *memoryKV) Iterate(prefix []byte, fn func(k, v []byte) bool) error {
+	// iterate in the key order as leveldb does
+	keys := make([]string, 0, len(m.db))
+
+	for key := range m.db {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		k, err := hex.DecodeHex(key)
+		if err != nil {
+			return err
+		}
+
+		if !bytes.HasPrefix(k, prefix) {
+			continue
+		}
+
+		if !fn(k, m.db[key]) {
+			break
+		}
+	}
+
+	return nil
+}
+
 func (m *memoryKV) Close() error {
 	return nil
 }
@@ -48,6 +48,9 @@ func TestStorage(t *testing.T, m PlaceholderStorage) {
 	t.Run("", func(t *testing.T) {
 		testReceipts(t, m)
 	})
+	t.Run("", func(t *testing.T) {
+		testDeleteAndIterate(t, m)
+	})
 }
 
 func testCanonicalChain(t *testing.T, m PlaceholderStorage) {
@@ -393,6 +396,45 @@ func testReceipts(t *testing.T, m PlaceholderStorage) {
 	assert.True(t, reflect.DeepEqual(receipts, found))
 }
 
+func testDeleteAndIterate(t *testing.T, m PlaceholderStorage) {
+	t.Helper()
+
+	s, closeFn := m(t)
+	defer closeFn()
+
+	kv, ok := s.(*KeyValueStorage)
+	if !ok {
+		t.Skip("not a key-value storage")
+	}
+
+	for i := uint64(0); i < 3; i++ {
+		assert.NoError(t, kv.WriteCanonicalHash(i, types.StringToHash("1")))
+	}
+
+	assert.NoError(t, kv.WriteTxLookup(hash1, hash2))
+
+	assert.NoError(t, kv.DeleteCanonicalHash(1))
+	assert.NoError(t, kv.DeleteTxLookup(hash1))
+
+	_, ok = kv.ReadCanonicalHash(1)
+	assert.False(t, ok)
+
+	_, ok = kv.ReadTxLookup(hash1)
+	assert.False(t, ok)
+
+	numbers := []uint64{}
+
+	assert.NoError(t, kv.Iterate(func(k, v []byte) bool {
+		assert.Equal(t, CANONICAL, k[:1])
+
+		numbers = append(numbers, kv.decodeUint(k[1:]))
+
+		return true
+	}))
+
+	assert.Equal(t, []uint64{0, 2}, numbers)
+}
+
 func testWriteCanonicalHeader(t *testing.T, m PlaceholderStorage) {
 	t.Helper()
 
@@ -14,6 +14,15 @@ type Params struct {
 	Engine         map[string]interface{} `json:"engine"`
 	Whitelists     *Whitelists            `json:"whitelists,omitempty"`
 	BlockGasTarget uint64                 `json:"blockGasTarget"`
+
+	// ChainParametersContract enables the chain parameters governed by the validators
+	// in the contract predeployed in the genesis
+	ChainParametersContract bool `json:"chainParametersContract,omitempty"`
+
+	// ContractDeployerAllowList is the initial list of the accounts allowed to deploy contracts
+	ContractDeployerAllowList *AddressListConfig `json:"contractDeployerAllowList,omitempty"`
+	// TransactionsAllowList is the initial list of the accounts allowed to send transactions
+	TransactionsAllowList *AddressListConfig `json:"transactionsAllowList,omitempty"`
 }
 
 func (p *Params) GetEngine() string {
@@ -30,6 +39,14 @@ type Whitelists struct {
 	Deployment []types.Address `json:"deployment,omitempty"`
 }
 
+// AddressListConfig is the initial configuration of an address list enforced in the state
+type AddressListConfig struct {
+	// AdminAddresses are the accounts allowed to modify the list
+	AdminAddresses []types.Address `json:"adminAddresses,omitempty"`
+	// EnabledAddresses are the accounts in the list
+	EnabledAddresses []types.Address `json:"enabledAddresses,omitempty"`
+}
+
 // Forks specifies when each fork is activated
 type Forks struct {
 	Homestead      *Fork `json:"homestead,omitempty"`
@@ -8,7 +8,6 @@ import (
 	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/secrets"
-	"github.com/0xPolygon/polygon-edge/secrets/awskms"
 	"github.com/0xPolygon/polygon-edge/secrets/local"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/0xPolygon/polygon-edge/validators"
@@ -119,8 +118,12 @@ func GetValidatorsFromPrefixPath(
 }
 
 func getValidatorAddressFromSecretManager(manager secrets.SecretsManager) (types.Address, error) {
-	if k, ok := manager.(*awskms.KmsSecretManager); ok {
-		info, _ := k.GetSecretInfo(secrets.ValidatorKey)
+	if secrets.IsRemoteSigner(manager.GetSecretsManagerType()) {
+		info, err := manager.GetSecretInfo(secrets.ValidatorKey)
+		if err != nil {
+			return types.ZeroAddress, err
+		}
+
 		return types.StringToAddress(info.Address), nil
 	}
 
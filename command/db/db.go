@@ -0,0 +1,37 @@
+package db
+
+import (
+	"github.com/0xPolygon/polygon-edge/command/db/export"
+	dbimport "github.com/0xPolygon/polygon-edge/command/db/import"
+	"github.com/0xPolygon/polygon-edge/command/db/inspect"
+	"github.com/0xPolygon/polygon-edge/command/db/rewind"
+	"github.com/0xPolygon/polygon-edge/command/db/verify"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	dbCmd := &cobra.Command{
+		Use: "db",
+		Short: "Top level command for operating directly on the databases of the stopped node. " +
+			"Only accepts subcommands.",
+	}
+
+	registerSubcommands(dbCmd)
+
+	return dbCmd
+}
+
+func registerSubcommands(baseCmd *cobra.Command) {
+	baseCmd.AddCommand(
+		// db export
+		export.GetCommand(),
+		// db import
+		dbimport.GetCommand(),
+		// db inspect
+		inspect.GetCommand(),
+		// db rewind
+		rewind.GetCommand(),
+		// db verify
+		verify.GetCommand(),
+	)
+}
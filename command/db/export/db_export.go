@@ -0,0 +1,63 @@
+package export
+
+import (
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	exportCmd := &cobra.Command{
+		Use: "export",
+		Short: "Exports the blocks of the stopped node to the file of concatenated RLP-encoded blocks. " +
+			"The file is gzip compressed if its name ends with .gz",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	params.SetFlags(exportCmd)
+	setFlags(exportCmd)
+	helper.SetRequiredFlags(exportCmd, params.getRequiredFlags())
+
+	return exportCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.out,
+		outFlag,
+		"",
+		"the path to the block file",
+	)
+
+	cmd.Flags().StringVar(
+		&params.fromRaw,
+		fromFlag,
+		"0",
+		"the beginning height of the exported blocks",
+	)
+
+	cmd.Flags().StringVar(
+		&params.toRaw,
+		toFlag,
+		"",
+		"the end height of the exported blocks. If omitted, the blocks up to the latest one are exported",
+	)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.exportBlocks(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
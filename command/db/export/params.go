@@ -0,0 +1,150 @@
+package export
+
+import (
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/0xPolygon/polygon-edge/archive"
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/command"
+	dbHelper "github.com/0xPolygon/polygon-edge/command/db/helper"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	outFlag  = "out"
+	fromFlag = "from"
+	toFlag   = "to"
+)
+
+var (
+	params = &exportParams{}
+)
+
+var (
+	errDecodeRange  = errors.New("unable to decode range value")
+	errInvalidRange = errors.New(`invalid "to" value; must be >= "from"`)
+)
+
+type exportParams struct {
+	dbHelper.ChainParams
+
+	out string
+
+	fromRaw string
+	toRaw   string
+
+	from uint64
+	to   *uint64
+
+	resTo uint64
+}
+
+func (p *exportParams) validateFlags() error {
+	var parseErr error
+
+	if p.from, parseErr = types.ParseUint64orHex(&p.fromRaw); parseErr != nil {
+		return errDecodeRange
+	}
+
+	if p.toRaw != "" {
+		var parsedTo uint64
+
+		if parsedTo, parseErr = types.ParseUint64orHex(&p.toRaw); parseErr != nil {
+			return errDecodeRange
+		}
+
+		if p.from > parsedTo {
+			return errInvalidRange
+		}
+
+		p.to = &parsedTo
+	}
+
+	return nil
+}
+
+func (p *exportParams) getRequiredFlags() []string {
+	return []string{
+		dbHelper.DataDirFlag,
+		outFlag,
+	}
+}
+
+// exportBlocks writes the blocks of the stopped node to the new block file
+func (p *exportParams) exportBlocks() error {
+	offlineServer, err := p.OpenChain()
+	if err != nil {
+		return err
+	}
+
+	defer offlineServer.Close()
+
+	chain := offlineServer.Blockchain()
+
+	p.resTo = chain.Header().Number
+	if p.to != nil {
+		if *p.to > p.resTo {
+			return fmt.Errorf("the end height %d is beyond the latest block %d", *p.to, p.resTo)
+		}
+
+		p.resTo = *p.to
+	}
+
+	if p.from > p.resTo {
+		return fmt.Errorf("the beginning height %d is beyond the latest block %d", p.from, p.resTo)
+	}
+
+	// always create new file, throw error if the file exists
+	fs, err := os.OpenFile(p.out, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+
+	if err := p.writeBlocks(chain, fs); err != nil {
+		_ = fs.Close()
+		_ = os.Remove(p.out)
+
+		return err
+	}
+
+	return fs.Close()
+}
+
+// writeBlocks writes the blocks to the file, compressing them if the file is gzip
+func (p *exportParams) writeBlocks(chain *blockchain.Blockchain, fs io.Writer) error {
+	buffered := bufio.NewWriter(fs)
+
+	var output io.Writer = buffered
+
+	var compressed *gzip.Writer
+
+	if dbHelper.IsGzipFile(p.out) {
+		compressed = gzip.NewWriter(buffered)
+		output = compressed
+	}
+
+	if err := archive.ExportBlocks(chain, output, p.from, p.resTo); err != nil {
+		return err
+	}
+
+	if compressed != nil {
+		if err := compressed.Close(); err != nil {
+			return err
+		}
+	}
+
+	return buffered.Flush()
+}
+
+func (p *exportParams) getResult() command.CommandResult {
+	return &DBExportResult{
+		From: p.from,
+		To:   p.resTo,
+		Out:  p.out,
+	}
+}
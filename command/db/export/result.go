@@ -0,0 +1,29 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type DBExportResult struct {
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+	Out  string `json:"out"`
+}
+
+func (r *DBExportResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[DB EXPORT]\n")
+	buffer.WriteString("Exported blocks successfully:\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("File|%s", r.Out),
+		fmt.Sprintf("From|%d", r.From),
+		fmt.Sprintf("To|%d", r.To),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
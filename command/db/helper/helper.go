@@ -0,0 +1,176 @@
+package helper
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/leveldb"
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/keystore"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/server"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/hashicorp/go-hclog"
+	"github.com/spf13/cobra"
+)
+
+const (
+	DataDirFlag             = "data-dir"
+	ChainFlag               = "chain"
+	SecretsConfigFlag       = "secrets-config"
+	SecretsPasswordFileFlag = "secrets-password-file"
+)
+
+// gzipExtension is the extension of the gzip compressed block files
+const gzipExtension = ".gz"
+
+// the directories of the databases in the data dir
+const (
+	blockchainDir = "blockchain"
+	trieDir       = "trie"
+)
+
+var errUnexpectedStorage = errors.New("unexpected storage type")
+
+// ChainParams are the flags locating the chain of the stopped node
+type ChainParams struct {
+	DataDir             string
+	GenesisPath         string
+	SecretsConfigPath   string
+	SecretsPasswordFile string
+}
+
+// SetFlags registers the flags locating the chain of the stopped node
+func (p *ChainParams) SetFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&p.DataDir,
+		DataDirFlag,
+		"",
+		"the data directory of the stopped node",
+	)
+
+	cmd.Flags().StringVar(
+		&p.GenesisPath,
+		ChainFlag,
+		"./"+command.DefaultGenesisFileName,
+		"the genesis file of the chain",
+	)
+
+	cmd.Flags().StringVar(
+		&p.SecretsConfigPath,
+		SecretsConfigFlag,
+		"",
+		"the path to the SecretsManager config file. "+
+			"If omitted, the local FS secrets manager is used",
+	)
+
+	cmd.Flags().StringVar(
+		&p.SecretsPasswordFile,
+		SecretsPasswordFileFlag,
+		"",
+		"the path to the file containing the password of the encrypted local keystore files. "+
+			"If omitted, the password is read from the "+keystore.PasswordEnvVar+
+			" environment variable or the terminal",
+	)
+}
+
+// OpenChain opens the chain in the data dir of the stopped node, without the networking
+func (p *ChainParams) OpenChain() (*server.OfflineServer, error) {
+	genesisConfig, err := chain.Import(p.GenesisPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read genesis file (%s), %w", p.GenesisPath, err)
+	}
+
+	var secretsConfig *secrets.SecretsManagerConfig
+
+	if p.SecretsConfigPath != "" {
+		if secretsConfig, err = secrets.ReadConfig(p.SecretsConfigPath); err != nil {
+			return nil, fmt.Errorf("unable to read secrets config file, %w", err)
+		}
+	}
+
+	offlineServer, err := server.NewOfflineServer(&server.Config{
+		Chain:               genesisConfig,
+		DataDir:             p.DataDir,
+		SecretsManager:      secretsConfig,
+		SecretsPasswordFile: p.SecretsPasswordFile,
+		LogLevel:            hclog.Info,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w, make sure the node is stopped", err)
+	}
+
+	return offlineServer, nil
+}
+
+// IsGzipFile returns true if the block file is gzip compressed, based on its extension
+func IsGzipFile(path string) bool {
+	return strings.HasSuffix(path, gzipExtension)
+}
+
+// Databases are the blockchain database and the trie store of the stopped node
+type Databases struct {
+	Blockchain *storage.KeyValueStorage
+	Trie       *itrie.KVStorage
+}
+
+// OpenDatabases opens the blockchain database and the trie store in the data dir of the stopped node
+func OpenDatabases(dataDir string) (*Databases, error) {
+	// leveldb creates the missing database, check the data dir first
+	for _, dir := range []string{blockchainDir, trieDir} {
+		if path := filepath.Join(dataDir, dir); !common.DirectoryExists(path) {
+			return nil, fmt.Errorf("database directory %s not found", path)
+		}
+	}
+
+	logger := hclog.NewNullLogger()
+
+	chainStorage, err := leveldb.NewLevelDBStorage(filepath.Join(dataDir, blockchainDir), logger)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open blockchain database, %w, make sure the node is stopped", err)
+	}
+
+	kvStorage, ok := chainStorage.(*storage.KeyValueStorage)
+	if !ok {
+		_ = chainStorage.Close()
+
+		return nil, errUnexpectedStorage
+	}
+
+	trieStorage, err := itrie.NewLevelDBStorage(filepath.Join(dataDir, trieDir), logger)
+	if err != nil {
+		_ = chainStorage.Close()
+
+		return nil, fmt.Errorf("unable to open trie store, %w, make sure the node is stopped", err)
+	}
+
+	kvTrie, ok := trieStorage.(*itrie.KVStorage)
+	if !ok {
+		_ = chainStorage.Close()
+		_ = trieStorage.Close()
+
+		return nil, errUnexpectedStorage
+	}
+
+	return &Databases{
+		Blockchain: kvStorage,
+		Trie:       kvTrie,
+	}, nil
+}
+
+// Close closes the blockchain database and the trie store
+func (d *Databases) Close() error {
+	chainErr := d.Blockchain.Close()
+	trieErr := d.Trie.Close()
+
+	if chainErr != nil {
+		return chainErr
+	}
+
+	return trieErr
+}
@@ -0,0 +1,156 @@
+package dbimport
+
+import (
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/archive"
+	"github.com/0xPolygon/polygon-edge/command"
+	dbHelper "github.com/0xPolygon/polygon-edge/command/db/helper"
+	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	fileFlag = "file"
+	toFlag   = "to"
+)
+
+// progressInterval is the interval of the import progress logs
+const progressInterval = 10 * time.Second
+
+var (
+	params = &importParams{}
+)
+
+var (
+	errDecodeRange = errors.New("unable to decode range value")
+)
+
+type importParams struct {
+	dbHelper.ChainParams
+
+	file string
+
+	toRaw string
+	to    *uint64
+
+	resFrom uint64
+	resTo   uint64
+	head    uint64
+}
+
+func (p *importParams) validateFlags() error {
+	if p.toRaw != "" {
+		parsedTo, parseErr := types.ParseUint64orHex(&p.toRaw)
+		if parseErr != nil {
+			return errDecodeRange
+		}
+
+		p.to = &parsedTo
+	}
+
+	return nil
+}
+
+func (p *importParams) getRequiredFlags() []string {
+	return []string{
+		dbHelper.DataDirFlag,
+		fileFlag,
+	}
+}
+
+// importBlocks writes the blocks from the block file to the chain of the stopped node
+func (p *importParams) importBlocks() error {
+	fs, err := os.Open(p.file)
+	if err != nil {
+		return err
+	}
+
+	defer fs.Close()
+
+	var input io.Reader = bufio.NewReader(fs)
+
+	if dbHelper.IsGzipFile(p.file) {
+		if input, err = gzip.NewReader(input); err != nil {
+			return fmt.Errorf("unable to read gzip file (%s), %w", p.file, err)
+		}
+	}
+
+	offlineServer, err := p.OpenChain()
+	if err != nil {
+		return err
+	}
+
+	defer offlineServer.Close()
+
+	chain := offlineServer.Blockchain()
+	progression := progress.NewProgressionWrapper(progress.ChainSyncRestore)
+
+	stopLogCh := make(chan struct{})
+	defer close(stopLogCh)
+
+	go logProgress(progression, stopLogCh)
+
+	p.resFrom, p.resTo, err = archive.ImportBlocks(chain, input, p.to, progression)
+	p.head = chain.Header().Number
+
+	if errors.Is(err, archive.ErrImportInterrupted) {
+		return fmt.Errorf("%w at block %d, run the import again to resume it", err, p.head)
+	}
+
+	return err
+}
+
+// logProgress logs the progression of the import periodically
+func logProgress(progression *progress.ProgressionWrapper, stopCh <-chan struct{}) {
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:  "import",
+		Level: hclog.Info,
+	})
+
+	ticker := time.NewTicker(progressInterval)
+	defer ticker.Stop()
+
+	var lastBlock uint64
+
+	for {
+		select {
+		case <-ticker.C:
+			current := progression.GetProgression()
+			if current == nil || current.CurrentBlock == lastBlock {
+				continue
+			}
+
+			if lastBlock == 0 {
+				lastBlock = current.StartingBlock - 1
+			}
+
+			logger.Info(
+				"Imported blocks",
+				"block", current.CurrentBlock,
+				"target", current.HighestBlock,
+				"blocks/s", float64(current.CurrentBlock-lastBlock)/progressInterval.Seconds(),
+			)
+
+			lastBlock = current.CurrentBlock
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+func (p *importParams) getResult() command.CommandResult {
+	return &DBImportResult{
+		File: p.file,
+		From: p.resFrom,
+		To:   p.resTo,
+		Head: p.head,
+	}
+}
@@ -0,0 +1,37 @@
+package dbimport
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type DBImportResult struct {
+	File string `json:"file"`
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+	Head uint64 `json:"head"`
+}
+
+func (r *DBImportResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[DB IMPORT]\n")
+
+	if r.To == 0 {
+		buffer.WriteString("No new blocks in the block file\n")
+	} else {
+		buffer.WriteString("Imported blocks successfully:\n")
+	}
+
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("File|%s", r.File),
+		fmt.Sprintf("From|%d", r.From),
+		fmt.Sprintf("To|%d", r.To),
+		fmt.Sprintf("Latest block|%d", r.Head),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
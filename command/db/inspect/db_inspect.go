@@ -0,0 +1,44 @@
+package inspect
+
+import (
+	"github.com/0xPolygon/polygon-edge/command"
+	dbHelper "github.com/0xPolygon/polygon-edge/command/db/helper"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	inspectCmd := &cobra.Command{
+		Use: "inspect",
+		Short: "Reports the sizes of the key prefixes of the stopped node's databases " +
+			"and checks the head, the canonical chain and the total difficulty are consistent",
+		Run: runCommand,
+	}
+
+	setFlags(inspectCmd)
+	helper.SetRequiredFlags(inspectCmd, params.getRequiredFlags())
+
+	return inspectCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		dbHelper.DataDirFlag,
+		"",
+		"the data directory of the stopped node",
+	)
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.inspect(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
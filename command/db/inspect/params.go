@@ -0,0 +1,43 @@
+package inspect
+
+import (
+	"github.com/0xPolygon/polygon-edge/blockchain/dbtool"
+	"github.com/0xPolygon/polygon-edge/command"
+	dbHelper "github.com/0xPolygon/polygon-edge/command/db/helper"
+)
+
+var (
+	params = &inspectParams{}
+)
+
+type inspectParams struct {
+	dataDir string
+
+	report *dbtool.InspectReport
+}
+
+func (p *inspectParams) getRequiredFlags() []string {
+	return []string{
+		dbHelper.DataDirFlag,
+	}
+}
+
+// inspect inspects the databases of the stopped node
+func (p *inspectParams) inspect() error {
+	dbs, err := dbHelper.OpenDatabases(p.dataDir)
+	if err != nil {
+		return err
+	}
+
+	defer dbs.Close()
+
+	p.report, err = dbtool.Inspect(dbs.Blockchain, dbs.Trie)
+
+	return err
+}
+
+func (p *inspectParams) getResult() command.CommandResult {
+	return &DBInspectResult{
+		InspectReport: *p.report,
+	}
+}
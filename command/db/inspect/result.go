@@ -0,0 +1,77 @@
+package inspect
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/dbtool"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type DBInspectResult struct {
+	dbtool.InspectReport
+}
+
+func (r *DBInspectResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[DB INSPECT]\n")
+	buffer.WriteString("\n[BLOCKCHAIN DATABASE]\n")
+	writeStats(&buffer, r.Prefixes)
+
+	buffer.WriteString("\n[TRIE STORE]\n")
+	writeStats(&buffer, r.Trie)
+
+	buffer.WriteString("\n[HEAD]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Number|%d", r.HeadNumber),
+		fmt.Sprintf("Hash|%s", r.HeadHash),
+	}))
+	buffer.WriteString("\n")
+
+	writeIssues(&buffer, r.Issues)
+
+	return buffer.String()
+}
+
+func writeStats(buffer *bytes.Buffer, stats []*dbtool.PrefixStats) {
+	rows := make([]string, len(stats)+1)
+	rows[0] = "Prefix|Entries|Size"
+
+	for i, s := range stats {
+		rows[i+1] = fmt.Sprintf("%s|%d|%s", s.Name, s.Entries, formatSize(s.Size))
+	}
+
+	buffer.WriteString(helper.FormatList(rows))
+	buffer.WriteString("\n")
+}
+
+func writeIssues(buffer *bytes.Buffer, issues []string) {
+	buffer.WriteString("\n[ISSUES]\n")
+
+	if len(issues) == 0 {
+		buffer.WriteString("No issues found\n")
+
+		return
+	}
+
+	buffer.WriteString(helper.FormatList(issues))
+	buffer.WriteString("\n")
+}
+
+// formatSize formats the size in bytes in the human readable units
+func formatSize(size uint64) string {
+	const unit = 1024
+
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	div, exp := uint64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.2f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
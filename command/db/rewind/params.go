@@ -0,0 +1,103 @@
+package rewind
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/dbtool"
+	"github.com/0xPolygon/polygon-edge/command"
+	dbHelper "github.com/0xPolygon/polygon-edge/command/db/helper"
+	cmdHelper "github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	toFlag  = "to"
+	yesFlag = "yes"
+)
+
+var (
+	params = &rewindParams{}
+)
+
+var (
+	errDecodeHeight = errors.New("unable to decode height value")
+	errNotConfirmed = errors.New("rewind is not confirmed")
+)
+
+type rewindParams struct {
+	dataDir string
+
+	toRaw string
+	to    uint64
+
+	confirmed bool
+
+	res *dbtool.RewindResult
+}
+
+func (p *rewindParams) validateFlags() error {
+	var parseErr error
+
+	if p.to, parseErr = types.ParseUint64orHex(&p.toRaw); parseErr != nil {
+		return errDecodeHeight
+	}
+
+	return nil
+}
+
+func (p *rewindParams) getRequiredFlags() []string {
+	return []string{
+		dbHelper.DataDirFlag,
+		toFlag,
+	}
+}
+
+// rewind sets the head of the stopped node back to the given block
+func (p *rewindParams) rewind() error {
+	dbs, err := dbHelper.OpenDatabases(p.dataDir)
+	if err != nil {
+		return err
+	}
+
+	defer dbs.Close()
+
+	head, ok := dbs.Blockchain.ReadHeadNumber()
+	if !ok {
+		return dbtool.ErrHeadNotFound
+	}
+
+	if err := p.confirmRewind(head); err != nil {
+		return err
+	}
+
+	p.res, err = dbtool.Rewind(dbs.Blockchain, dbs.Trie, p.to)
+
+	return err
+}
+
+// confirmRewind asks the user to confirm the rewind, unless it's confirmed already by the flag
+func (p *rewindParams) confirmRewind(head uint64) error {
+	if p.confirmed || p.to >= head {
+		return nil
+	}
+
+	confirmed, err := cmdHelper.ConfirmAction(
+		fmt.Sprintf("Rewind the head from block %d to block %d?", head, p.to),
+	)
+	if err != nil {
+		return err
+	}
+
+	if !confirmed {
+		return errNotConfirmed
+	}
+
+	return nil
+}
+
+func (p *rewindParams) getResult() command.CommandResult {
+	return &DBRewindResult{
+		RewindResult: *p.res,
+	}
+}
@@ -0,0 +1,31 @@
+package rewind
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/dbtool"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type DBRewindResult struct {
+	dbtool.RewindResult
+}
+
+func (r *DBRewindResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[DB REWIND]\n")
+	buffer.WriteString("Rewound head successfully:\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Old head|%d", r.OldHead),
+		fmt.Sprintf("New head|%d", r.NewHead),
+		fmt.Sprintf("New head hash|%s", r.NewHeadHash),
+		fmt.Sprintf("State root|%s", r.StateRoot),
+		fmt.Sprintf("Removed canonical hashes|%d", r.RemovedCanonical),
+		fmt.Sprintf("Removed tx lookups|%d", r.RemovedTxLookups),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,105 @@
+package verify
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/dbtool"
+	"github.com/0xPolygon/polygon-edge/command"
+	dbHelper "github.com/0xPolygon/polygon-edge/command/db/helper"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	fromFlag = "from"
+	toFlag   = "to"
+)
+
+var (
+	params = &verifyParams{}
+)
+
+var (
+	errDecodeRange  = errors.New("unable to decode range value")
+	errInvalidRange = errors.New(`invalid "to" value; must be >= "from"`)
+)
+
+type verifyParams struct {
+	dataDir string
+
+	fromRaw string
+	toRaw   string
+
+	from uint64
+	to   *uint64
+
+	report *dbtool.VerifyReport
+}
+
+func (p *verifyParams) validateFlags() error {
+	var parseErr error
+
+	if p.from, parseErr = types.ParseUint64orHex(&p.fromRaw); parseErr != nil {
+		return errDecodeRange
+	}
+
+	if p.toRaw != "" {
+		var parsedTo uint64
+
+		if parsedTo, parseErr = types.ParseUint64orHex(&p.toRaw); parseErr != nil {
+			return errDecodeRange
+		}
+
+		if p.from > parsedTo {
+			return errInvalidRange
+		}
+
+		p.to = &parsedTo
+	}
+
+	return nil
+}
+
+func (p *verifyParams) getRequiredFlags() []string {
+	return []string{
+		dbHelper.DataDirFlag,
+	}
+}
+
+// verify verifies the canonical blocks of the stopped node in the range
+func (p *verifyParams) verify() error {
+	dbs, err := dbHelper.OpenDatabases(p.dataDir)
+	if err != nil {
+		return err
+	}
+
+	defer dbs.Close()
+
+	head, ok := dbs.Blockchain.ReadHeadNumber()
+	if !ok {
+		return dbtool.ErrHeadNotFound
+	}
+
+	to := head
+	if p.to != nil {
+		if *p.to > head {
+			return fmt.Errorf("the end height %d is beyond the head block %d", *p.to, head)
+		}
+
+		to = *p.to
+	}
+
+	if p.from > to {
+		return fmt.Errorf("the beginning height %d is beyond the head block %d", p.from, head)
+	}
+
+	p.report = dbtool.VerifyBlocks(dbs.Blockchain, p.from, to)
+
+	return nil
+}
+
+func (p *verifyParams) getResult() command.CommandResult {
+	return &DBVerifyResult{
+		VerifyReport: *p.report,
+	}
+}
@@ -0,0 +1,36 @@
+package verify
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/dbtool"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type DBVerifyResult struct {
+	dbtool.VerifyReport
+}
+
+func (r *DBVerifyResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[DB VERIFY]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("From|%d", r.From),
+		fmt.Sprintf("To|%d", r.To),
+		fmt.Sprintf("Checked blocks|%d", r.Checked),
+	}))
+	buffer.WriteString("\n")
+
+	buffer.WriteString("\n[ISSUES]\n")
+
+	if len(r.Issues) == 0 {
+		buffer.WriteString("No issues found\n")
+	} else {
+		buffer.WriteString(helper.FormatList(r.Issues))
+		buffer.WriteString("\n")
+	}
+
+	return buffer.String()
+}
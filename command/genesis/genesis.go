@@ -124,6 +124,44 @@ func setFlags(cmd *cobra.Command) {
 		cmd.MarkFlagsMutuallyExclusive(command.IBFTValidatorPrefixFlag, command.IBFTValidatorFlag)
 	}
 
+	cmd.Flags().BoolVar(
+		&params.chainParams,
+		chainParamsFlag,
+		false,
+		"predeploy the contract of the chain parameters governed by the IBFT validators",
+	)
+
+	// Allow lists
+	{
+		cmd.Flags().StringArrayVar(
+			&params.contractDeployerAllowListAdmin,
+			contractDeployerAllowListAdminFlag,
+			[]string{},
+			"the addresses of the admins of the contract deployer allow list, the list is enabled if set",
+		)
+
+		cmd.Flags().StringArrayVar(
+			&params.contractDeployerAllowListEnabled,
+			contractDeployerAllowListEnabledFlag,
+			[]string{},
+			"the addresses allowed to deploy contracts",
+		)
+
+		cmd.Flags().StringArrayVar(
+			&params.transactionsAllowListAdmin,
+			transactionsAllowListAdminFlag,
+			[]string{},
+			"the addresses of the admins of the transactions allow list, the list is enabled if set",
+		)
+
+		cmd.Flags().StringArrayVar(
+			&params.transactionsAllowListEnabled,
+			transactionsAllowListEnabledFlag,
+			[]string{},
+			"the addresses allowed to send transactions",
+		)
+	}
+
 	// PoS
 	{
 		cmd.Flags().BoolVar(
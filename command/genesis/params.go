@@ -10,9 +10,12 @@ import (
 	"github.com/0xPolygon/polygon-edge/consensus/ibft"
 	"github.com/0xPolygon/polygon-edge/consensus/ibft/fork"
 	"github.com/0xPolygon/polygon-edge/consensus/ibft/signer"
+	"github.com/0xPolygon/polygon-edge/contracts/chainparams"
 	"github.com/0xPolygon/polygon-edge/contracts/staking"
+	chainParamsHelper "github.com/0xPolygon/polygon-edge/helper/chainparams"
 	stakingHelper "github.com/0xPolygon/polygon-edge/helper/staking"
 	"github.com/0xPolygon/polygon-edge/server"
+	"github.com/0xPolygon/polygon-edge/state/runtime/addresslist"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/0xPolygon/polygon-edge/validators"
 )
@@ -27,6 +30,12 @@ const (
 	posFlag           = "pos"
 	minValidatorCount = "min-validator-count"
 	maxValidatorCount = "max-validator-count"
+	chainParamsFlag   = "chain-params"
+
+	contractDeployerAllowListAdminFlag   = "contract-deployer-allow-list-admin"
+	contractDeployerAllowListEnabledFlag = "contract-deployer-allow-list-enabled"
+	transactionsAllowListAdminFlag       = "transactions-allow-list-admin"
+	transactionsAllowListEnabledFlag     = "transactions-allow-list-enabled"
 )
 
 // Legacy flags that need to be preserved for running clients
@@ -42,6 +51,8 @@ var (
 	errValidatorsNotSpecified = errors.New("validator information not specified")
 	errUnsupportedConsensus   = errors.New("specified consensusRaw not supported")
 	errInvalidEpochSize       = errors.New("epoch size must be greater than 1")
+	errChainParamsNotIBFT     = errors.New("chain parameters contract is supported only by IBFT")
+	errAllowListWithoutAdmin  = errors.New("allow list must have at least one admin")
 )
 
 type genesisParams struct {
@@ -63,6 +74,13 @@ type genesisParams struct {
 	minNumValidators uint64
 	maxNumValidators uint64
 
+	chainParams bool
+
+	contractDeployerAllowListAdmin   []string
+	contractDeployerAllowListEnabled []string
+	transactionsAllowListAdmin       []string
+	transactionsAllowListEnabled     []string
+
 	rawIBFTValidatorType string
 	ibftValidatorType    validators.ValidatorType
 
@@ -100,6 +118,17 @@ func (p *genesisParams) validateFlags() error {
 		return errInvalidEpochSize
 	}
 
+	// The parameters in the contract are voted by the IBFT validators
+	if p.chainParams && !p.isIBFTConsensus() {
+		return errChainParamsNotIBFT
+	}
+
+	// The allow lists can't be modified without an admin
+	if (len(p.contractDeployerAllowListAdmin) == 0 && len(p.contractDeployerAllowListEnabled) != 0) ||
+		(len(p.transactionsAllowListAdmin) == 0 && len(p.transactionsAllowListEnabled) != 0) {
+		return errAllowListWithoutAdmin
+	}
+
 	// Validate min and max validators number
 	if err := command.ValidateMinMaxValidatorsNumber(p.minNumValidators, p.maxNumValidators); err != nil {
 		return err
@@ -295,9 +324,10 @@ func (p *genesisParams) initGenesisConfig() error {
 			GasUsed:    command.DefaultGenesisGasUsed,
 		},
 		Params: &chain.Params{
-			ChainID: int(p.chainID),
-			Forks:   chain.AllForksEnabled,
-			Engine:  p.consensusEngineConfig,
+			ChainID:                 int(p.chainID),
+			Forks:                   chain.AllForksEnabled,
+			Engine:                  p.consensusEngineConfig,
+			ChainParametersContract: p.chainParams,
 		},
 		Bootnodes: p.bootnodes,
 	}
@@ -312,10 +342,37 @@ func (p *genesisParams) initGenesisConfig() error {
 		chainConfig.Genesis.Alloc[staking.AddrStakingContract] = stakingAccount
 	}
 
+	// Predeploy chain parameters smart contract if needed
+	if p.chainParams {
+		chainParamsAccount, err := chainParamsHelper.PredeployChainParamsSC(p.ibftValidators)
+		if err != nil {
+			return err
+		}
+
+		chainConfig.Genesis.Alloc[chainparams.AddrChainParamsContract] = chainParamsAccount
+	}
+
 	if err := fillPremineMap(chainConfig.Genesis.Alloc, p.premine); err != nil {
 		return err
 	}
 
+	// Set up the allow lists enforced in the state
+	if config := newAddressListConfig(
+		p.contractDeployerAllowListAdmin,
+		p.contractDeployerAllowListEnabled,
+	); config != nil {
+		chainConfig.Params.ContractDeployerAllowList = config
+		addresslist.ApplyGenesisAllocs(chainConfig.Genesis, addresslist.AllowListContractsAddr, config)
+	}
+
+	if config := newAddressListConfig(
+		p.transactionsAllowListAdmin,
+		p.transactionsAllowListEnabled,
+	); config != nil {
+		chainConfig.Params.TransactionsAllowList = config
+		addresslist.ApplyGenesisAllocs(chainConfig.Genesis, addresslist.AllowListTransactionsAddr, config)
+	}
+
 	p.genesisConfig = chainConfig
 
 	return nil
@@ -81,3 +81,26 @@ func fillPremineMap(
 
 	return nil
 }
+
+// newAddressListConfig returns the configuration of the address list from the given addresses,
+// nil if the list is not enabled
+func newAddressListConfig(adminAddresses, enabledAddresses []string) *chain.AddressListConfig {
+	if len(adminAddresses) == 0 {
+		return nil
+	}
+
+	config := &chain.AddressListConfig{
+		AdminAddresses:   make([]types.Address, len(adminAddresses)),
+		EnabledAddresses: make([]types.Address, len(enabledAddresses)),
+	}
+
+	for idx, addr := range adminAddresses {
+		config.AdminAddresses[idx] = types.StringToAddress(addr)
+	}
+
+	for idx, addr := range enabledAddresses {
+		config.EnabledAddresses[idx] = types.StringToAddress(addr)
+	}
+
+	return config
+}
@@ -1,12 +1,14 @@
 package helper
 
 import (
+	"bufio"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
 	"net/url"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/chain"
@@ -18,10 +20,15 @@ import (
 	txpoolOp "github.com/0xPolygon/polygon-edge/txpool/proto"
 	"github.com/ryanuber/columnize"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
+var (
+	ErrNoTerminal = errors.New("confirmation required, but the standard input is not a terminal")
+)
+
 type ClientCloseResult struct {
 	Message string `json:"message"`
 }
@@ -77,6 +84,25 @@ func HandleSignals(
 	}
 }
 
+// ConfirmAction asks the user to confirm the action on the terminal,
+// and returns true only if the answer is yes
+func ConfirmAction(question string) (bool, error) {
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return false, ErrNoTerminal
+	}
+
+	fmt.Fprintf(os.Stderr, "%s [y/N]: ", question)
+
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return false, fmt.Errorf("unable to read confirmation, %w", err)
+	}
+
+	answer = strings.ToLower(strings.TrimSpace(answer))
+
+	return answer == "y" || answer == "yes", nil
+}
+
 // FormatList formats a list, using a specific blank value replacement
 func FormatList(in []string) string {
 	columnConf := columnize.DefaultConfig()
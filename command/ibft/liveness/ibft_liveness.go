@@ -0,0 +1,42 @@
+package liveness
+
+import (
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	ibftLivenessCmd := &cobra.Command{
+		Use: "liveness",
+		Short: "Returns the committed seals the validators signed and missed in the liveness window " +
+			"until the parent of the latest block, unless a block number is specified",
+		Run: runCommand,
+	}
+
+	setFlags(ibftLivenessCmd)
+
+	return ibftLivenessCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().IntVar(
+		&params.blockNumber,
+		numberFlag,
+		-1,
+		"the block height (number) until which the liveness is counted",
+	)
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.initLiveness(helper.GetGRPCAddress(cmd)); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(newIBFTLivenessResult(params.liveness))
+}
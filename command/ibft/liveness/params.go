@@ -0,0 +1,54 @@
+package liveness
+
+import (
+	"context"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	ibftOp "github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
+)
+
+const (
+	numberFlag = "number"
+)
+
+var (
+	params = &livenessParams{}
+)
+
+type livenessParams struct {
+	blockNumber int
+
+	liveness *ibftOp.LivenessResp
+}
+
+func (p *livenessParams) initLiveness(grpcAddress string) error {
+	ibftClient, err := helper.GetIBFTOperatorClientConnection(grpcAddress)
+	if err != nil {
+		return err
+	}
+
+	liveness, err := ibftClient.Liveness(
+		context.Background(),
+		p.getLivenessRequest(),
+	)
+	if err != nil {
+		return err
+	}
+
+	p.liveness = liveness
+
+	return nil
+}
+
+func (p *livenessParams) getLivenessRequest() *ibftOp.LivenessReq {
+	req := &ibftOp.LivenessReq{
+		Latest: true,
+	}
+
+	if p.blockNumber >= 0 {
+		req.Latest = false
+		req.Number = uint64(p.blockNumber)
+	}
+
+	return req
+}
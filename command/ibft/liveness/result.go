@@ -0,0 +1,81 @@
+package liveness
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	ibftOp "github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
+)
+
+type IBFTValidatorLiveness struct {
+	Address     string `json:"address"`
+	Signed      uint64 `json:"signed"`
+	Missed      uint64 `json:"missed"`
+	MissedInRow uint64 `json:"missed_in_row"`
+	LastSigned  uint64 `json:"last_signed"`
+	Offline     bool   `json:"offline"`
+}
+
+type IBFTLivenessResult struct {
+	Number     uint64                  `json:"number"`
+	Window     uint64                  `json:"window"`
+	Validators []IBFTValidatorLiveness `json:"validators"`
+}
+
+func newIBFTLivenessResult(resp *ibftOp.LivenessResp) *IBFTLivenessResult {
+	res := &IBFTLivenessResult{
+		Number:     resp.Number,
+		Window:     resp.Window,
+		Validators: make([]IBFTValidatorLiveness, len(resp.Validators)),
+	}
+
+	for i, v := range resp.Validators {
+		res.Validators[i] = IBFTValidatorLiveness{
+			Address:     v.Address,
+			Signed:      v.Signed,
+			Missed:      v.Missed,
+			MissedInRow: v.MissedInRow,
+			LastSigned:  v.LastSigned,
+			Offline:     v.Offline,
+		}
+	}
+
+	return res
+}
+
+func (r *IBFTLivenessResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[IBFT LIVENESS]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Block|%d", r.Number),
+		fmt.Sprintf("Window|%d", r.Window),
+	}))
+	buffer.WriteString("\n")
+
+	numValidators := len(r.Validators)
+	validators := make([]string, numValidators+1)
+	validators[0] = "No validators found"
+
+	if numValidators > 0 {
+		validators[0] = "ADDRESS|SIGNED|MISSED|MISSED IN ROW|LAST SIGNED|OFFLINE"
+		for i, v := range r.Validators {
+			validators[i+1] = fmt.Sprintf(
+				"%s|%d|%d|%d|%d|%t",
+				v.Address,
+				v.Signed,
+				v.Missed,
+				v.MissedInRow,
+				v.LastSigned,
+				v.Offline,
+			)
+		}
+	}
+
+	buffer.WriteString("\n[VALIDATORS]\n")
+	buffer.WriteString(helper.FormatList(validators))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
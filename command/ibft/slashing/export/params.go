@@ -0,0 +1,94 @@
+package export
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/slashing"
+)
+
+const (
+	dataDirFlag = "data-dir"
+	outputFlag  = "output"
+	forceFlag   = "force"
+)
+
+var (
+	params = &exportParams{}
+)
+
+var (
+	errOutputExists = errors.New("output file already exists, use --force to overwrite it")
+	errNoHistory    = errors.New("slashing protection database not found in the data directory")
+)
+
+type exportParams struct {
+	dataDir string
+	output  string
+	force   bool
+
+	interchange *slashing.Interchange
+}
+
+func (ep *exportParams) getRequiredFlags() []string {
+	return []string{
+		dataDirFlag,
+		outputFlag,
+	}
+}
+
+func (ep *exportParams) validateFlags() error {
+	if _, err := os.Stat(ep.output); err == nil && !ep.force {
+		return errOutputExists
+	}
+
+	return nil
+}
+
+// exportHistory writes the slashing protection history of the node into the output file
+func (ep *exportParams) exportHistory() error {
+	path := filepath.Join(ep.dataDir, "consensus", slashing.DirName)
+	if _, err := os.Stat(path); err != nil {
+		return errNoHistory
+	}
+
+	store, err := slashing.NewStore(path)
+	if err != nil {
+		return fmt.Errorf("%w, make sure the node is stopped", err)
+	}
+
+	defer store.Close()
+
+	if ep.interchange, err = store.Export(); err != nil {
+		return err
+	}
+
+	raw, err := json.MarshalIndent(ep.interchange, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(ep.output, raw, 0600); err != nil {
+		return fmt.Errorf("unable to write interchange file (%s), %w", ep.output, err)
+	}
+
+	return nil
+}
+
+func (ep *exportParams) getResult() command.CommandResult {
+	result := &SlashingExportResult{
+		Output:      ep.output,
+		GenesisHash: ep.interchange.Metadata.GenesisHash.String(),
+	}
+
+	for _, history := range ep.interchange.Data {
+		result.Signers++
+		result.Signatures += len(history.Signatures)
+	}
+
+	return result
+}
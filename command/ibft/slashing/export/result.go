@@ -0,0 +1,30 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type SlashingExportResult struct {
+	Output      string `json:"output"`
+	GenesisHash string `json:"genesis_hash"`
+	Signers     int    `json:"signers"`
+	Signatures  int    `json:"signatures"`
+}
+
+func (r *SlashingExportResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[SLASHING PROTECTION EXPORT]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Interchange file|%s", r.Output),
+		fmt.Sprintf("Genesis hash|%s", r.GenesisHash),
+		fmt.Sprintf("Signers|%d", r.Signers),
+		fmt.Sprintf("Signatures|%d", r.Signatures),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
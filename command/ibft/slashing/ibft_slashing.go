@@ -0,0 +1,28 @@
+package slashing
+
+import (
+	"github.com/0xPolygon/polygon-edge/command/ibft/slashing/export"
+	slashingimport "github.com/0xPolygon/polygon-edge/command/ibft/slashing/import"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	slashingCmd := &cobra.Command{
+		Use: "slashing-protection",
+		Short: "Top level command for managing the slashing protection history of the validator. " +
+			"Only accepts subcommands.",
+	}
+
+	registerSubcommands(slashingCmd)
+
+	return slashingCmd
+}
+
+func registerSubcommands(baseCmd *cobra.Command) {
+	baseCmd.AddCommand(
+		// ibft slashing-protection export
+		export.GetCommand(),
+		// ibft slashing-protection import
+		slashingimport.GetCommand(),
+	)
+}
@@ -0,0 +1,69 @@
+package slashingimport
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/slashing"
+)
+
+const (
+	dataDirFlag = "data-dir"
+	fileFlag    = "file"
+)
+
+var (
+	params = &importParams{}
+)
+
+type importParams struct {
+	dataDir string
+	file    string
+
+	interchange *slashing.Interchange
+}
+
+func (ip *importParams) getRequiredFlags() []string {
+	return []string{
+		dataDirFlag,
+		fileFlag,
+	}
+}
+
+// importHistory merges the slashing protection history from the file into the node database
+func (ip *importParams) importHistory() error {
+	raw, err := os.ReadFile(ip.file)
+	if err != nil {
+		return fmt.Errorf("unable to read interchange file (%s), %w", ip.file, err)
+	}
+
+	ip.interchange = &slashing.Interchange{}
+	if err := json.Unmarshal(raw, ip.interchange); err != nil {
+		return fmt.Errorf("invalid interchange file, %w", err)
+	}
+
+	store, err := slashing.NewStore(filepath.Join(ip.dataDir, "consensus", slashing.DirName))
+	if err != nil {
+		return fmt.Errorf("%w, make sure the node is stopped", err)
+	}
+
+	defer store.Close()
+
+	return store.Import(ip.interchange)
+}
+
+func (ip *importParams) getResult() command.CommandResult {
+	result := &SlashingImportResult{
+		File: ip.file,
+	}
+
+	for _, history := range ip.interchange.Data {
+		result.Signers++
+		result.Signatures += len(history.Signatures)
+	}
+
+	return result
+}
@@ -0,0 +1,28 @@
+package slashingimport
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type SlashingImportResult struct {
+	File       string `json:"file"`
+	Signers    int    `json:"signers"`
+	Signatures int    `json:"signatures"`
+}
+
+func (r *SlashingImportResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[SLASHING PROTECTION IMPORT]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Interchange file|%s", r.File),
+		fmt.Sprintf("Signers|%d", r.Signers),
+		fmt.Sprintf("Signatures|%d", r.Signatures),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
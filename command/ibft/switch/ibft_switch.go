@@ -99,7 +99,57 @@ func setFlags(cmd *cobra.Command) {
 			"",
 			"the maximum number of validators in the validator set for PoS",
 		)
+
+		cmd.Flags().BoolVar(
+			&params.stakeWeighted,
+			stakeWeightedFlag,
+			false,
+			"select the proposer and compute the quorum based on the stake of the validators in PoS",
+		)
+
+		cmd.Flags().StringVar(
+			&params.jailThresholdRaw,
+			jailThresholdFlag,
+			"",
+			"unstake the validators missing more committed seals than the threshold in the liveness window in PoS",
+		)
+
+		cmd.Flags().StringVar(
+			&params.livenessWindowRaw,
+			livenessWindowFlag,
+			"",
+			"the number of blocks the liveness of the validators is tracked for in PoS",
+		)
 	}
+
+	cmd.Flags().StringVar(
+		&params.maxEmptyBlockIntervalRaw,
+		maxEmptyBlockIntervalFlag,
+		"",
+		"produce blocks only when transactions are pending, "+
+			"with an empty block at most every given number of seconds",
+	)
+
+	cmd.Flags().StringVar(
+		&params.blockTimeRaw,
+		blockTimeFlag,
+		"",
+		"the minimum block time in seconds, overriding the block time of the servers",
+	)
+
+	cmd.Flags().StringVar(
+		&params.baseRoundTimeoutRaw,
+		baseRoundTimeoutFlag,
+		"",
+		"the timeout in seconds of the first round at a height, the timeout grows in the next rounds",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.adaptiveRoundTimeout,
+		adaptiveRoundTimeoutFlag,
+		false,
+		"lengthen the round timeout by the round changes in the recent heights",
+	)
 }
 
 func runPreRun(_ *cobra.Command, _ []string) error {
@@ -15,18 +15,30 @@ import (
 )
 
 const (
-	chainFlag         = "chain"
-	typeFlag          = "type"
-	deploymentFlag    = "deployment"
-	fromFlag          = "from"
-	minValidatorCount = "min-validator-count"
-	maxValidatorCount = "max-validator-count"
+	chainFlag          = "chain"
+	typeFlag           = "type"
+	deploymentFlag     = "deployment"
+	fromFlag           = "from"
+	minValidatorCount  = "min-validator-count"
+	maxValidatorCount  = "max-validator-count"
+	stakeWeightedFlag  = "stake-weighted"
+	jailThresholdFlag  = "jail-threshold"
+	livenessWindowFlag = "liveness-window"
+
+	maxEmptyBlockIntervalFlag = "max-empty-block-interval"
+	blockTimeFlag             = "block-time"
+	baseRoundTimeoutFlag      = "base-round-timeout"
+	adaptiveRoundTimeoutFlag  = "adaptive-round-timeout"
 )
 
 var (
 	ErrFromPositive                  = errors.New(`"from" must be positive number`)
 	ErrIBFTConfigNotFound            = errors.New(`"ibft" config doesn't exist in "engine" of genesis.json'`)
-	ErrSameIBFTAndValidatorType      = errors.New("cannot specify same IBFT type and validator type as the last fork")
+	ErrSameIBFTAndValidatorType      = errors.New("cannot specify same IBFT type, validator type and fork settings as the last fork")
+	ErrInvalidEmptyBlockInterval     = errors.New(`"max-empty-block-interval" must be positive number`)
+	ErrInvalidBlockTime              = errors.New(`"block-time" must be positive number`)
+	ErrInvalidBaseRoundTimeout       = errors.New(`"base-round-timeout" must be positive number`)
+	ErrInvalidLivenessWindow         = errors.New(`"liveness-window" must be positive number`)
 	ErrLessFromThanLastFrom          = errors.New(`"from" must be greater than the beginning height of last fork`)
 	ErrInvalidValidatorsUpdateHeight = errors.New(`cannot specify a less height than 2 for validators update`)
 )
@@ -59,6 +71,20 @@ type switchParams struct {
 	maxValidatorCount    *uint64
 	minValidatorCountRaw string
 	minValidatorCount    *uint64
+	stakeWeighted        bool
+	jailThresholdRaw     string
+	jailThreshold        *uint64
+	livenessWindowRaw    string
+	livenessWindow       *uint64
+
+	maxEmptyBlockIntervalRaw string
+	maxEmptyBlockInterval    *uint64
+
+	blockTimeRaw         string
+	blockTime            *uint64
+	baseRoundTimeoutRaw  string
+	baseRoundTimeout     *uint64
+	adaptiveRoundTimeout bool
 
 	genesisConfig *chain.Chain
 }
@@ -95,6 +121,14 @@ func (p *switchParams) initRawParams() error {
 		return err
 	}
 
+	if err := p.initMaxEmptyBlockInterval(); err != nil {
+		return err
+	}
+
+	if err := p.initRoundTiming(); err != nil {
+		return err
+	}
+
 	if err := p.initChain(); err != nil {
 		return err
 	}
@@ -220,9 +254,51 @@ func (p *switchParams) initPoSConfig() error {
 			)
 		}
 
+		if p.stakeWeighted {
+			return fmt.Errorf(
+				"doesn't support stake weighted voting in %s",
+				string(p.ibftType),
+			)
+		}
+
+		if p.jailThresholdRaw != "" || p.livenessWindowRaw != "" {
+			return fmt.Errorf(
+				"doesn't support jailing in %s",
+				string(p.ibftType),
+			)
+		}
+
 		return nil
 	}
 
+	if p.livenessWindowRaw != "" {
+		value, err := types.ParseUint64orHex(&p.livenessWindowRaw)
+		if err != nil {
+			return fmt.Errorf(
+				"unable to parse liveness window value, %w",
+				err,
+			)
+		}
+
+		if value == 0 {
+			return ErrInvalidLivenessWindow
+		}
+
+		p.livenessWindow = &value
+	}
+
+	if p.jailThresholdRaw != "" {
+		value, err := types.ParseUint64orHex(&p.jailThresholdRaw)
+		if err != nil {
+			return fmt.Errorf(
+				"unable to parse jail threshold value, %w",
+				err,
+			)
+		}
+
+		p.jailThreshold = &value
+	}
+
 	if p.minValidatorCountRaw != "" {
 		value, err := types.ParseUint64orHex(&p.minValidatorCountRaw)
 		if err != nil {
@@ -250,6 +326,64 @@ func (p *switchParams) initPoSConfig() error {
 	return p.validateMinMaxValidatorNumber()
 }
 
+func (p *switchParams) initMaxEmptyBlockInterval() error {
+	if p.maxEmptyBlockIntervalRaw == "" {
+		return nil
+	}
+
+	value, err := types.ParseUint64orHex(&p.maxEmptyBlockIntervalRaw)
+	if err != nil {
+		return fmt.Errorf(
+			"unable to parse max empty block interval value, %w",
+			err,
+		)
+	}
+
+	if value == 0 {
+		return ErrInvalidEmptyBlockInterval
+	}
+
+	p.maxEmptyBlockInterval = &value
+
+	return nil
+}
+
+func (p *switchParams) initRoundTiming() error {
+	if p.blockTimeRaw != "" {
+		value, err := types.ParseUint64orHex(&p.blockTimeRaw)
+		if err != nil {
+			return fmt.Errorf(
+				"unable to parse block time value, %w",
+				err,
+			)
+		}
+
+		if value == 0 {
+			return ErrInvalidBlockTime
+		}
+
+		p.blockTime = &value
+	}
+
+	if p.baseRoundTimeoutRaw != "" {
+		value, err := types.ParseUint64orHex(&p.baseRoundTimeoutRaw)
+		if err != nil {
+			return fmt.Errorf(
+				"unable to parse base round timeout value, %w",
+				err,
+			)
+		}
+
+		if value == 0 {
+			return ErrInvalidBaseRoundTimeout
+		}
+
+		p.baseRoundTimeout = &value
+	}
+
+	return nil
+}
+
 func (p *switchParams) validateMinMaxValidatorNumber() error {
 	// Validate min and max validators number if not nil
 	// If they are not defined they will get default values
@@ -312,6 +446,13 @@ func (p *switchParams) updateGenesisConfig() error {
 		p.ibftValidators,
 		p.maxValidatorCount,
 		p.minValidatorCount,
+		p.stakeWeighted,
+		p.jailThreshold,
+		p.livenessWindow,
+		p.maxEmptyBlockInterval,
+		p.blockTime,
+		p.baseRoundTimeout,
+		p.adaptiveRoundTimeout,
 	)
 }
 
@@ -338,12 +479,35 @@ func (p *switchParams) getResult() command.CommandResult {
 		Type:          p.ibftType,
 		ValidatorType: p.ibftValidatorType,
 		From:          common.JSONNumber{Value: p.from},
+		StakeWeighted: p.stakeWeighted,
 	}
 
 	if p.deployment != nil {
 		result.Deployment = &common.JSONNumber{Value: *p.deployment}
 	}
 
+	if p.jailThreshold != nil {
+		result.JailThreshold = &common.JSONNumber{Value: *p.jailThreshold}
+	}
+
+	if p.livenessWindow != nil {
+		result.LivenessWindow = &common.JSONNumber{Value: *p.livenessWindow}
+	}
+
+	if p.maxEmptyBlockInterval != nil {
+		result.MaxEmptyBlockInterval = &common.JSONNumber{Value: *p.maxEmptyBlockInterval}
+	}
+
+	if p.blockTime != nil {
+		result.BlockTime = &common.JSONNumber{Value: *p.blockTime}
+	}
+
+	if p.baseRoundTimeout != nil {
+		result.BaseRoundTimeout = &common.JSONNumber{Value: *p.baseRoundTimeout}
+	}
+
+	result.AdaptiveRoundTimeout = p.adaptiveRoundTimeout
+
 	if p.minValidatorCount != nil {
 		result.MinValidatorCount = common.JSONNumber{Value: *p.minValidatorCount}
 	} else {
@@ -370,6 +534,13 @@ func appendIBFTForks(
 	// PoS
 	maxValidatorCount *uint64,
 	minValidatorCount *uint64,
+	stakeWeighted bool,
+	jailThreshold *uint64,
+	livenessWindow *uint64,
+	maxEmptyBlockInterval *uint64,
+	blockTime *uint64,
+	baseRoundTimeout *uint64,
+	adaptiveRoundTimeout bool,
 ) error {
 	ibftConfig, ok := cc.Params.Engine["ibft"].(map[string]interface{})
 	if !ok {
@@ -384,7 +555,14 @@ func appendIBFTForks(
 	lastFork := ibftForks[len(ibftForks)-1]
 
 	if (ibftType == lastFork.Type) &&
-		(validatorType == lastFork.ValidatorType) {
+		(validatorType == lastFork.ValidatorType) &&
+		(stakeWeighted == lastFork.StakeWeighted) &&
+		sameOptionalNumber(jailThreshold, lastFork.JailThreshold) &&
+		sameOptionalNumber(livenessWindow, lastFork.LivenessWindow) &&
+		sameOptionalNumber(maxEmptyBlockInterval, lastFork.MaxEmptyBlockInterval) &&
+		sameOptionalNumber(blockTime, lastFork.BlockTime) &&
+		sameOptionalNumber(baseRoundTimeout, lastFork.BaseRoundTimeout) &&
+		(adaptiveRoundTimeout == lastFork.AdaptiveRoundTimeout) {
 		return ErrSameIBFTAndValidatorType
 	}
 
@@ -405,6 +583,20 @@ func appendIBFTForks(
 		From:          common.JSONNumber{Value: from},
 	}
 
+	if maxEmptyBlockInterval != nil {
+		newFork.MaxEmptyBlockInterval = &common.JSONNumber{Value: *maxEmptyBlockInterval}
+	}
+
+	if blockTime != nil {
+		newFork.BlockTime = &common.JSONNumber{Value: *blockTime}
+	}
+
+	if baseRoundTimeout != nil {
+		newFork.BaseRoundTimeout = &common.JSONNumber{Value: *baseRoundTimeout}
+	}
+
+	newFork.AdaptiveRoundTimeout = adaptiveRoundTimeout
+
 	switch ibftType {
 	case fork.PoA:
 		newFork.Validators = validators
@@ -420,6 +612,16 @@ func appendIBFTForks(
 		if minValidatorCount != nil {
 			newFork.MinValidatorCount = &common.JSONNumber{Value: *minValidatorCount}
 		}
+
+		newFork.StakeWeighted = stakeWeighted
+
+		if jailThreshold != nil {
+			newFork.JailThreshold = &common.JSONNumber{Value: *jailThreshold}
+		}
+
+		if livenessWindow != nil {
+			newFork.LivenessWindow = &common.JSONNumber{Value: *livenessWindow}
+		}
 	}
 
 	ibftForks = append(ibftForks, &newFork)
@@ -432,3 +634,12 @@ func appendIBFTForks(
 
 	return nil
 }
+
+// sameOptionalNumber returns whether the optional setting is the same as the one of the fork
+func sameOptionalNumber(value *uint64, forkValue *common.JSONNumber) bool {
+	if value == nil || forkValue == nil {
+		return value == nil && forkValue == nil
+	}
+
+	return *value == forkValue.Value
+}
@@ -18,6 +18,14 @@ type IBFTSwitchResult struct {
 	Deployment        *common.JSONNumber       `json:"deployment,omitempty"`
 	MaxValidatorCount common.JSONNumber        `json:"maxValidatorCount"`
 	MinValidatorCount common.JSONNumber        `json:"minValidatorCount"`
+	StakeWeighted     bool                     `json:"stakeWeighted"`
+	JailThreshold     *common.JSONNumber       `json:"jailThreshold,omitempty"`
+	LivenessWindow    *common.JSONNumber       `json:"livenessWindow,omitempty"`
+
+	MaxEmptyBlockInterval *common.JSONNumber `json:"maxEmptyBlockInterval,omitempty"`
+	BlockTime             *common.JSONNumber `json:"blockTime,omitempty"`
+	BaseRoundTimeout      *common.JSONNumber `json:"baseRoundTimeout,omitempty"`
+	AdaptiveRoundTimeout  bool               `json:"adaptiveRoundTimeout,omitempty"`
 }
 
 func (r *IBFTSwitchResult) GetOutput() string {
@@ -41,7 +49,32 @@ func (r *IBFTSwitchResult) GetOutput() string {
 		outputs = append(outputs,
 			fmt.Sprintf("MaxValidatorCount|%d", r.MaxValidatorCount.Value),
 			fmt.Sprintf("MinValidatorCount|%d", r.MinValidatorCount.Value),
+			fmt.Sprintf("StakeWeighted|%t", r.StakeWeighted),
 		)
+
+		if r.JailThreshold != nil {
+			outputs = append(outputs, fmt.Sprintf("JailThreshold|%d", r.JailThreshold.Value))
+		}
+
+		if r.LivenessWindow != nil {
+			outputs = append(outputs, fmt.Sprintf("LivenessWindow|%d", r.LivenessWindow.Value))
+		}
+	}
+
+	if r.MaxEmptyBlockInterval != nil {
+		outputs = append(outputs, fmt.Sprintf("MaxEmptyBlockInterval|%d", r.MaxEmptyBlockInterval.Value))
+	}
+
+	if r.BlockTime != nil {
+		outputs = append(outputs, fmt.Sprintf("BlockTime|%d", r.BlockTime.Value))
+	}
+
+	if r.BaseRoundTimeout != nil {
+		outputs = append(outputs, fmt.Sprintf("BaseRoundTimeout|%d", r.BaseRoundTimeout.Value))
+	}
+
+	if r.AdaptiveRoundTimeout {
+		outputs = append(outputs, fmt.Sprintf("AdaptiveRoundTimeout|%t", r.AdaptiveRoundTimeout))
 	}
 
 	buffer.WriteString(helper.FormatKV(outputs))
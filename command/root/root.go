@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/0xPolygon/polygon-edge/command/backup"
+	"github.com/0xPolygon/polygon-edge/command/db"
 	"github.com/0xPolygon/polygon-edge/command/genesis"
 	"github.com/0xPolygon/polygon-edge/command/helper"
 	"github.com/0xPolygon/polygon-edge/command/ibft"
@@ -48,6 +49,7 @@ func (rc *RootCommand) registerSubCommands() {
 		monitor.GetCommand(),
 		ibft.GetCommand(),
 		backup.GetCommand(),
+		db.GetCommand(),
 		genesis.GetCommand(),
 		server.GetCommand(),
 		whitelist.GetCommand(),
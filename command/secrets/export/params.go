@@ -0,0 +1,184 @@
+package export
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/helper/keystore"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/secrets/helper"
+)
+
+const (
+	dataDirFlag            = "data-dir"
+	configFlag             = "config"
+	typeFlag               = "type"
+	outputFlag             = "output"
+	outputPasswordFileFlag = "output-password-file"
+	passwordFileFlag       = "password-file"
+	forceFlag              = "force"
+)
+
+// Define constant names for the secret types accepted by the type flag
+const (
+	ecdsaType   = "ecdsa"
+	blsType     = "bls"
+	networkType = "network"
+)
+
+var (
+	params = &exportParams{}
+
+	// scrypt parameters of the exported keystore file
+	scryptN = keystore.StandardScryptN
+	scryptP = keystore.StandardScryptP
+)
+
+var (
+	errInvalidConfig   = errors.New("invalid secrets configuration")
+	errInvalidParams   = errors.New("no config file or data directory passed in")
+	errUnsupportedType = errors.New("unsupported secrets manager")
+	errInvalidType     = fmt.Errorf(
+		"invalid secret type, only %s, %s and %s are supported",
+		ecdsaType, blsType, networkType,
+	)
+	errOutputExists = errors.New("output file already exists, use --force to overwrite it")
+)
+
+// secretNames maps the secret types to the names used by the secrets managers
+var secretNames = map[string]string{
+	ecdsaType:   secrets.ValidatorKey,
+	blsType:     secrets.ValidatorBLSKey,
+	networkType: secrets.NetworkKey,
+}
+
+type exportParams struct {
+	dataDir            string
+	configPath         string
+	secretType         string
+	output             string
+	outputPasswordFile string
+	passwordFile       string
+	force              bool
+
+	secretsManager secrets.SecretsManager
+	secretsConfig  *secrets.SecretsManagerConfig
+
+	secretName string
+	identity   string
+}
+
+func (ep *exportParams) getRequiredFlags() []string {
+	return []string{
+		typeFlag,
+		outputFlag,
+	}
+}
+
+func (ep *exportParams) validateFlags() error {
+	if ep.dataDir == "" && ep.configPath == "" {
+		return errInvalidParams
+	}
+
+	secretName, ok := secretNames[ep.secretType]
+	if !ok {
+		return errInvalidType
+	}
+
+	ep.secretName = secretName
+
+	if _, err := os.Stat(ep.output); err == nil && !ep.force {
+		return errOutputExists
+	}
+
+	return nil
+}
+
+func (ep *exportParams) initSecretsManager() error {
+	var err error
+	if ep.configPath != "" {
+		if err = ep.parseConfig(); err != nil {
+			return err
+		}
+
+		ep.secretsManager, err = helper.InitCloudSecretsManager(ep.secretsConfig, ep.dataDir)
+
+		return err
+	}
+
+	ep.secretsManager, err = helper.SetupLocalSecretsManagerWithPassword(ep.dataDir, false, "", ep.passwordFile)
+
+	return err
+}
+
+func (ep *exportParams) parseConfig() error {
+	secretsConfig, readErr := secrets.ReadConfig(ep.configPath)
+	if readErr != nil {
+		return errInvalidConfig
+	}
+
+	if !secrets.SupportedServiceManager(secretsConfig.Type) {
+		return errUnsupportedType
+	}
+
+	ep.secretsConfig = secretsConfig
+
+	return nil
+}
+
+// exportSecret writes the secret from the secrets manager into the encrypted keystore file
+func (ep *exportParams) exportSecret() error {
+	secret, err := ep.secretsManager.GetSecret(ep.secretName)
+	if err != nil {
+		return fmt.Errorf("unable to read %s secret, %w", ep.secretType, err)
+	}
+
+	if ep.identity, err = helper.GetSecretIdentity(ep.secretName, secret); err != nil {
+		return fmt.Errorf("invalid %s secret, %w", ep.secretType, err)
+	}
+
+	rawSecret, err := hex.DecodeString(string(secret))
+	if err != nil {
+		return err
+	}
+
+	// The geth keystore files contain the address without the prefix
+	address := ""
+	if ep.secretName == secrets.ValidatorKey {
+		address = strings.ToLower(strings.TrimPrefix(ep.identity, "0x"))
+	}
+
+	password, err := keystore.ReadPassword(ep.outputPasswordFile, true)
+	if err != nil {
+		return err
+	}
+
+	keyJSON, err := keystore.EncryptKey(
+		rawSecret,
+		address,
+		password,
+		scryptN,
+		scryptP,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(ep.output, keyJSON, 0600); err != nil {
+		return fmt.Errorf("unable to write keystore file (%s), %w", ep.output, err)
+	}
+
+	return nil
+}
+
+func (ep *exportParams) getResult() command.CommandResult {
+	return &SecretsExportResult{
+		Type:     ep.secretType,
+		Identity: ep.identity,
+		Output:   ep.output,
+	}
+}
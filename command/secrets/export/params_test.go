@@ -0,0 +1,165 @@
+package export
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/keystore"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/secrets/helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTestFile writes the content into a file in the directory and returns its path
+func writeTestFile(t *testing.T, dir, name string, content []byte) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, content, 0600))
+
+	return path
+}
+
+func TestExportParams_validateFlags(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	existing := writeTestFile(t, dir, "keystore.json", []byte("{}"))
+
+	tests := []struct {
+		name        string
+		params      *exportParams
+		expectedErr error
+	}{
+		{
+			name: "should pass for a new output file",
+			params: &exportParams{
+				dataDir:    dir,
+				secretType: ecdsaType,
+				output:     filepath.Join(dir, "new.json"),
+			},
+		},
+		{
+			name: "should fail without the data directory and the config",
+			params: &exportParams{
+				secretType: ecdsaType,
+				output:     filepath.Join(dir, "new.json"),
+			},
+			expectedErr: errInvalidParams,
+		},
+		{
+			name: "should fail for an unknown secret type",
+			params: &exportParams{
+				dataDir:    dir,
+				secretType: "unknown",
+				output:     filepath.Join(dir, "new.json"),
+			},
+			expectedErr: errInvalidType,
+		},
+		{
+			name: "should not overwrite the output file without the force flag",
+			params: &exportParams{
+				dataDir:    dir,
+				secretType: ecdsaType,
+				output:     existing,
+			},
+			expectedErr: errOutputExists,
+		},
+		{
+			name: "should overwrite the output file with the force flag",
+			params: &exportParams{
+				dataDir:    dir,
+				secretType: ecdsaType,
+				output:     existing,
+				force:      true,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.ErrorIs(t, test.params.validateFlags(), test.expectedErr)
+		})
+	}
+}
+
+func TestExportParams_exportSecret(t *testing.T) {
+	// Use the light scrypt parameters to speed up the test
+	scryptN, scryptP = keystore.LightScryptN, keystore.LightScryptP
+
+	t.Cleanup(func() {
+		scryptN, scryptP = keystore.StandardScryptN, keystore.StandardScryptP
+	})
+
+	key, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	raw, err := crypto.MarshalECDSAPrivateKey(key)
+	require.NoError(t, err)
+
+	address := crypto.PubKeyToAddress(&key.PublicKey).String()
+
+	tests := []struct {
+		name      string
+		encrypted bool
+	}{
+		{
+			name: "should export the plaintext local secret",
+		},
+		{
+			// the local secrets are encrypted by the standard scrypt parameters
+			name:      "should export the encrypted local secret by the output password",
+			encrypted: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			ep := &exportParams{
+				dataDir:            filepath.Join(dir, "data"),
+				secretType:         ecdsaType,
+				secretName:         secrets.ValidatorKey,
+				output:             filepath.Join(dir, "keystore.json"),
+				outputPasswordFile: writeTestFile(t, dir, "output-password", []byte("output")),
+				passwordFile:       writeTestFile(t, dir, "password", []byte("local")),
+			}
+
+			localManager, err := helper.SetupLocalSecretsManagerWithPassword(
+				ep.dataDir,
+				test.encrypted,
+				"",
+				ep.passwordFile,
+			)
+			require.NoError(t, err)
+			require.NoError(t, localManager.SetSecret(secrets.ValidatorKey, []byte(hex.EncodeToString(raw))))
+
+			require.NoError(t, ep.initSecretsManager())
+			require.NoError(t, ep.exportSecret())
+
+			assert.Equal(t, address, ep.identity)
+
+			keyJSON, err := os.ReadFile(ep.output)
+			require.NoError(t, err)
+
+			_, err = keystore.DecryptKey(keyJSON, "local")
+			assert.Error(t, err)
+
+			exported, err := keystore.DecryptKey(keyJSON, "output")
+			require.NoError(t, err)
+
+			assert.Equal(t, raw, exported)
+		})
+	}
+}
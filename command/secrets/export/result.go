@@ -0,0 +1,28 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type SecretsExportResult struct {
+	Type     string `json:"type"`
+	Identity string `json:"identity"`
+	Output   string `json:"output"`
+}
+
+func (r *SecretsExportResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[SECRETS EXPORT]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Type|%s", r.Type),
+		fmt.Sprintf("Identity|%s", r.Identity),
+		fmt.Sprintf("Keystore file|%s", r.Output),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,106 @@
+package export
+
+import (
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/helper/keystore"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	secretsExportCmd := &cobra.Command{
+		Use:     "export",
+		Short:   "Exports a private key from the specified Secrets Manager to an encrypted keystore file",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(secretsExportCmd)
+	helper.SetRequiredFlags(secretsExportCmd, params.getRequiredFlags())
+
+	return secretsExportCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		dataDirFlag,
+		"",
+		"the directory for the Polygon Edge data if the local FS is used",
+	)
+
+	cmd.Flags().StringVar(
+		&params.configPath,
+		configFlag,
+		"",
+		"the path to the SecretsManager config file, "+
+			"if omitted, the local FS secrets manager is used",
+	)
+
+	cmd.Flags().StringVar(
+		&params.secretType,
+		typeFlag,
+		"",
+		fmt.Sprintf("the type of the exported secret (%s, %s or %s)", ecdsaType, blsType, networkType),
+	)
+
+	cmd.Flags().StringVar(
+		&params.output,
+		outputFlag,
+		"",
+		"the path to the keystore JSON file the private key is written to",
+	)
+
+	cmd.Flags().StringVar(
+		&params.outputPasswordFile,
+		outputPasswordFileFlag,
+		"",
+		"the path to the file containing the password of the exported keystore file. "+
+			"If omitted, the password is read from the "+keystore.PasswordEnvVar+
+			" environment variable or the terminal",
+	)
+
+	cmd.Flags().StringVar(
+		&params.passwordFile,
+		passwordFileFlag,
+		"",
+		"the path to the file containing the password of the encrypted local secrets, only for the local FS. "+
+			"If omitted, the password is read from the "+keystore.PasswordEnvVar+
+			" environment variable or the terminal",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.force,
+		forceFlag,
+		false,
+		"overwrite the output file if it already exists",
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(dataDirFlag, configFlag)
+	cmd.MarkFlagsMutuallyExclusive(passwordFileFlag, configFlag)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.initSecretsManager(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	if err := params.exportSecret(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
@@ -0,0 +1,260 @@
+package secretsimport
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	cmdHelper "github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/helper/keystore"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/secrets/helper"
+)
+
+const (
+	dataDirFlag            = "data-dir"
+	configFlag             = "config"
+	typeFlag               = "type"
+	fileFlag               = "file"
+	sourcePasswordFileFlag = "source-password-file"
+	passwordFileFlag       = "password-file"
+	forceFlag              = "force"
+	yesFlag                = "yes"
+	encryptFlag            = "encrypt"
+)
+
+// Define constant names for the secret types accepted by the type flag
+const (
+	ecdsaType   = "ecdsa"
+	blsType     = "bls"
+	networkType = "network"
+)
+
+var (
+	params = &importParams{}
+)
+
+var (
+	errInvalidConfig   = errors.New("invalid secrets configuration")
+	errInvalidParams   = errors.New("no config file or data directory passed in")
+	errUnsupportedType = errors.New("unsupported secrets manager")
+	errInvalidType     = fmt.Errorf(
+		"invalid secret type, only %s, %s and %s are supported",
+		ecdsaType, blsType, networkType,
+	)
+	errSecretExists = errors.New("secret is already initialized, use --force to overwrite it")
+	errNotConfirmed = errors.New("import is not confirmed")
+)
+
+// secretNames maps the secret types to the names used by the secrets managers
+var secretNames = map[string]string{
+	ecdsaType:   secrets.ValidatorKey,
+	blsType:     secrets.ValidatorBLSKey,
+	networkType: secrets.NetworkKey,
+}
+
+type importParams struct {
+	dataDir            string
+	configPath         string
+	secretType         string
+	file               string
+	sourcePasswordFile string
+	passwordFile       string
+	force              bool
+	confirmed          bool
+	encrypted          bool
+
+	secretsManager secrets.SecretsManager
+	secretsConfig  *secrets.SecretsManagerConfig
+
+	secretName string
+	secret     []byte
+	identity   string
+}
+
+func (ip *importParams) getRequiredFlags() []string {
+	return []string{
+		typeFlag,
+		fileFlag,
+	}
+}
+
+func (ip *importParams) validateFlags() error {
+	if ip.dataDir == "" && ip.configPath == "" {
+		return errInvalidParams
+	}
+
+	secretName, ok := secretNames[ip.secretType]
+	if !ok {
+		return errInvalidType
+	}
+
+	ip.secretName = secretName
+
+	return nil
+}
+
+// readSecret reads the secret from the raw hex file or the keystore JSON file
+// and derives its public identity
+func (ip *importParams) readSecret() error {
+	content, err := os.ReadFile(ip.file)
+	if err != nil {
+		return fmt.Errorf("unable to read secret file (%s), %w", ip.file, err)
+	}
+
+	if keystore.IsEncryptedKey(content) {
+		if ip.secret, err = ip.decryptSecret(content); err != nil {
+			return err
+		}
+	} else {
+		ip.secret = []byte(strings.TrimPrefix(string(bytes.TrimSpace(content)), "0x"))
+	}
+
+	if ip.identity, err = helper.GetSecretIdentity(ip.secretName, ip.secret); err != nil {
+		return fmt.Errorf("invalid %s secret, %w", ip.secretType, err)
+	}
+
+	return nil
+}
+
+func (ip *importParams) decryptSecret(keyJSON []byte) ([]byte, error) {
+	password, err := keystore.ReadPassword(ip.sourcePasswordFile, false)
+	if err != nil {
+		return nil, err
+	}
+
+	rawSecret, err := keystore.DecryptKey(keyJSON, password)
+	if err != nil {
+		return nil, err
+	}
+
+	// Secrets managers store the keys hex encoded
+	return []byte(hex.EncodeToString(rawSecret)), nil
+}
+
+func (ip *importParams) initSecretsManager() error {
+	var err error
+	if ip.configPath != "" {
+		if err = ip.parseConfig(); err != nil {
+			return err
+		}
+
+		ip.secretsManager, err = helper.InitCloudSecretsManager(ip.secretsConfig, ip.dataDir)
+
+		return err
+	}
+
+	if ip.secretsManager, err = ip.setupLocalSecretsManager(); err != nil {
+		return err
+	}
+
+	// The replaced encrypted secret is never overwritten by a plaintext one
+	if !ip.encrypted && isEncryptedSecret(ip.secretsManager, ip.secretName) {
+		ip.encrypted = true
+
+		ip.secretsManager, err = ip.setupLocalSecretsManager()
+	}
+
+	return err
+}
+
+func (ip *importParams) setupLocalSecretsManager() (secrets.SecretsManager, error) {
+	return helper.SetupLocalSecretsManagerWithPassword(ip.dataDir, ip.encrypted, "", ip.passwordFile)
+}
+
+// isEncryptedSecret checks if the existing secret is stored in the encrypted keystore file
+func isEncryptedSecret(secretsManager secrets.SecretsManager, name string) bool {
+	encryptedManager, ok := secretsManager.(interface {
+		IsEncrypted(name string) bool
+	})
+
+	return ok && secretsManager.HasSecret(name) && encryptedManager.IsEncrypted(name)
+}
+
+func (ip *importParams) parseConfig() error {
+	secretsConfig, readErr := secrets.ReadConfig(ip.configPath)
+	if readErr != nil {
+		return errInvalidConfig
+	}
+
+	if !secrets.SupportedServiceManager(secretsConfig.Type) {
+		return errUnsupportedType
+	}
+
+	ip.secretsConfig = secretsConfig
+
+	return nil
+}
+
+// confirmImport asks the user to confirm the derived identity of the secret,
+// unless it's confirmed already by the flag
+func (ip *importParams) confirmImport() error {
+	if ip.confirmed {
+		return nil
+	}
+
+	confirmed, err := cmdHelper.ConfirmAction(
+		fmt.Sprintf("Import %s secret with identity %s?", ip.secretType, ip.identity),
+	)
+	if err != nil {
+		return err
+	}
+
+	if !confirmed {
+		return errNotConfirmed
+	}
+
+	return nil
+}
+
+// checkExisting makes sure the existing secret is not overwritten without the force flag
+func (ip *importParams) checkExisting() error {
+	if ip.secretsManager.HasSecret(ip.secretName) && !ip.force {
+		return errSecretExists
+	}
+
+	return nil
+}
+
+// importSecret writes the secret to the secrets manager. The existing secret
+// is kept in memory while it's replaced, and restored if the new one can't be written
+func (ip *importParams) importSecret() error {
+	if !ip.secretsManager.HasSecret(ip.secretName) {
+		return ip.secretsManager.SetSecret(ip.secretName, ip.secret)
+	}
+
+	previous, err := ip.secretsManager.GetSecret(ip.secretName)
+	if err != nil {
+		return fmt.Errorf("unable to read existing secret, %w", err)
+	}
+
+	if err := ip.secretsManager.RemoveSecret(ip.secretName); err != nil {
+		return fmt.Errorf("unable to remove existing secret, %w", err)
+	}
+
+	if err := ip.secretsManager.SetSecret(ip.secretName, ip.secret); err != nil {
+		if restoreErr := ip.secretsManager.SetSecret(ip.secretName, previous); restoreErr != nil {
+			return fmt.Errorf(
+				"unable to write secret, %w, and unable to restore existing secret, %s",
+				err,
+				restoreErr.Error(),
+			)
+		}
+
+		return fmt.Errorf("unable to write secret, the existing secret is restored, %w", err)
+	}
+
+	return nil
+}
+
+func (ip *importParams) getResult() command.CommandResult {
+	return &SecretsImportResult{
+		Type:      ip.secretType,
+		Identity:  ip.identity,
+		Encrypted: ip.encrypted,
+	}
+}
@@ -0,0 +1,303 @@
+package secretsimport
+
+import (
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/keystore"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/secrets/helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errTestWrite = errors.New("write error")
+
+// newTestKey generates a hex encoded ECDSA key and returns it with its address
+func newTestKey(t *testing.T) ([]byte, string) {
+	t.Helper()
+
+	key, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	raw, err := crypto.MarshalECDSAPrivateKey(key)
+	require.NoError(t, err)
+
+	return []byte(hex.EncodeToString(raw)), crypto.PubKeyToAddress(&key.PublicKey).String()
+}
+
+// writeTestFile writes the content into a file in the directory and returns its path
+func writeTestFile(t *testing.T, dir, name string, content []byte) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, content, 0600))
+
+	return path
+}
+
+// newTestKeystore writes the key into a keystore file encrypted by the password
+func newTestKeystore(t *testing.T, dir string, key []byte, password string) string {
+	t.Helper()
+
+	raw, err := hex.DecodeString(string(key))
+	require.NoError(t, err)
+
+	keyJSON, err := keystore.EncryptKey(raw, "", password, keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+
+	return writeTestFile(t, dir, "keystore.json", keyJSON)
+}
+
+// failingSecretsManager is a secrets manager failing to write the secrets with the failing value
+type failingSecretsManager struct {
+	secrets.SecretsManager
+
+	failingValue []byte
+}
+
+func (m *failingSecretsManager) SetSecret(name string, value []byte) error {
+	if string(value) == string(m.failingValue) {
+		return errTestWrite
+	}
+
+	return m.SecretsManager.SetSecret(name, value)
+}
+
+func TestImportParams_readSecret(t *testing.T) {
+	t.Parallel()
+
+	key, address := newTestKey(t)
+
+	tests := []struct {
+		name               string
+		content            func(dir string) string
+		sourcePasswordFile func(dir string) string
+		expectedErr        bool
+	}{
+		{
+			name: "should read the raw hex file",
+			content: func(dir string) string {
+				return writeTestFile(t, dir, "key", key)
+			},
+		},
+		{
+			name: "should read the raw hex file with the prefix and the trailing new line",
+			content: func(dir string) string {
+				return writeTestFile(t, dir, "key", []byte("0x"+string(key)+"\n"))
+			},
+		},
+		{
+			name: "should decrypt the keystore file by the source password",
+			content: func(dir string) string {
+				return newTestKeystore(t, dir, key, "source")
+			},
+			sourcePasswordFile: func(dir string) string {
+				return writeTestFile(t, dir, "source-password", []byte("source\n"))
+			},
+		},
+		{
+			name: "should fail to decrypt the keystore file by the wrong password",
+			content: func(dir string) string {
+				return newTestKeystore(t, dir, key, "source")
+			},
+			sourcePasswordFile: func(dir string) string {
+				return writeTestFile(t, dir, "source-password", []byte("local"))
+			},
+			expectedErr: true,
+		},
+		{
+			name: "should fail if the secret is not a valid key",
+			content: func(dir string) string {
+				return writeTestFile(t, dir, "key", []byte("0x1234"))
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+
+			ip := &importParams{
+				secretType: ecdsaType,
+				secretName: secrets.ValidatorKey,
+				file:       test.content(dir),
+			}
+
+			if test.sourcePasswordFile != nil {
+				ip.sourcePasswordFile = test.sourcePasswordFile(dir)
+			}
+
+			err := ip.readSecret()
+			if test.expectedErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, key, ip.secret)
+			assert.Equal(t, address, ip.identity)
+		})
+	}
+}
+
+func TestImportParams_importSecret(t *testing.T) {
+	t.Parallel()
+
+	existingKey, _ := newTestKey(t)
+	key, _ := newTestKey(t)
+
+	tests := []struct {
+		name        string
+		existing    bool
+		force       bool
+		failing     bool
+		expectedErr error
+		expected    []byte
+	}{
+		{
+			name:     "should write the secret",
+			expected: key,
+		},
+		{
+			name:        "should not overwrite the existing secret without the force flag",
+			existing:    true,
+			expectedErr: errSecretExists,
+			expected:    existingKey,
+		},
+		{
+			name:     "should overwrite the existing secret with the force flag",
+			existing: true,
+			force:    true,
+			expected: key,
+		},
+		{
+			name:        "should restore the existing secret if the secret can't be written",
+			existing:    true,
+			force:       true,
+			failing:     true,
+			expectedErr: errTestWrite,
+			expected:    existingKey,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ip := &importParams{
+				dataDir:    t.TempDir(),
+				secretName: secrets.ValidatorKey,
+				secret:     key,
+				force:      test.force,
+			}
+
+			require.NoError(t, ip.initSecretsManager())
+
+			if test.existing {
+				require.NoError(t, ip.secretsManager.SetSecret(secrets.ValidatorKey, existingKey))
+			}
+
+			if test.failing {
+				ip.secretsManager = &failingSecretsManager{
+					SecretsManager: ip.secretsManager,
+					failingValue:   key,
+				}
+			}
+
+			err := ip.checkExisting()
+			if err == nil {
+				err = ip.importSecret()
+			}
+
+			assert.ErrorIs(t, err, test.expectedErr)
+
+			secret, err := ip.secretsManager.GetSecret(secrets.ValidatorKey)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, secret)
+		})
+	}
+}
+
+// The encryption of the local secrets uses the standard scrypt parameters,
+// the cases are not run in parallel to keep the memory usage low
+func TestImportParams_encrypt(t *testing.T) {
+	t.Parallel()
+
+	key, _ := newTestKey(t)
+
+	t.Run("should encrypt the keystore secret by the local password", func(t *testing.T) {
+		dir := t.TempDir()
+
+		ip := &importParams{
+			dataDir:            filepath.Join(dir, "data"),
+			secretType:         ecdsaType,
+			secretName:         secrets.ValidatorKey,
+			file:               newTestKeystore(t, dir, key, "source"),
+			sourcePasswordFile: writeTestFile(t, dir, "source-password", []byte("source")),
+			passwordFile:       writeTestFile(t, dir, "password", []byte("local")),
+			encrypted:          true,
+		}
+
+		require.NoError(t, ip.readSecret())
+		require.NoError(t, ip.initSecretsManager())
+		require.NoError(t, ip.importSecret())
+
+		keyJSON, err := os.ReadFile(filepath.Join(ip.dataDir, secrets.ConsensusFolderLocal, secrets.ValidatorKeyLocal))
+		require.NoError(t, err)
+
+		require.True(t, keystore.IsEncryptedKey(keyJSON))
+
+		_, err = keystore.DecryptKey(keyJSON, "source")
+		assert.Error(t, err)
+
+		raw, err := keystore.DecryptKey(keyJSON, "local")
+		require.NoError(t, err)
+
+		assert.Equal(t, string(key), hex.EncodeToString(raw))
+	})
+
+	t.Run("should keep encrypting the secret replacing an encrypted one", func(t *testing.T) {
+		dir := t.TempDir()
+
+		passwordFile := writeTestFile(t, dir, "password", []byte("local"))
+
+		existingKey, _ := newTestKey(t)
+
+		encryptedManager, err := helper.SetupLocalSecretsManagerWithPassword(dir, true, "", passwordFile)
+		require.NoError(t, err)
+		require.NoError(t, encryptedManager.SetSecret(secrets.ValidatorKey, existingKey))
+
+		ip := &importParams{
+			dataDir:      dir,
+			secretName:   secrets.ValidatorKey,
+			secret:       key,
+			passwordFile: passwordFile,
+			force:        true,
+		}
+
+		require.NoError(t, ip.initSecretsManager())
+		require.NoError(t, ip.importSecret())
+
+		assert.True(t, ip.encrypted)
+		assert.True(t, isEncryptedSecret(ip.secretsManager, secrets.ValidatorKey))
+
+		secret, err := encryptedManager.GetSecret(secrets.ValidatorKey)
+		require.NoError(t, err)
+
+		assert.Equal(t, key, secret)
+	})
+}
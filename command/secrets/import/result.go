@@ -0,0 +1,28 @@
+package secretsimport
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type SecretsImportResult struct {
+	Type      string `json:"type"`
+	Identity  string `json:"identity"`
+	Encrypted bool   `json:"encrypted"`
+}
+
+func (r *SecretsImportResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[SECRETS IMPORT]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Type|%s", r.Type),
+		fmt.Sprintf("Identity|%s", r.Identity),
+		fmt.Sprintf("Encrypted|%t", r.Encrypted),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,143 @@
+package secretsimport
+
+import (
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/helper/keystore"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	secretsImportCmd := &cobra.Command{
+		Use: "import",
+		Short: "Imports a private key from a raw hex file or an encrypted keystore file " +
+			"to the specified Secrets Manager",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(secretsImportCmd)
+	helper.SetRequiredFlags(secretsImportCmd, params.getRequiredFlags())
+
+	return secretsImportCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		dataDirFlag,
+		"",
+		"the directory for the Polygon Edge data if the local FS is used",
+	)
+
+	cmd.Flags().StringVar(
+		&params.configPath,
+		configFlag,
+		"",
+		"the path to the SecretsManager config file, "+
+			"if omitted, the local FS secrets manager is used",
+	)
+
+	cmd.Flags().StringVar(
+		&params.secretType,
+		typeFlag,
+		"",
+		fmt.Sprintf("the type of the imported secret (%s, %s or %s)", ecdsaType, blsType, networkType),
+	)
+
+	cmd.Flags().StringVar(
+		&params.file,
+		fileFlag,
+		"",
+		"the path to the raw hex or keystore JSON file containing the private key",
+	)
+
+	cmd.Flags().StringVar(
+		&params.sourcePasswordFile,
+		sourcePasswordFileFlag,
+		"",
+		"the path to the file containing the password of the imported keystore file. "+
+			"If omitted, the password is read from the "+keystore.PasswordEnvVar+
+			" environment variable or the terminal",
+	)
+
+	cmd.Flags().StringVar(
+		&params.passwordFile,
+		passwordFileFlag,
+		"",
+		"the path to the file containing the password of the encrypted local secrets, only for the local FS. "+
+			"If omitted, the password is read from the "+keystore.PasswordEnvVar+
+			" environment variable or the terminal",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.force,
+		forceFlag,
+		false,
+		"overwrite the secret if it already exists",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.confirmed,
+		yesFlag,
+		false,
+		"skip the confirmation of the derived address / node ID",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.encrypted,
+		encryptFlag,
+		false,
+		"the flag indicating whether the secret is stored in the encrypted keystore file, only for the local FS. "+
+			"The secret replacing an encrypted one is always encrypted",
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(dataDirFlag, configFlag)
+
+	// encryption is supported only by the local FS secrets manager
+	cmd.MarkFlagsMutuallyExclusive(encryptFlag, configFlag)
+	cmd.MarkFlagsMutuallyExclusive(passwordFileFlag, configFlag)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.readSecret(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	if err := params.initSecretsManager(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	if err := params.checkExisting(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	if err := params.confirmImport(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	if err := params.importSecret(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
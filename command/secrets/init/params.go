@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/helper/keystore"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/secrets/helper"
 )
@@ -15,6 +16,9 @@ const (
 	blsFlag     = "bls"
 	networkFlag = "network"
 	numFlag     = "num"
+
+	encryptFlag      = "encrypt"
+	passwordFileFlag = "password-file"
 )
 
 var (
@@ -30,6 +34,10 @@ type initParams struct {
 	generatesBLS     bool
 	generatesNetwork bool
 
+	encrypted    bool
+	passwordFile string
+	password     string
+
 	secretsManager secrets.SecretsManager
 	secretsConfig  *secrets.SecretsManagerConfig
 }
@@ -88,8 +96,30 @@ func (ip *initParams) parseConfig() error {
 	return nil
 }
 
+// initPassword reads the keystore password once,
+// so it can be shared by all the encrypted data directories
+func (ip *initParams) initPassword() error {
+	if !ip.encrypted {
+		return nil
+	}
+
+	password, err := keystore.ReadPassword(ip.passwordFile, true)
+	if err != nil {
+		return err
+	}
+
+	ip.password = password
+
+	return nil
+}
+
 func (ip *initParams) initLocalSecretsManager() error {
-	local, err := helper.SetupLocalSecretsManager(ip.dataDir)
+	local, err := helper.SetupLocalSecretsManagerWithPassword(
+		ip.dataDir,
+		ip.encrypted,
+		ip.password,
+		ip.passwordFile,
+	)
 	if err != nil {
 		return err
 	}
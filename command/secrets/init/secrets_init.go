@@ -6,6 +6,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/helper/keystore"
 )
 
 const (
@@ -64,6 +65,26 @@ func setFlags(cmd *cobra.Command) {
 	// num flag should be used with data-dir flag only so it should not be used with config flag.
 	cmd.MarkFlagsMutuallyExclusive(numFlag, configFlag)
 
+	cmd.Flags().BoolVar(
+		&basicParams.encrypted,
+		encryptFlag,
+		false,
+		"the flag indicating whether the keys are stored in encrypted keystore files, only for the local FS. "+
+			"The password is read from the password file, the "+keystore.PasswordEnvVar+
+			" environment variable, or the terminal",
+	)
+
+	cmd.Flags().StringVar(
+		&basicParams.passwordFile,
+		passwordFileFlag,
+		"",
+		"the path to the file containing the keystore password, only for the local FS",
+	)
+
+	// encryption is supported only by the local FS secrets manager
+	cmd.MarkFlagsMutuallyExclusive(encryptFlag, configFlag)
+	cmd.MarkFlagsMutuallyExclusive(passwordFileFlag, configFlag)
+
 	cmd.Flags().BoolVar(
 		&basicParams.generatesECDSA,
 		ecdsaFlag,
@@ -98,6 +119,12 @@ func runCommand(cmd *cobra.Command, _ []string) {
 	outputter := command.InitializeOutputter(cmd)
 	defer outputter.WriteOutput()
 
+	if err := basicParams.initPassword(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
 	paramsList := newParamsList(basicParams, initNumber)
 	results := make(Results, len(paramsList))
 
@@ -135,6 +162,9 @@ func newParamsList(params initParams, num int) []initParams {
 			generatesECDSA:   params.generatesECDSA,
 			generatesBLS:     params.generatesBLS,
 			generatesNetwork: params.generatesNetwork,
+			encrypted:        params.encrypted,
+			passwordFile:     params.passwordFile,
+			password:         params.password,
 		}
 	}
 
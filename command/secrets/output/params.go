@@ -19,6 +19,8 @@ const (
 	validatorFlag = "validator"
 	blsFlag       = "bls"
 	nodeIDFlag    = "node-id"
+
+	passwordFileFlag = "password-file"
 )
 
 var (
@@ -32,8 +34,9 @@ var (
 )
 
 type outputParams struct {
-	dataDir    string
-	configPath string
+	dataDir      string
+	configPath   string
+	passwordFile string
 
 	outputNodeID    bool
 	outputValidator bool
@@ -134,7 +137,7 @@ func (op *outputParams) initLocalSecretsManager() error {
 		return fmt.Errorf(strings.Join(errs, "\n"))
 	}
 
-	local, err := helper.SetupLocalSecretsManager(op.dataDir)
+	local, err := helper.SetupLocalSecretsManagerWithPassword(op.dataDir, false, "", op.passwordFile)
 	if err != nil {
 		return err
 	}
@@ -58,7 +58,16 @@ func setFlags(cmd *cobra.Command) {
 			"from the provided secrets manager",
 	)
 
+	cmd.Flags().StringVar(
+		&params.passwordFile,
+		passwordFileFlag,
+		"",
+		"the path to the file containing the password of the encrypted keystore files, "+
+			"only for the local FS",
+	)
+
 	cmd.MarkFlagsMutuallyExclusive(dataDirFlag, configFlag)
+	cmd.MarkFlagsMutuallyExclusive(passwordFileFlag, configFlag)
 	cmd.MarkFlagsMutuallyExclusive(nodeIDFlag, validatorFlag, blsFlag)
 }
 
@@ -6,6 +6,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/0xPolygon/polygon-edge/health"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/hashicorp/hcl"
 	"gopkg.in/yaml.v3"
@@ -15,6 +16,7 @@ import (
 type Config struct {
 	GenesisPath              string     `json:"chain_config" yaml:"chain_config"`
 	SecretsConfigPath        string     `json:"secrets_config" yaml:"secrets_config"`
+	SecretsPasswordFile      string     `json:"secrets_password_file" yaml:"secrets_password_file"`
 	DataDir                  string     `json:"data_dir" yaml:"data_dir"`
 	BlockGasTarget           string     `json:"block_gas_target" yaml:"block_gas_target"`
 	GRPCAddr                 string     `json:"grpc_addr" yaml:"grpc_addr"`
@@ -31,11 +33,16 @@ type Config struct {
 	JSONRPCBatchRequestLimit uint64     `json:"json_rpc_batch_request_limit" yaml:"json_rpc_batch_request_limit"`
 	JSONRPCBlockRangeLimit   uint64     `json:"json_rpc_block_range_limit" yaml:"json_rpc_block_range_limit"`
 	JSONLogFormat            bool       `json:"json_log_format" yaml:"json_log_format"`
+	IBFTTransport            string     `json:"ibft_transport" yaml:"ibft_transport"`
+	ReadyMinPeers            uint64     `json:"ready_min_peers" yaml:"ready_min_peers"`
+	ReadyMaxBlockAge         uint64     `json:"ready_max_block_age_s" yaml:"ready_max_block_age_s"`
 }
 
 // Telemetry holds the config details for metric services.
 type Telemetry struct {
 	PrometheusAddr string `json:"prometheus_addr" yaml:"prometheus_addr"`
+	OTLPEndpoint   string `json:"otlp_endpoint" yaml:"otlp_endpoint"`
+	OTLPInsecure   bool   `json:"otlp_insecure" yaml:"otlp_insecure"`
 }
 
 // Network defines the network configuration params
@@ -75,6 +82,16 @@ const (
 	// DefaultJSONRPCBlockRangeLimit maximum block range allowed for json_rpc
 	// requests with fromBlock/toBlock values (e.g. eth_getLogs)
 	DefaultJSONRPCBlockRangeLimit uint64 = 1000
+
+	// DefaultIBFTTransport is the transport of the IBFT messages, relayed by the gossip of all the nodes
+	DefaultIBFTTransport = "gossip"
+
+	// DefaultReadyMinPeers is the minimum number of peers of the node reported as ready
+	DefaultReadyMinPeers = health.DefaultMinPeers
+
+	// DefaultReadyMaxBlockAge is the maximum age in seconds of the head block of the node reported as ready,
+	// zero to derive it from the max empty block interval of the IBFT fork, see health.DefaultMaxBlockAge
+	DefaultReadyMaxBlockAge = uint64(0)
 )
 
 // DefaultConfig returns the default server configuration
@@ -111,6 +128,9 @@ func DefaultConfig() *Config {
 		LogFilePath:              "",
 		JSONRPCBatchRequestLimit: DefaultJSONRPCBatchRequestLimit,
 		JSONRPCBlockRangeLimit:   DefaultJSONRPCBlockRangeLimit,
+		IBFTTransport:            DefaultIBFTTransport,
+		ReadyMinPeers:            DefaultReadyMinPeers,
+		ReadyMaxBlockAge:         DefaultReadyMaxBlockAge,
 	}
 }
 
@@ -12,6 +12,7 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/consensus"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/server"
@@ -148,7 +149,8 @@ func (p *serverParams) initDevConsensusConfig() {
 
 	p.genesisConfig.Params.Engine = map[string]interface{}{
 		string(server.DevConsensus): map[string]interface{}{
-			"interval": p.devInterval,
+			"interval":                 p.devInterval,
+			consensus.KeyConfirmations: p.devConfirmations,
 		},
 	}
 }
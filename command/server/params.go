@@ -3,9 +3,12 @@ package server
 import (
 	"errors"
 	"net"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/command/server/config"
+	"github.com/0xPolygon/polygon-edge/health"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/server"
@@ -19,6 +22,8 @@ const (
 	dataDirFlag                  = "data-dir"
 	libp2pAddressFlag            = "libp2p"
 	prometheusAddressFlag        = "prometheus"
+	otlpEndpointFlag             = "otlp-endpoint"
+	otlpInsecureFlag             = "otlp-insecure"
 	natFlag                      = "nat"
 	dnsFlag                      = "dns"
 	sealFlag                     = "seal"
@@ -32,12 +37,17 @@ const (
 	maxEnqueuedFlag              = "max-enqueued"
 	blockGasTargetFlag           = "block-gas-target"
 	secretsConfigFlag            = "secrets-config"
+	secretsPasswordFileFlag      = "secrets-password-file"
 	restoreFlag                  = "restore"
 	blockTimeFlag                = "block-time"
 	devIntervalFlag              = "dev-interval"
+	devConfirmationsFlag         = "dev-confirmations"
 	devFlag                      = "dev"
 	corsOriginFlag               = "access-control-allow-origins"
 	logFileLocationFlag          = "log-to"
+	ibftTransportFlag            = "ibft-transport"
+	readyMinPeersFlag            = "ready-min-peers"
+	readyMaxBlockAgeFlag         = "ready-max-block-age"
 )
 
 // Flags that are deprecated, but need to be preserved for
@@ -75,9 +85,10 @@ type serverParams struct {
 	grpcAddress       *net.TCPAddr
 	jsonRPCAddress    *net.TCPAddr
 
-	blockGasTarget uint64
-	devInterval    uint64
-	isDevMode      bool
+	blockGasTarget   uint64
+	devInterval      uint64
+	devConfirmations uint64
+	isDevMode        bool
 
 	corsAllowedOrigins []string
 
@@ -106,6 +117,10 @@ func (p *serverParams) isPrometheusAddressSet() bool {
 	return p.rawConfig.Telemetry.PrometheusAddr != ""
 }
 
+func (p *serverParams) isOTLPEndpointSet() bool {
+	return p.rawConfig.Telemetry.OTLPEndpoint != ""
+}
+
 func (p *serverParams) isNATAddressSet() bool {
 	return p.rawConfig.Network.NatAddr != ""
 }
@@ -130,6 +145,17 @@ func (p *serverParams) getRestoreFilePath() *string {
 	return nil
 }
 
+func (p *serverParams) getTracingConfig() *tracing.Config {
+	if !p.isOTLPEndpointSet() {
+		return nil
+	}
+
+	return &tracing.Config{
+		Endpoint: p.rawConfig.Telemetry.OTLPEndpoint,
+		Insecure: p.rawConfig.Telemetry.OTLPInsecure,
+	}
+}
+
 func (p *serverParams) setRawGRPCAddress(grpcAddress string) {
 	p.rawConfig.GRPCAddr = grpcAddress
 }
@@ -155,6 +181,7 @@ func (p *serverParams) generateConfig() *server.Config {
 		LibP2PAddr: p.libp2pAddress,
 		Telemetry: &server.Telemetry{
 			PrometheusAddr: p.prometheusAddress,
+			Tracing:        p.getTracingConfig(),
 		},
 		Network: &network.Config{
 			NoDiscover:       p.rawConfig.Network.NoDiscover,
@@ -167,16 +194,22 @@ func (p *serverParams) generateConfig() *server.Config {
 			MaxOutboundPeers: p.rawConfig.Network.MaxOutboundPeers,
 			Chain:            p.genesisConfig,
 		},
-		DataDir:            p.rawConfig.DataDir,
-		Seal:               p.rawConfig.ShouldSeal,
-		PriceLimit:         p.rawConfig.TxPool.PriceLimit,
-		MaxSlots:           p.rawConfig.TxPool.MaxSlots,
-		MaxAccountEnqueued: p.rawConfig.TxPool.MaxAccountEnqueued,
-		SecretsManager:     p.secretsConfig,
-		RestoreFile:        p.getRestoreFilePath(),
-		BlockTime:          p.rawConfig.BlockTime,
-		LogLevel:           hclog.LevelFromString(p.rawConfig.LogLevel),
-		JSONLogFormat:      p.rawConfig.JSONLogFormat,
-		LogFilePath:        p.logFileLocation,
+		DataDir:             p.rawConfig.DataDir,
+		Seal:                p.rawConfig.ShouldSeal,
+		PriceLimit:          p.rawConfig.TxPool.PriceLimit,
+		MaxSlots:            p.rawConfig.TxPool.MaxSlots,
+		MaxAccountEnqueued:  p.rawConfig.TxPool.MaxAccountEnqueued,
+		SecretsManager:      p.secretsConfig,
+		SecretsPasswordFile: p.rawConfig.SecretsPasswordFile,
+		RestoreFile:         p.getRestoreFilePath(),
+		BlockTime:           p.rawConfig.BlockTime,
+		LogLevel:            hclog.LevelFromString(p.rawConfig.LogLevel),
+		JSONLogFormat:       p.rawConfig.JSONLogFormat,
+		LogFilePath:         p.logFileLocation,
+		IBFTTransport:       p.rawConfig.IBFTTransport,
+		Health: &health.Config{
+			MinPeers:    p.rawConfig.ReadyMinPeers,
+			MaxBlockAge: time.Duration(p.rawConfig.ReadyMaxBlockAge) * time.Second,
+		},
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/command/helper"
 	"github.com/0xPolygon/polygon-edge/command/server/config"
 	"github.com/0xPolygon/polygon-edge/command/server/export"
+	"github.com/0xPolygon/polygon-edge/helper/keystore"
 	"github.com/0xPolygon/polygon-edge/server"
 	"github.com/spf13/cobra"
 )
@@ -82,6 +83,21 @@ func setFlags(cmd *cobra.Command) {
 			"If only port is defined (:port) it will bind to 0.0.0.0:port",
 	)
 
+	cmd.Flags().StringVar(
+		&params.rawConfig.Telemetry.OTLPEndpoint,
+		otlpEndpointFlag,
+		"",
+		"the address and port of the OpenTelemetry collector the traces are exported to over OTLP gRPC "+
+			"(address:port). The tracing is disabled if it's not set",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.Telemetry.OTLPInsecure,
+		otlpInsecureFlag,
+		false,
+		"disable the TLS of the connection to the OpenTelemetry collector",
+	)
+
 	cmd.Flags().StringVar(
 		&params.rawConfig.Network.NatAddr,
 		natFlag,
@@ -111,6 +127,15 @@ func setFlags(cmd *cobra.Command) {
 			"If omitted, the local FS secrets manager is used",
 	)
 
+	cmd.Flags().StringVar(
+		&params.rawConfig.SecretsPasswordFile,
+		secretsPasswordFileFlag,
+		"",
+		"the path to the file containing the password of the encrypted local keystore files. "+
+			"If omitted, the password is read from the "+keystore.PasswordEnvVar+
+			" environment variable or the terminal",
+	)
+
 	cmd.Flags().StringVar(
 		&params.rawConfig.RestoreFile,
 		restoreFlag,
@@ -192,6 +217,14 @@ func setFlags(cmd *cobra.Command) {
 		"minimum block time in seconds (at least 1s)",
 	)
 
+	cmd.Flags().StringVar(
+		&params.rawConfig.IBFTTransport,
+		ibftTransportFlag,
+		defaultConfig.IBFTTransport,
+		"the transport of the IBFT messages: gossip relays them through all the nodes, "+
+			"direct sends them to the validators over dedicated streams",
+	)
+
 	cmd.Flags().StringArrayVar(
 		&params.corsAllowedOrigins,
 		corsOriginFlag,
@@ -214,6 +247,21 @@ func setFlags(cmd *cobra.Command) {
 			"that consider fromBlock/toBlock values (e.g. eth_getLogs), value of 0 disables it",
 	)
 
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.ReadyMinPeers,
+		readyMinPeersFlag,
+		defaultConfig.ReadyMinPeers,
+		"minimum number of connected peers of the node reported as ready by the /ready endpoint",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.ReadyMaxBlockAge,
+		readyMaxBlockAgeFlag,
+		defaultConfig.ReadyMaxBlockAge,
+		"maximum age in seconds of the head block of the node reported as ready by the /ready endpoint, "+
+			"one minute or the max empty block interval plus the block time if empty blocks are skipped when 0",
+	)
+
 	cmd.Flags().StringVar(
 		&params.rawConfig.LogFilePath,
 		logFileLocationFlag,
@@ -258,6 +306,15 @@ func setDevFlags(cmd *cobra.Command) {
 	)
 
 	_ = cmd.Flags().MarkHidden(devIntervalFlag)
+
+	cmd.Flags().Uint64Var(
+		&params.devConfirmations,
+		devConfirmationsFlag,
+		0,
+		"the number of blocks required on top of a block to consider it finalized in dev mode (default 0)",
+	)
+
+	_ = cmd.Flags().MarkHidden(devConfirmationsFlag)
 }
 
 func runPreRun(cmd *cobra.Command, _ []string) error {
@@ -8,10 +8,23 @@ import (
 )
 
 type StatusResult struct {
-	ChainID            int64  `json:"chain_id"`
-	CurrentBlockNumber int64  `json:"current_block_number"`
-	CurrentBlockHash   string `json:"current_block_hash"`
-	LibP2PAddress      string `json:"libp2p_address"`
+	ChainID            int64          `json:"chain_id"`
+	CurrentBlockNumber int64          `json:"current_block_number"`
+	CurrentBlockHash   string         `json:"current_block_hash"`
+	LibP2PAddress      string         `json:"libp2p_address"`
+	Readiness          *ReadinessInfo `json:"readiness,omitempty"`
+}
+
+// ReadinessInfo holds the results of the readiness checks of the client
+type ReadinessInfo struct {
+	Ready  bool             `json:"ready"`
+	Checks []ReadinessCheck `json:"checks"`
+}
+
+type ReadinessCheck struct {
+	Name    string `json:"name"`
+	Passed  bool   `json:"passed"`
+	Message string `json:"message"`
 }
 
 func (r *StatusResult) GetOutput() string {
@@ -25,5 +38,22 @@ func (r *StatusResult) GetOutput() string {
 		fmt.Sprintf("Libp2p Address|%s", r.LibP2PAddress),
 	}))
 
+	if r.Readiness != nil {
+		rows := make([]string, 0, len(r.Readiness.Checks)+1)
+		rows = append(rows, fmt.Sprintf("Ready|%t", r.Readiness.Ready))
+
+		for _, check := range r.Readiness.Checks {
+			result := "passed"
+			if !check.Passed {
+				result = "failed"
+			}
+
+			rows = append(rows, fmt.Sprintf("%s|%s (%s)", check.Name, result, check.Message))
+		}
+
+		buffer.WriteString("\n\n[READINESS]\n")
+		buffer.WriteString(helper.FormatKV(rows))
+	}
+
 	return buffer.String()
 }
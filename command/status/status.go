@@ -40,9 +40,33 @@ func runCommand(cmd *cobra.Command, _ []string) {
 		CurrentBlockNumber: statusResponse.Current.Number,
 		CurrentBlockHash:   statusResponse.Current.Hash,
 		LibP2PAddress:      statusResponse.P2PAddr,
+		Readiness:          newReadinessInfo(statusResponse.Readiness),
 	})
 }
 
+// newReadinessInfo converts the readiness of the status response,
+// it's nil if the client doesn't report the readiness
+func newReadinessInfo(readiness *proto.ServerStatus_Readiness) *ReadinessInfo {
+	if readiness == nil {
+		return nil
+	}
+
+	info := &ReadinessInfo{
+		Ready:  readiness.Ready,
+		Checks: make([]ReadinessCheck, len(readiness.Checks)),
+	}
+
+	for i, check := range readiness.Checks {
+		info.Checks[i] = ReadinessCheck{
+			Name:    check.Name,
+			Passed:  check.Passed,
+			Message: check.Message,
+		}
+	}
+
+	return info
+}
+
 func getSystemStatus(grpcAddress string) (*proto.ServerStatus, error) {
 	client, err := helper.GetSystemClientConnection(
 		grpcAddress,
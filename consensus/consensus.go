@@ -34,6 +34,14 @@ type Consensus interface {
 	// GetSyncProgression retrieves the current sync progression, if any
 	GetSyncProgression() *progress.Progression
 
+	// GetFinalizedNumber returns the number of the latest block that can't be reverted,
+	// given the number of the latest block of the chain
+	GetFinalizedNumber(latest uint64) uint64
+
+	// GetSafeNumber returns the number of the latest block that is unlikely to be reverted,
+	// given the number of the latest block of the chain
+	GetSafeNumber(latest uint64) uint64
+
 	// Initialize initializes the consensus (e.g. setup data)
 	Initialize() error
 
@@ -70,6 +78,7 @@ type Params struct {
 	Logger         hclog.Logger
 	SecretsManager secrets.SecretsManager
 	BlockTime      uint64
+	IBFTTransport  string
 }
 
 // Factory is the factory function to create a discovery consensus
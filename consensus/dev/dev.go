@@ -27,6 +27,9 @@ type Dev struct {
 	interval uint64
 	txpool   *txpool.TxPool
 
+	// number of blocks required on top of a block to finalize the block
+	confirmations uint64
+
 	blockchain *blockchain.Blockchain
 	executor   *state.Executor
 }
@@ -56,6 +59,13 @@ func Factory(
 		d.interval = interval
 	}
 
+	confirmations, err := consensus.GetConfirmations(params.Config.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	d.confirmations = confirmations
+
 	return d, nil
 }
 
@@ -233,6 +243,16 @@ func (d *Dev) PreCommitState(_header *types.Header, _txn *state.Transition) erro
 	return nil
 }
 
+// GetFinalizedNumber returns the number of the latest block with the configured confirmations
+func (d *Dev) GetFinalizedNumber(latest uint64) uint64 {
+	return consensus.ConfirmedNumber(latest, d.confirmations)
+}
+
+// GetSafeNumber returns the same number as GetFinalizedNumber
+func (d *Dev) GetSafeNumber(latest uint64) uint64 {
+	return d.GetFinalizedNumber(latest)
+}
+
 func (d *Dev) GetSyncProgression() *progress.Progression {
 	return nil
 }
@@ -17,18 +17,27 @@ type Dummy struct {
 	txpool     *txpool.TxPool
 	blockchain *blockchain.Blockchain
 	executor   *state.Executor
+
+	// number of blocks required on top of a block to finalize the block
+	confirmations uint64
 }
 
 func Factory(params *consensus.Params) (consensus.Consensus, error) {
 	logger := params.Logger.Named("dummy")
 
+	confirmations, err := consensus.GetConfirmations(params.Config.Config)
+	if err != nil {
+		return nil, err
+	}
+
 	d := &Dummy{
-		logger:     logger,
-		notifyCh:   make(chan struct{}),
-		closeCh:    make(chan struct{}),
-		blockchain: params.Blockchain,
-		executor:   params.Executor,
-		txpool:     params.TxPool,
+		logger:        logger,
+		notifyCh:      make(chan struct{}),
+		closeCh:       make(chan struct{}),
+		blockchain:    params.Blockchain,
+		executor:      params.Executor,
+		txpool:        params.TxPool,
+		confirmations: confirmations,
 	}
 
 	return d, nil
@@ -65,6 +74,16 @@ func (d *Dummy) PreCommitState(_header *types.Header, _txn *state.Transition) er
 	return nil
 }
 
+// GetFinalizedNumber returns the number of the latest block with the configured confirmations
+func (d *Dummy) GetFinalizedNumber(latest uint64) uint64 {
+	return consensus.ConfirmedNumber(latest, d.confirmations)
+}
+
+// GetSafeNumber returns the same number as GetFinalizedNumber
+func (d *Dummy) GetSafeNumber(latest uint64) uint64 {
+	return d.GetFinalizedNumber(latest)
+}
+
 func (d *Dummy) GetSyncProgression() *progress.Progression {
 	return nil
 }
@@ -10,8 +10,10 @@ import (
 	"github.com/0xPolygon/polygon-edge/consensus"
 	"github.com/0xPolygon/polygon-edge/consensus/ibft/signer"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/armon/go-metrics"
 )
 
 func (i *backendIBFT) BuildProposal(blockNumber uint64) []byte {
@@ -30,6 +32,13 @@ func (i *backendIBFT) BuildProposal(blockNumber uint64) []byte {
 		return nil
 	}
 
+	// Propose the block the node is locked on instead of a new one
+	if proposal, _ := i.lockedProposal(blockNumber); proposal != nil {
+		i.logger.Info("proposing the locked proposal from WAL", "num", blockNumber)
+
+		return proposal
+	}
+
 	block, err := i.buildBlock(latestHeader)
 	if err != nil {
 		i.logger.Error("cannot build block", "num", blockNumber, "err", err)
@@ -44,13 +53,19 @@ func (i *backendIBFT) InsertBlock(
 	proposal []byte,
 	committedSeals []*messages.CommittedSeal,
 ) {
+	_, span := otelTracer.Start(context.Background(), "backendIBFT.InsertBlock")
+	defer span.End()
+
 	newBlock := &types.Block{}
 	if err := newBlock.UnmarshalRLP(proposal); err != nil {
 		i.logger.Error("cannot unmarshal proposal", "err", err)
+		tracing.RecordError(span, err)
 
 		return
 	}
 
+	span.SetAttributes(tracing.TxHashes(newBlock.Transactions))
+
 	committedSealsMap := make(map[types.Address][]byte, len(committedSeals))
 
 	for _, cm := range committedSeals {
@@ -66,6 +81,7 @@ func (i *backendIBFT) InsertBlock(
 	header, err := i.currentSigner.WriteCommittedSeals(newBlock.Header, committedSealsMap)
 	if err != nil {
 		i.logger.Error("cannot write committed seals", "err", err)
+		tracing.RecordError(span, err)
 
 		return
 	}
@@ -90,21 +106,33 @@ func (i *backendIBFT) InsertBlock(
 			"before", hex.EncodeToHex(extraDataBackup),
 			"after", hex.EncodeToHex(header.ExtraData),
 			"committedSeals", committedSealsStr)
+		tracing.RecordError(span, err)
 
 		return
 	}
 
 	newBlock.Header = header
 
+	span.SetAttributes(tracing.Block(newBlock.Header)...)
+
 	// Save the block locally
 	if err := i.blockchain.WriteBlock(newBlock, "consensus"); err != nil {
 		i.logger.Error("cannot write block", "err", err)
+		tracing.RecordError(span, err)
 
 		return
 	}
 
+	// The round state of the height is not needed anymore
+	i.pruneWAL(newBlock.Number())
+
 	i.updateMetrics(newBlock)
 
+	if round, ok := i.roundHistory.commit(newBlock.Number()); ok {
+		// the number of the rounds the height took
+		metrics.SetGauge([]string{consensusMetrics, "rounds"}, float32(round+1))
+	}
+
 	i.logger.Info(
 		"block committed",
 		"number", newBlock.Number(),
@@ -135,29 +163,48 @@ func (i *backendIBFT) ID() []byte {
 }
 
 func (i *backendIBFT) MaximumFaultyNodes() uint64 {
+	if i.currentVotingPowers != nil {
+		return uint64(CalcWeightedMaxFaultyNodes(i.currentValidators, i.currentVotingPowers))
+	}
+
 	return uint64(CalcMaxFaultyNodes(i.currentValidators))
 }
 
 func (i *backendIBFT) Quorum(blockNumber uint64) uint64 {
 	validators, err := i.forkManager.GetValidators(blockNumber)
 	if err != nil {
-		i.logger.Error(
-			"failed to get validators when calculation quorum",
-			"height", blockNumber,
-			"err", err,
-		)
+		i.logger.Error("failed to get validators when calculating quorum", "height", blockNumber, "err", err)
 
 		// return Math.MaxInt32 to prevent overflow when casting to int in go-ibft package
 		return math.MaxInt32
 	}
 
+	powers, err := i.forkManager.GetVotingPowers(blockNumber)
+	if err != nil {
+		i.logger.Error("failed to get voting powers when calculating quorum", "height", blockNumber, "err", err)
+
+		return math.MaxInt32
+	}
+
+	// the stake weighted quorum is switched on from the height of the stakeWeighted fork
+	if powers != nil {
+		return uint64(WeightedQuorumSize(validators, powers))
+	}
+
 	quorumFn := i.quorumSize(blockNumber)
 
 	return uint64(quorumFn(validators))
 }
 
 // buildBlock builds the block, based on the passed in snapshot and parent header
-func (i *backendIBFT) buildBlock(parent *types.Header) (*types.Block, error) {
+func (i *backendIBFT) buildBlock(parent *types.Header) (block *types.Block, err error) {
+	ctx, span := otelTracer.Start(context.Background(), "backendIBFT.buildBlock")
+	span.SetAttributes(tracing.BlockNumberKey.Int64(int64(parent.Number + 1)))
+
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
 	header := &types.Header{
 		ParentHash: parent.Hash,
 		Number:     parent.Number + 1,
@@ -184,7 +231,8 @@ func (i *backendIBFT) buildBlock(parent *types.Header) (*types.Block, error) {
 	}
 
 	// Set the header timestamp
-	potentialTimestamp := i.calcHeaderTimestamp(parent.Timestamp, time.Now())
+	isEmpty := i.currentHooks.ShouldWriteTransactions(header.Number) && i.txpool.Length() == 0
+	potentialTimestamp := i.calcHeaderTimestamp(parent.Timestamp, time.Now(), isEmpty)
 	header.Timestamp = uint64(potentialTimestamp.Unix())
 
 	parentCommittedSeals, err := i.extractParentCommittedSeals(parent)
@@ -200,7 +248,7 @@ func (i *backendIBFT) buildBlock(parent *types.Header) (*types.Block, error) {
 	}
 
 	// Get the block transactions
-	writeCtx, cancelFn := context.WithDeadline(context.Background(), potentialTimestamp)
+	writeCtx, cancelFn := context.WithDeadline(ctx, potentialTimestamp)
 	defer cancelFn()
 
 	txs := i.writeTransactions(
@@ -219,32 +267,30 @@ func (i *backendIBFT) buildBlock(parent *types.Header) (*types.Block, error) {
 	header.GasUsed = transition.TotalGas()
 
 	// build the block
-	block := consensus.BuildBlock(consensus.BuildBlockParams{
+	block = consensus.BuildBlock(consensus.BuildBlockParams{
 		Header:   header,
 		Txns:     txs,
 		Receipts: transition.Receipts(),
 	})
 
-	// write the seal of the block after all the fields are completed
-	header, err = i.currentSigner.WriteProposerSeal(header)
-	if err != nil {
+	// the transactions may have been dropped after the timestamp was set,
+	// don't propose the empty block the validators would reject
+	if err := i.verifyEmptyBlock(parent, block.Header, i.currentHooks); err != nil {
 		return nil, err
 	}
 
-	block.Header = header
-
-	// compute the hash, this is only a provisional hash since the final one
-	// is sealed after all the committed seals
-	block.Header.ComputeHash()
+	span.SetAttributes(tracing.TxHashes(txs))
 
+	// the proposer seal is written once the round of the proposal is known
 	i.logger.Info("build block", "number", header.Number, "txs", len(txs))
 
 	return block, nil
 }
 
 // calcHeaderTimestamp calculates the new block timestamp, based
-// on the block time and parent timestamp
-func (i *backendIBFT) calcHeaderTimestamp(parentUnix uint64, currentTime time.Time) time.Time {
+// on the block time and parent timestamp.
+// The empty block is not produced before the max empty block interval passes if empty blocks are skipped
+func (i *backendIBFT) calcHeaderTimestamp(parentUnix uint64, currentTime time.Time, isEmpty bool) time.Time {
 	var (
 		parentTimestamp    = time.Unix(int64(parentUnix), 0)
 		potentialTimestamp = parentTimestamp.Add(i.blockTime)
@@ -258,6 +304,12 @@ func (i *backendIBFT) calcHeaderTimestamp(parentUnix uint64, currentTime time.Ti
 		potentialTimestamp = roundUpTime(currentTime, i.blockTime)
 	}
 
+	if isEmpty && i.currentMaxEmptyBlockInterval > 0 {
+		if earliest := emptyBlockTime(parentUnix, i.currentMaxEmptyBlockInterval); potentialTimestamp.Before(earliest) {
+			potentialTimestamp = earliest
+		}
+	}
+
 	return potentialTimestamp
 }
 
@@ -323,6 +375,7 @@ write:
 		default:
 			// execute transactions one by one
 			result, ok := i.writeTransaction(
+				writeCtx,
 				i.txpool.Peek(),
 				transition,
 				gasLimit,
@@ -353,6 +406,7 @@ write:
 }
 
 func (i *backendIBFT) writeTransaction(
+	ctx context.Context,
 	tx *types.Transaction,
 	transition transitionInterface,
 	gasLimit uint64,
@@ -361,6 +415,11 @@ func (i *backendIBFT) writeTransaction(
 		return nil, false
 	}
 
+	_, span := otelTracer.Start(ctx, "backendIBFT.writeTransaction")
+	span.SetAttributes(tracing.TxHash(tx.Hash))
+
+	defer span.End()
+
 	if tx.ExceedsBlockGasLimit(gasLimit) {
 		i.txpool.Drop(tx)
 
@@ -378,6 +437,8 @@ func (i *backendIBFT) writeTransaction(
 	}
 
 	if err := transition.Write(tx); err != nil {
+		tracing.RecordError(span, err)
+
 		if _, ok := err.(*state.GasLimitReachedTransitionApplicationError); ok { //nolint:errorlint
 			// stop processing
 			return nil, false
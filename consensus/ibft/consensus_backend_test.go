@@ -16,10 +16,12 @@ func TestIBFTBackend_CalculateHeaderTimestamp(t *testing.T) {
 	now := time.Unix(time.Now().Unix(), 0) // Round down
 
 	testTable := []struct {
-		name            string
-		parentTimestamp int64
-		currentTime     time.Time
-		blockTime       uint64
+		name                  string
+		parentTimestamp       int64
+		currentTime           time.Time
+		blockTime             uint64
+		isEmpty               bool
+		maxEmptyBlockInterval uint64
 
 		expectedTimestamp time.Time
 	}{
@@ -28,6 +30,8 @@ func TestIBFTBackend_CalculateHeaderTimestamp(t *testing.T) {
 			now.Add(time.Duration(-1) * time.Second).Unix(), // 1s before
 			now,
 			1,
+			false,
+			0,
 			now, // 1s after
 		},
 		{
@@ -35,8 +39,46 @@ func TestIBFTBackend_CalculateHeaderTimestamp(t *testing.T) {
 			now.Add(time.Duration(-4) * time.Second).Unix(), // 4s before
 			now,
 			3,
+			false,
+			0,
 			roundUpTime(now, 3*time.Second),
 		},
+		{
+			"Empty block is not delayed if empty blocks are not skipped",
+			now.Add(time.Duration(-1) * time.Second).Unix(), // 1s before
+			now,
+			1,
+			true,
+			0,
+			now,
+		},
+		{
+			"Empty block is delayed until the max empty block interval",
+			now.Add(time.Duration(-1) * time.Second).Unix(), // 1s before
+			now,
+			1,
+			true,
+			10,
+			now.Add(9 * time.Second), // 10s after the parent
+		},
+		{
+			"Block with transactions is not delayed",
+			now.Add(time.Duration(-1) * time.Second).Unix(), // 1s before
+			now,
+			1,
+			false,
+			10,
+			now,
+		},
+		{
+			"Empty block after the max empty block interval",
+			now.Add(time.Duration(-12) * time.Second).Unix(), // 12s before
+			now,
+			2,
+			true,
+			10,
+			roundUpTime(now, 2*time.Second),
+		},
 	}
 
 	for _, testCase := range testTable {
@@ -46,7 +88,8 @@ func TestIBFTBackend_CalculateHeaderTimestamp(t *testing.T) {
 			t.Parallel()
 
 			i := &backendIBFT{
-				blockTime: time.Duration(testCase.blockTime) * time.Second,
+				blockTime:                    time.Duration(testCase.blockTime) * time.Second,
+				currentMaxEmptyBlockInterval: time.Duration(testCase.maxEmptyBlockInterval) * time.Second,
 			}
 
 			assert.Equal(
@@ -55,6 +98,7 @@ func TestIBFTBackend_CalculateHeaderTimestamp(t *testing.T) {
 				i.calcHeaderTimestamp(
 					uint64(testCase.parentTimestamp),
 					testCase.currentTime,
+					testCase.isEmpty,
 				).Unix(),
 			)
 		})
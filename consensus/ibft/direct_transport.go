@@ -0,0 +1,218 @@
+package ibft
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	protoIBFT "github.com/0xPolygon/go-ibft/messages/proto"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
+	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/network/grpc"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/validators"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+	rawGrpc "google.golang.org/grpc"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+const (
+	ibftDirectProto = "/ibft/direct/0.1"
+
+	// directSendTimeout is the timeout to send a message to a validator
+	directSendTimeout = 2 * time.Second
+)
+
+// directNetwork is the interface of the network the direct transport opens the streams in
+type directNetwork interface {
+	RegisterProtocol(string, network.Protocol)
+	NewProtoConnection(protocol string, peerID peer.ID) (*rawGrpc.ClientConn, error)
+	IsConnected(peerID peer.ID) bool
+}
+
+// directTransport sends the messages to the validators over the streams dedicated to IBFT.
+// The message is gossiped instead if any of the validators can't be reached directly
+type directTransport struct {
+	proto.UnimplementedIbftTransportServer
+
+	logger  hclog.Logger
+	network directNetwork
+	stream  *grpc.GrpcStream
+	gossip  transport       // fallback for the validators without the direct stream
+	peers   *validatorPeers // peer IDs the validators announced
+
+	getValidators func(height uint64) (validators.Validators, error) // validators at the height
+	deliver       func(msg *protoIBFT.Message)                       // handles the message received
+
+	connsLock sync.Mutex
+	conns     map[peer.ID]*rawGrpc.ClientConn
+}
+
+func newDirectTransport(
+	logger hclog.Logger,
+	network directNetwork,
+	gossip transport,
+	peers *validatorPeers,
+	getValidators func(uint64) (validators.Validators, error),
+	deliver func(*protoIBFT.Message),
+) *directTransport {
+	return &directTransport{
+		logger:        logger,
+		network:       network,
+		gossip:        gossip,
+		peers:         peers,
+		getValidators: getValidators,
+		deliver:       deliver,
+		conns:         make(map[peer.ID]*rawGrpc.ClientConn),
+	}
+}
+
+// start registers the gRPC service receiving the messages from the validators
+func (d *directTransport) start() {
+	d.stream = grpc.NewGrpcStream()
+
+	proto.RegisterIbftTransportServer(d.stream.GrpcServer(), d)
+	d.stream.Serve()
+	d.network.RegisterProtocol(ibftDirectProto, d.stream)
+}
+
+// close stops the gRPC service and closes the streams to the validators
+func (d *directTransport) close() error {
+	d.connsLock.Lock()
+	for peerID, conn := range d.conns {
+		_ = conn.Close()
+
+		delete(d.conns, peerID)
+	}
+	d.connsLock.Unlock()
+
+	if d.stream == nil {
+		return nil
+	}
+
+	return d.stream.Close()
+}
+
+// Message is a gRPC endpoint to receive a message from a validator
+func (d *directTransport) Message(_ context.Context, req *proto.IbftMessage) (*empty.Empty, error) {
+	msg := &protoIBFT.Message{}
+	if err := protobuf.Unmarshal(req.Data, msg); err != nil {
+		return nil, err
+	}
+
+	d.deliver(msg)
+
+	return &empty.Empty{}, nil
+}
+
+// Multicast sends the message to the validators at the height of the message and to this node
+func (d *directTransport) Multicast(msg *protoIBFT.Message) error {
+	vals, err := d.getValidators(msg.GetView().GetHeight())
+	if err != nil {
+		return d.gossip.Multicast(msg)
+	}
+
+	data, err := protobuf.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	var (
+		sender    = types.BytesToAddress(msg.From)
+		peerIDs   = make([]peer.ID, 0, vals.Len())
+		useGossip = false
+	)
+
+	for idx := 0; idx < vals.Len(); idx++ {
+		addr := vals.At(uint64(idx)).Addr()
+		if addr == sender {
+			continue
+		}
+
+		peerID, ok := d.peers.get(addr)
+		if !ok || !d.network.IsConnected(peerID) {
+			useGossip = true
+
+			break
+		}
+
+		peerIDs = append(peerIDs, peerID)
+	}
+
+	if useGossip {
+		// the gossip reaches all the validators including this node
+		return d.gossip.Multicast(msg)
+	}
+
+	for _, peerID := range peerIDs {
+		go d.send(peerID, data, msg)
+	}
+
+	go d.deliver(msg)
+
+	return nil
+}
+
+// send sends the message to the peer, the message is gossiped if the peer can't be reached
+func (d *directTransport) send(peerID peer.ID, data []byte, msg *protoIBFT.Message) {
+	if err := d.sendTo(peerID, data); err != nil {
+		d.logger.Debug("failed to send message directly, gossiping", "peer", peerID, "err", err)
+
+		if err := d.gossip.Multicast(msg); err != nil {
+			d.logger.Error("fail to gossip", "err", err)
+		}
+	}
+}
+
+func (d *directTransport) sendTo(peerID peer.ID, data []byte) error {
+	conn, err := d.getConn(peerID)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), directSendTimeout)
+	defer cancel()
+
+	if _, err := proto.NewIbftTransportClient(conn).Message(ctx, &proto.IbftMessage{Data: data}); err != nil {
+		d.removeConn(peerID, conn)
+
+		return err
+	}
+
+	return nil
+}
+
+// getConn returns the stream to the peer, a new one is opened if there is none
+func (d *directTransport) getConn(peerID peer.ID) (*rawGrpc.ClientConn, error) {
+	d.connsLock.Lock()
+	defer d.connsLock.Unlock()
+
+	if conn, ok := d.conns[peerID]; ok {
+		return conn, nil
+	}
+
+	conn, err := d.network.NewProtoConnection(ibftDirectProto, peerID)
+	if err != nil {
+		return nil, err
+	}
+
+	d.conns[peerID] = conn
+
+	return conn, nil
+}
+
+// removeConn closes the broken stream to the peer
+func (d *directTransport) removeConn(peerID peer.ID, conn *rawGrpc.ClientConn) {
+	d.connsLock.Lock()
+	defer d.connsLock.Unlock()
+
+	if d.conns[peerID] != conn {
+		return
+	}
+
+	_ = conn.Close()
+
+	delete(d.conns, peerID)
+}
@@ -0,0 +1,162 @@
+package ibft
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	protoIBFT "github.com/0xPolygon/go-ibft/messages/proto"
+	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/validators"
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	rawGrpc "google.golang.org/grpc"
+)
+
+var errTestConnection = errors.New("connection failed")
+
+type mockDirectNetwork struct {
+	connected map[peer.ID]bool
+}
+
+func (m *mockDirectNetwork) RegisterProtocol(string, network.Protocol) {}
+
+func (m *mockDirectNetwork) NewProtoConnection(string, peer.ID) (*rawGrpc.ClientConn, error) {
+	return nil, errTestConnection
+}
+
+func (m *mockDirectNetwork) IsConnected(peerID peer.ID) bool {
+	return m.connected[peerID]
+}
+
+type mockTransport struct {
+	msgCh chan *protoIBFT.Message
+}
+
+func (m *mockTransport) Multicast(msg *protoIBFT.Message) error {
+	m.msgCh <- msg
+
+	return nil
+}
+
+func TestValidatorPeers_Set(t *testing.T) {
+	t.Parallel()
+
+	pool := newTesterAccountPool(t, 1)
+	addr := pool.accounts[0].Address()
+
+	peers := newValidatorPeers()
+
+	_, ok := peers.get(addr)
+	assert.False(t, ok)
+
+	assert.True(t, peers.set(addr, peer.ID("A"), 10))
+
+	// the older announcement is ignored
+	assert.False(t, peers.set(addr, peer.ID("B"), 9))
+	assert.False(t, peers.set(addr, peer.ID("B"), 10))
+
+	peerID, ok := peers.get(addr)
+	assert.True(t, ok)
+	assert.Equal(t, peer.ID("A"), peerID)
+
+	assert.True(t, peers.set(addr, peer.ID("B"), 11))
+
+	peerID, _ = peers.get(addr)
+	assert.Equal(t, peer.ID("B"), peerID)
+}
+
+func TestDirectTransport_Multicast(t *testing.T) {
+	t.Parallel()
+
+	pool := newTesterAccountPool(t, 3)
+	vals := pool.ValidatorSet()
+
+	msg := &protoIBFT.Message{
+		View: &protoIBFT.View{Height: 1},
+		From: pool.accounts[0].Address().Bytes(),
+	}
+
+	testTable := []struct {
+		name      string
+		announced []int // indexes of the validators which announced the peer IDs
+		connected []int // indexes of the validators connected to this node
+		delivered bool  // whether the message is delivered to this node directly
+	}{
+		{
+			"Gossip if a validator has not announced the peer ID",
+			[]int{1},
+			[]int{1, 2},
+			false,
+		},
+		{
+			"Gossip if a validator is not connected",
+			[]int{1, 2},
+			[]int{1},
+			false,
+		},
+		{
+			"Gossip if sending to a validator fails",
+			[]int{1, 2},
+			[]int{1, 2},
+			true,
+		},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				peers      = newValidatorPeers()
+				net        = &mockDirectNetwork{connected: map[peer.ID]bool{}}
+				gossip     = &mockTransport{msgCh: make(chan *protoIBFT.Message, vals.Len())}
+				deliveryCh = make(chan *protoIBFT.Message, 1)
+			)
+
+			for _, idx := range testCase.announced {
+				peers.set(pool.accounts[idx].Address(), peer.ID(pool.accounts[idx].alias), 1)
+			}
+
+			for _, idx := range testCase.connected {
+				net.connected[peer.ID(pool.accounts[idx].alias)] = true
+			}
+
+			transport := newDirectTransport(
+				hclog.NewNullLogger(),
+				net,
+				gossip,
+				peers,
+				func(uint64) (validators.Validators, error) {
+					return vals, nil
+				},
+				func(msg *protoIBFT.Message) {
+					deliveryCh <- msg
+				},
+			)
+
+			assert.NoError(t, transport.Multicast(msg))
+
+			select {
+			case gossiped := <-gossip.msgCh:
+				assert.Equal(t, msg, gossiped)
+			case <-time.After(time.Second):
+				t.Fatal("message has not been gossiped")
+			}
+
+			if testCase.delivered {
+				select {
+				case delivered := <-deliveryCh:
+					assert.Equal(t, msg, delivered)
+				case <-time.After(time.Second):
+					t.Fatal("message has not been delivered")
+				}
+			} else {
+				assert.Empty(t, deliveryCh)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package ibft
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/fork"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// pendingTxsPollInterval is the interval of checking the transaction pool
+	// while waiting for transactions to produce a block
+	pendingTxsPollInterval = 100 * time.Millisecond
+)
+
+var (
+	ErrEmptyBlockTooEarly = errors.New("empty block is produced before the max empty block interval")
+)
+
+// getMaxEmptyBlockInterval returns the max interval between the empty blocks at the given height,
+// 0 if empty blocks are not skipped
+func getMaxEmptyBlockInterval(forkManager forkManagerInterface, height uint64) (time.Duration, error) {
+	ibftFork, err := forkManager.GetFork(height)
+	if err != nil {
+		return 0, err
+	}
+
+	if ibftFork.MaxEmptyBlockInterval == nil {
+		return 0, nil
+	}
+
+	return time.Duration(ibftFork.MaxEmptyBlockInterval.Value) * time.Second, nil
+}
+
+// GetMaxBlockInterval returns the max interval between the parent and the block at the given height,
+// the max empty block interval plus the block time if the empty blocks are skipped, 0 otherwise
+func (i *backendIBFT) GetMaxBlockInterval(height uint64) (time.Duration, error) {
+	interval, err := getMaxEmptyBlockInterval(i.forkManager, height)
+	if err != nil || interval == 0 {
+		return 0, err
+	}
+
+	blockTime, err := getBlockTime(i.forkManager, height, i.defaultBlockTime)
+	if err != nil {
+		return 0, err
+	}
+
+	return interval + blockTime, nil
+}
+
+// emptyBlockTime returns the earliest time the empty block can be produced after the parent
+func emptyBlockTime(parentUnix uint64, interval time.Duration) time.Time {
+	return time.Unix(int64(parentUnix), 0).Add(interval)
+}
+
+// isSkippableEmptyBlock returns whether the header is of an empty block that may be skipped,
+// the blocks without transactions by the hooks are not skipped
+func isSkippableEmptyBlock(header *types.Header, hooks fork.HooksInterface) bool {
+	return header.TxRoot == types.EmptyRootHash && hooks.ShouldWriteTransactions(header.Number)
+}
+
+// verifyEmptyBlock verifies the empty block is not produced before the max empty block interval
+// passes since the parent block if empty blocks are skipped
+func (i *backendIBFT) verifyEmptyBlock(parent, header *types.Header, hooks fork.HooksInterface) error {
+	if !isSkippableEmptyBlock(header, hooks) {
+		return nil
+	}
+
+	interval, err := getMaxEmptyBlockInterval(i.forkManager, header.Number)
+	if err != nil {
+		return err
+	}
+
+	if interval == 0 {
+		return nil
+	}
+
+	if earliest := emptyBlockTime(parent.Timestamp, interval); header.Timestamp < uint64(earliest.Unix()) {
+		return fmt.Errorf(
+			"%w: timestamp %d, earliest %d",
+			ErrEmptyBlockTooEarly, header.Timestamp, earliest.Unix(),
+		)
+	}
+
+	return nil
+}
+
+// waitForPendingTransactions waits until there are transactions to include into the next block,
+// a validator has started the sequence of the next block, or the max empty block interval passes
+// since the parent block. It returns false if the wait is interrupted
+func (i *backendIBFT) waitForPendingTransactions(parent *types.Header, interrupt <-chan struct{}) bool {
+	interval := i.currentMaxEmptyBlockInterval
+	if interval == 0 {
+		return true
+	}
+
+	// start the sequence one block time earlier so that the empty block is sealed at the interval
+	timer := time.NewTimer(time.Until(emptyBlockTime(parent.Timestamp, interval).Add(-i.blockTime)))
+	defer timer.Stop()
+
+	ticker := time.NewTicker(pendingTxsPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if i.txpool.Length() > 0 || atomic.LoadUint64(&i.pendingMessageHeight) == parent.Number+1 {
+			return true
+		}
+
+		select {
+		case <-timer.C:
+			return true
+		case <-ticker.C:
+		case <-interrupt:
+			return false
+		case <-i.closeCh:
+			return false
+		}
+	}
+}
+
+// observeMessageHeight records that a validator has sent a consensus message of the pending height.
+// The messages of the other heights are ignored, the record is only valid until the next block is written
+func (i *backendIBFT) observeMessageHeight(height uint64) {
+	if height != i.blockchain.Header().Number+1 {
+		return
+	}
+
+	atomic.StoreUint64(&i.pendingMessageHeight, height)
+}
@@ -13,10 +13,35 @@ const (
 	KeyType          = "type"
 	KeyTypes         = "types"
 	KeyValidatorType = "validator_type"
+	KeyStakeWeighted = "stakeWeighted"
+	KeyJailThreshold = "jailThreshold"
+
+	// KeyMaxEmptyBlockInterval is the key of the maximum interval in seconds between the blocks
+	// while there are no transactions
+	KeyMaxEmptyBlockInterval = "maxEmptyBlockInterval"
+
+	// KeyLivenessWindow is the key of the number of heights the liveness of validators is tracked for
+	KeyLivenessWindow = "livenessWindow"
+
+	// KeyBlockTime is the key of the minimum block time in seconds
+	KeyBlockTime = "blockTime"
+
+	// KeyBaseRoundTimeout is the key of the timeout in seconds of the first round at a height
+	KeyBaseRoundTimeout = "baseRoundTimeout"
+
+	// KeyAdaptiveRoundTimeout is the key of the flag lengthening the round timeout by the recent round changes
+	KeyAdaptiveRoundTimeout = "adaptiveRoundTimeout"
 )
 
 var (
 	ErrUndefinedIBFTConfig = errors.New("IBFT config is not defined")
+	ErrStakeWeightedNotPoS = errors.New("stake weighted voting is only available in PoS")
+	ErrJailingNotPoS       = errors.New("jailing is only available in PoS")
+	ErrInvalidWindow       = errors.New("invalid liveness window")
+
+	ErrInvalidEmptyBlockInterval = errors.New("max empty block interval must be positive")
+	ErrInvalidBlockTime          = errors.New("block time must be positive")
+	ErrInvalidBaseRoundTimeout   = errors.New("base round timeout must be positive")
 )
 
 // IBFT Fork represents setting in params.engine.ibft of genesis.json
@@ -33,6 +58,31 @@ type IBFTFork struct {
 	// PoS
 	MaxValidatorCount *common.JSONNumber `json:"maxValidatorCount,omitempty"`
 	MinValidatorCount *common.JSONNumber `json:"minValidatorCount,omitempty"`
+
+	// StakeWeighted enables the proposer selection and the quorum based on the stake of the validators
+	StakeWeighted bool `json:"stakeWeighted,omitempty"`
+
+	// JailThreshold enables jailing of the validators missing more committed seals than the threshold
+	// in the liveness window, the jailed validators are unstaked by the consensus
+	JailThreshold *common.JSONNumber `json:"jailThreshold,omitempty"`
+
+	// LivenessWindow is the number of heights the liveness of the validators is tracked for,
+	// liveness.DefaultWindow if not set
+	LivenessWindow *common.JSONNumber `json:"livenessWindow,omitempty"`
+
+	// MaxEmptyBlockInterval enables skipping empty blocks, the blocks are produced only
+	// when there are transactions or the interval in seconds has passed since the parent block
+	MaxEmptyBlockInterval *common.JSONNumber `json:"maxEmptyBlockInterval,omitempty"`
+
+	// BlockTime is the minimum block time in seconds, the block time of the server is used if not set
+	BlockTime *common.JSONNumber `json:"blockTime,omitempty"`
+
+	// BaseRoundTimeout is the timeout in seconds of the first round at a height,
+	// the timeout grows in the next rounds. The block time is added to the default timeout if not set
+	BaseRoundTimeout *common.JSONNumber `json:"baseRoundTimeout,omitempty"`
+
+	// AdaptiveRoundTimeout lengthens the base round timeout by the rounds the recent heights took
+	AdaptiveRoundTimeout bool `json:"adaptiveRoundTimeout,omitempty"`
 }
 
 func (f *IBFTFork) UnmarshalJSON(data []byte) error {
@@ -45,6 +95,14 @@ func (f *IBFTFork) UnmarshalJSON(data []byte) error {
 		Validators        interface{}               `json:"validators,omitempty"`
 		MaxValidatorCount *common.JSONNumber        `json:"maxValidatorCount,omitempty"`
 		MinValidatorCount *common.JSONNumber        `json:"minValidatorCount,omitempty"`
+		StakeWeighted     bool                      `json:"stakeWeighted,omitempty"`
+		JailThreshold     *common.JSONNumber        `json:"jailThreshold,omitempty"`
+		LivenessWindow    *common.JSONNumber        `json:"livenessWindow,omitempty"`
+
+		MaxEmptyBlockInterval *common.JSONNumber `json:"maxEmptyBlockInterval,omitempty"`
+		BlockTime             *common.JSONNumber `json:"blockTime,omitempty"`
+		BaseRoundTimeout      *common.JSONNumber `json:"baseRoundTimeout,omitempty"`
+		AdaptiveRoundTimeout  bool               `json:"adaptiveRoundTimeout,omitempty"`
 	}{}
 
 	if err := json.Unmarshal(data, &raw); err != nil {
@@ -57,6 +115,37 @@ func (f *IBFTFork) UnmarshalJSON(data []byte) error {
 	f.To = raw.To
 	f.MaxValidatorCount = raw.MaxValidatorCount
 	f.MinValidatorCount = raw.MinValidatorCount
+	f.StakeWeighted = raw.StakeWeighted
+	f.JailThreshold = raw.JailThreshold
+	f.LivenessWindow = raw.LivenessWindow
+	f.MaxEmptyBlockInterval = raw.MaxEmptyBlockInterval
+	f.BlockTime = raw.BlockTime
+	f.BaseRoundTimeout = raw.BaseRoundTimeout
+	f.AdaptiveRoundTimeout = raw.AdaptiveRoundTimeout
+
+	if f.StakeWeighted && f.Type != PoS {
+		return ErrStakeWeightedNotPoS
+	}
+
+	if f.JailThreshold != nil && f.Type != PoS {
+		return ErrJailingNotPoS
+	}
+
+	if f.LivenessWindow != nil && f.LivenessWindow.Value == 0 {
+		return ErrInvalidWindow
+	}
+
+	if f.MaxEmptyBlockInterval != nil && f.MaxEmptyBlockInterval.Value == 0 {
+		return ErrInvalidEmptyBlockInterval
+	}
+
+	if f.BlockTime != nil && f.BlockTime.Value == 0 {
+		return ErrInvalidBlockTime
+	}
+
+	if f.BaseRoundTimeout != nil && f.BaseRoundTimeout.Value == 0 {
+		return ErrInvalidBaseRoundTimeout
+	}
 
 	f.ValidatorType = validators.ECDSAValidatorType
 	if raw.ValidatorType != nil {
@@ -98,6 +187,64 @@ func GetIBFTForks(ibftConfig map[string]interface{}) (IBFTForks, error) {
 			}
 		}
 
+		stakeWeighted, _ := ibftConfig[KeyStakeWeighted].(bool)
+		if stakeWeighted && typ != PoS {
+			return nil, ErrStakeWeightedNotPoS
+		}
+
+		var jailThreshold *common.JSONNumber
+
+		if rawThreshold, ok := ibftConfig[KeyJailThreshold].(float64); ok {
+			if typ != PoS {
+				return nil, ErrJailingNotPoS
+			}
+
+			jailThreshold = &common.JSONNumber{Value: uint64(rawThreshold)}
+		}
+
+		var livenessWindow *common.JSONNumber
+
+		if rawWindow, ok := ibftConfig[KeyLivenessWindow]; ok {
+			window, ok := rawWindow.(float64)
+			if !ok || window < 1 {
+				return nil, ErrInvalidWindow
+			}
+
+			livenessWindow = &common.JSONNumber{Value: uint64(window)}
+		}
+
+		var maxEmptyBlockInterval *common.JSONNumber
+
+		if rawInterval, ok := ibftConfig[KeyMaxEmptyBlockInterval].(float64); ok {
+			if rawInterval < 1 {
+				return nil, ErrInvalidEmptyBlockInterval
+			}
+
+			maxEmptyBlockInterval = &common.JSONNumber{Value: uint64(rawInterval)}
+		}
+
+		var blockTime *common.JSONNumber
+
+		if rawBlockTime, ok := ibftConfig[KeyBlockTime].(float64); ok {
+			if rawBlockTime < 1 {
+				return nil, ErrInvalidBlockTime
+			}
+
+			blockTime = &common.JSONNumber{Value: uint64(rawBlockTime)}
+		}
+
+		var baseRoundTimeout *common.JSONNumber
+
+		if rawTimeout, ok := ibftConfig[KeyBaseRoundTimeout].(float64); ok {
+			if rawTimeout < 1 {
+				return nil, ErrInvalidBaseRoundTimeout
+			}
+
+			baseRoundTimeout = &common.JSONNumber{Value: uint64(rawTimeout)}
+		}
+
+		adaptiveRoundTimeout, _ := ibftConfig[KeyAdaptiveRoundTimeout].(bool)
+
 		return IBFTForks{
 			{
 				Type:          typ,
@@ -105,6 +252,14 @@ func GetIBFTForks(ibftConfig map[string]interface{}) (IBFTForks, error) {
 				ValidatorType: validatorType,
 				From:          common.JSONNumber{Value: 0},
 				To:            nil,
+				StakeWeighted: stakeWeighted,
+				JailThreshold: jailThreshold,
+
+				LivenessWindow:        livenessWindow,
+				MaxEmptyBlockInterval: maxEmptyBlockInterval,
+				BlockTime:             blockTime,
+				BaseRoundTimeout:      baseRoundTimeout,
+				AdaptiveRoundTimeout:  adaptiveRoundTimeout,
 			},
 		}, nil
 	}
@@ -90,6 +90,20 @@ func TestIBFTForkUnmarshalJSON(t *testing.T) {
 				MinValidatorCount: nil,
 			},
 		},
+		{
+			name: "should parse stake weighted PoS",
+			data: fmt.Sprintf(`{
+				"type": "%s",
+				"from": %d,
+				"stakeWeighted": true
+			}`, PoS, 21),
+			expected: &IBFTFork{
+				Type:          PoS,
+				ValidatorType: validators.ECDSAValidatorType,
+				From:          common.JSONNumber{Value: 21},
+				StakeWeighted: true,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -159,6 +173,215 @@ func TestGetIBFTForks(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			name: "should return a single stake weighted fork if IBFTConfig has stakeWeighted",
+			config: map[string]interface{}{
+				"type":          "PoS",
+				"stakeWeighted": true,
+			},
+			res: IBFTForks{
+				{
+					Type:          PoS,
+					ValidatorType: validators.ECDSAValidatorType,
+					Deployment:    nil,
+					From:          common.JSONNumber{Value: 0},
+					To:            nil,
+					StakeWeighted: true,
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "should return error if stakeWeighted is set in PoA",
+			config: map[string]interface{}{
+				"type":          "PoA",
+				"stakeWeighted": true,
+			},
+			res: nil,
+			err: ErrStakeWeightedNotPoS,
+		},
+		{
+			name: "should return error if stakeWeighted is set in PoA fork",
+			config: map[string]interface{}{
+				"types": []interface{}{
+					map[string]interface{}{
+						"type":          "PoA",
+						"from":          0,
+						"stakeWeighted": true,
+					},
+				},
+			},
+			res: nil,
+			err: ErrStakeWeightedNotPoS,
+		},
+		{
+			name: "should return a single fork with jailing if IBFTConfig has jailThreshold",
+			config: map[string]interface{}{
+				"type":          "PoS",
+				"jailThreshold": float64(50),
+			},
+			res: IBFTForks{
+				{
+					Type:          PoS,
+					ValidatorType: validators.ECDSAValidatorType,
+					Deployment:    nil,
+					From:          common.JSONNumber{Value: 0},
+					To:            nil,
+					JailThreshold: &common.JSONNumber{Value: 50},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "should return error if jailThreshold is set in PoA",
+			config: map[string]interface{}{
+				"type":          "PoA",
+				"jailThreshold": float64(50),
+			},
+			res: nil,
+			err: ErrJailingNotPoS,
+		},
+		{
+			name: "should return error if jailThreshold is set in PoA fork",
+			config: map[string]interface{}{
+				"types": []interface{}{
+					map[string]interface{}{
+						"type":          "PoA",
+						"from":          0,
+						"jailThreshold": "0x32",
+					},
+				},
+			},
+			res: nil,
+			err: ErrJailingNotPoS,
+		},
+		{
+			name: "should return a single fork with livenessWindow if IBFTConfig has livenessWindow",
+			config: map[string]interface{}{
+				"type":           "PoS",
+				"livenessWindow": float64(200),
+			},
+			res: IBFTForks{
+				{
+					Type:           PoS,
+					ValidatorType:  validators.ECDSAValidatorType,
+					Deployment:     nil,
+					From:           common.JSONNumber{Value: 0},
+					To:             nil,
+					LivenessWindow: &common.JSONNumber{Value: 200},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "should return error if livenessWindow is not a number",
+			config: map[string]interface{}{
+				"type":           "PoS",
+				"livenessWindow": "200",
+			},
+			res: nil,
+			err: ErrInvalidWindow,
+		},
+		{
+			name: "should return error if livenessWindow is zero in fork",
+			config: map[string]interface{}{
+				"types": []interface{}{
+					map[string]interface{}{
+						"type":           "PoS",
+						"from":           0,
+						"livenessWindow": "0x0",
+					},
+				},
+			},
+			res: nil,
+			err: ErrInvalidWindow,
+		},
+		{
+			name: "should return a single fork skipping empty blocks if IBFTConfig has maxEmptyBlockInterval",
+			config: map[string]interface{}{
+				"type":                  "PoA",
+				"maxEmptyBlockInterval": float64(60),
+			},
+			res: IBFTForks{
+				{
+					Type:                  PoA,
+					ValidatorType:         validators.ECDSAValidatorType,
+					Deployment:            nil,
+					From:                  common.JSONNumber{Value: 0},
+					To:                    nil,
+					MaxEmptyBlockInterval: &common.JSONNumber{Value: 60},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "should return error if maxEmptyBlockInterval is zero",
+			config: map[string]interface{}{
+				"type":                  "PoA",
+				"maxEmptyBlockInterval": float64(0),
+			},
+			res: nil,
+			err: ErrInvalidEmptyBlockInterval,
+		},
+		{
+			name: "should return error if maxEmptyBlockInterval is zero in fork",
+			config: map[string]interface{}{
+				"types": []interface{}{
+					map[string]interface{}{
+						"type":                  "PoS",
+						"from":                  0,
+						"maxEmptyBlockInterval": "0x0",
+					},
+				},
+			},
+			res: nil,
+			err: ErrInvalidEmptyBlockInterval,
+		},
+		{
+			name: "should return a single fork with round timing if IBFTConfig has block time and round timeout",
+			config: map[string]interface{}{
+				"type":                 "PoA",
+				"blockTime":            float64(3),
+				"baseRoundTimeout":     float64(20),
+				"adaptiveRoundTimeout": true,
+			},
+			res: IBFTForks{
+				{
+					Type:                 PoA,
+					ValidatorType:        validators.ECDSAValidatorType,
+					Deployment:           nil,
+					From:                 common.JSONNumber{Value: 0},
+					To:                   nil,
+					BlockTime:            &common.JSONNumber{Value: 3},
+					BaseRoundTimeout:     &common.JSONNumber{Value: 20},
+					AdaptiveRoundTimeout: true,
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "should return error if blockTime is zero",
+			config: map[string]interface{}{
+				"type":      "PoA",
+				"blockTime": float64(0),
+			},
+			res: nil,
+			err: ErrInvalidBlockTime,
+		},
+		{
+			name: "should return error if baseRoundTimeout is zero in fork",
+			config: map[string]interface{}{
+				"types": []interface{}{
+					map[string]interface{}{
+						"type":             "PoA",
+						"from":             0,
+						"baseRoundTimeout": "0x0",
+					},
+				},
+			},
+			res: nil,
+			err: ErrInvalidBaseRoundTimeout,
+		},
 		{
 			name: "should return multiple forks",
 			config: map[string]interface{}{
@@ -2,6 +2,9 @@ package fork
 
 import (
 	"github.com/0xPolygon/polygon-edge/consensus/ibft/hook"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/liveness"
+	"github.com/0xPolygon/polygon-edge/validators"
+	"github.com/hashicorp/go-hclog"
 )
 
 // PoAHookRegisterer that registers hooks for PoA mode
@@ -59,15 +62,19 @@ func (r *PoAHookRegister) RegisterHooks(hooks *hook.Hooks, height uint64) {
 
 // PoAHookRegisterer that registers hooks for PoS mode
 type PoSHookRegister struct {
+	logger              hclog.Logger
 	posForks            IBFTForks
 	epochSize           uint64
 	deployContractForks map[uint64]*IBFTFork
+	getLiveness         func(uint64) (*liveness.Tracker, error)
 }
 
 // NewPoSHookRegister is a constructor of PoSHookRegister
 func NewPoSHookRegister(
+	logger hclog.Logger,
 	forks IBFTForks,
 	epochSize uint64,
+	getLiveness func(uint64) (*liveness.Tracker, error),
 ) *PoSHookRegister {
 	posForks := forks.filterByType(PoS)
 
@@ -81,22 +88,84 @@ func NewPoSHookRegister(
 		deployContractForks[fork.Deployment.Value] = fork
 	}
 
+	for _, fork := range posForks {
+		if fork.JailThreshold == nil || fork.Deployment != nil {
+			continue
+		}
+
+		// the jailing needs the jail method, upgrade the contract code at the beginning of the fork.
+		// The genesis contract is upgraded in the first block
+		upgradeHeight := fork.From.Value
+		if upgradeHeight == 0 {
+			upgradeHeight = 1
+		}
+
+		if _, ok := deployContractForks[upgradeHeight]; !ok {
+			deployContractForks[upgradeHeight] = fork
+		}
+	}
+
 	return &PoSHookRegister{
+		logger:              logger,
 		posForks:            posForks,
 		epochSize:           epochSize,
 		deployContractForks: deployContractForks,
+		getLiveness:         getLiveness,
 	}
 }
 
-// RegisterHooks registers hooks of PoA for additional block verification and contract deployment
+// RegisterHooks registers hooks of PoA for additional block verification, contract deployment
+// and jailing of offline validators
 func (r *PoSHookRegister) RegisterHooks(hooks *hook.Hooks, height uint64) {
-	if currentFork := r.posForks.getFork(height); currentFork != nil {
+	currentFork := r.posForks.getFork(height)
+	if currentFork != nil {
 		// in PoS mode currently
 		registerTxInclusionGuardHooks(hooks, r.epochSize)
 	}
 
 	if deploymentFork, ok := r.deployContractForks[height]; ok {
 		// deploy or update staking contract in deployment height
-		registerStakingContractDeploymentHooks(hooks, deploymentFork)
+		registerStakingContractDeploymentHooks(hooks, deploymentFork, height)
 	}
+
+	if currentFork != nil && currentFork.JailThreshold != nil {
+		// jail the offline validators after the other state changes
+		registerJailingHooks(hooks, r.logger, currentFork.JailThreshold.Value, r.getLiveness)
+	}
+}
+
+// ChainParamsHookRegister registers hooks to apply the votes for the chain parameters
+type ChainParamsHookRegister struct {
+	logger        hclog.Logger
+	epochSize     uint64
+	posForks      IBFTForks
+	getValidators func(uint64) (validators.Validators, error)
+}
+
+// NewChainParamsHookRegister is a constructor of ChainParamsHookRegister
+func NewChainParamsHookRegister(
+	logger hclog.Logger,
+	epochSize uint64,
+	forks IBFTForks,
+	getValidators func(uint64) (validators.Validators, error),
+) *ChainParamsHookRegister {
+	return &ChainParamsHookRegister{
+		logger:        logger,
+		epochSize:     epochSize,
+		posForks:      forks.filterByType(PoS),
+		getValidators: getValidators,
+	}
+}
+
+// RegisterHooks registers hooks to set the voted chain parameters in the end of the epoch,
+// the new values take effect from the first block of the next epoch.
+// The votes are weighted by the stakes of the validators in PoS
+func (r *ChainParamsHookRegister) RegisterHooks(hooks *hook.Hooks, height uint64) {
+	if r.epochSize == 0 || height == 0 || height%r.epochSize != 0 {
+		return
+	}
+
+	stakeWeighted := r.posForks.getFork(height) != nil
+
+	registerChainParamsHooks(hooks, r.logger, stakeWeighted, r.getValidators)
 }
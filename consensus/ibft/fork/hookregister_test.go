@@ -0,0 +1,55 @@
+package fork
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPoSHookRegister(t *testing.T) {
+	t.Parallel()
+
+	var (
+		genesisJailFork = &IBFTFork{
+			Type:          PoS,
+			From:          common.JSONNumber{Value: 0},
+			To:            &common.JSONNumber{Value: 99},
+			JailThreshold: &common.JSONNumber{Value: 10},
+		}
+		deploymentFork = &IBFTFork{
+			Type:       PoS,
+			Deployment: &common.JSONNumber{Value: 150},
+			From:       common.JSONNumber{Value: 200},
+			To:         &common.JSONNumber{Value: 299},
+		}
+		jailFork = &IBFTFork{
+			Type:          PoS,
+			From:          common.JSONNumber{Value: 300},
+			To:            &common.JSONNumber{Value: 399},
+			JailThreshold: &common.JSONNumber{Value: 10},
+		}
+		jailDeploymentFork = &IBFTFork{
+			Type:          PoS,
+			Deployment:    &common.JSONNumber{Value: 350},
+			From:          common.JSONNumber{Value: 400},
+			JailThreshold: &common.JSONNumber{Value: 10},
+		}
+	)
+
+	register := NewPoSHookRegister(
+		hclog.NewNullLogger(),
+		IBFTForks{genesisJailFork, deploymentFork, jailFork, jailDeploymentFork},
+		10,
+		nil,
+	)
+
+	// the forks jailing without the deployment upgrade the contract at their beginning
+	assert.Equal(t, map[uint64]*IBFTFork{
+		1:   genesisJailFork,
+		150: deploymentFork,
+		300: jailFork,
+		350: jailDeploymentFork,
+	}, register.deployContractForks)
+}
@@ -2,8 +2,11 @@ package fork
 
 import (
 	"errors"
+	"sync"
 
 	"github.com/0xPolygon/polygon-edge/consensus/ibft/hook"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/liveness"
+	"github.com/0xPolygon/polygon-edge/contracts/chainparams"
 	"github.com/0xPolygon/polygon-edge/contracts/staking"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
 	stakingHelper "github.com/0xPolygon/polygon-edge/helper/staking"
@@ -11,6 +14,8 @@ import (
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/0xPolygon/polygon-edge/validators"
 	"github.com/0xPolygon/polygon-edge/validators/store"
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
 )
 
 var (
@@ -82,20 +87,21 @@ func registerTxInclusionGuardHooks(hooks *hook.Hooks, epochSize uint64) {
 }
 
 // registerStakingContractDeploymentHooks registers hooks
-// to deploy or update staking contract
+// to deploy or update staking contract of the fork at the given height
 func registerStakingContractDeploymentHooks(
 	hooks *hook.Hooks,
 	fork *IBFTFork,
+	deploymentHeight uint64,
 ) {
 	hooks.PreCommitStateFunc = func(header *types.Header, txn *state.Transition) error {
 		// safe check
-		if header.Number != fork.Deployment.Value {
+		if header.Number != deploymentHeight {
 			return nil
 		}
 
 		if txn.AccountExists(staking.AddrStakingContract) {
 			// update bytecode of deployed contract
-			codeBytes, err := hex.DecodeHex(stakingHelper.StakingSCBytecode)
+			codeBytes, err := hex.DecodeHex(stakingHelper.GetStakingSCBytecode(fork.JailThreshold != nil))
 			if err != nil {
 				return err
 			}
@@ -117,6 +123,135 @@ func registerStakingContractDeploymentHooks(
 	}
 }
 
+// registerJailingHooks registers hooks to unstake the validators
+// missing more committed seals than the threshold in the liveness window.
+// The validators that have no stake or were jailed in the window are skipped
+func registerJailingHooks(
+	hooks *hook.Hooks,
+	logger hclog.Logger,
+	threshold uint64,
+	getLiveness func(uint64) (*liveness.Tracker, error),
+) {
+	var (
+		preCommitState = hooks.PreCommitStateFunc
+
+		// the heights the validators were jailed at
+		jailedLock sync.Mutex
+		jailed     = make(map[types.Address]uint64)
+	)
+
+	hooks.PreCommitStateFunc = func(header *types.Header, txn *state.Transition) error {
+		if preCommitState != nil {
+			if err := preCommitState(header, txn); err != nil {
+				return err
+			}
+		}
+
+		// the committed seals of the parent are not in the chain yet
+		if header.Number < 3 {
+			return nil
+		}
+
+		tracker, err := getLiveness(header.Number - 2)
+		if err != nil {
+			return err
+		}
+
+		jailedLock.Lock()
+		defer jailedLock.Unlock()
+
+		for _, validator := range tracker.Exceeding(threshold) {
+			// the block may be built more than once at the same height, so only the earlier heights are counted
+			if jailedAt, ok := jailed[validator]; ok && jailedAt < header.Number && header.Number-jailedAt <= tracker.Window() {
+				continue
+			}
+
+			// the jailed validator stays in the validator set until the end of the epoch
+			stake, err := staking.SystemQueryAccountStake(txn, validator)
+			if err != nil {
+				return err
+			}
+
+			if stake.Sign() == 0 {
+				continue
+			}
+
+			res, err := staking.JailValidator(txn, validator)
+			if err != nil {
+				return err
+			}
+
+			// the call is reverted if the validator can't be unstaked,
+			// the result is the same in all nodes so that the block is still valid
+			if res.Failed() {
+				metrics.IncrCounter([]string{"consensus", "jail_failures"}, 1)
+
+				logger.Error("failed to jail validator", "height", header.Number, "validator", validator, "err", res.Err)
+
+				continue
+			}
+
+			jailed[validator] = header.Number
+
+			logger.Info("jailed offline validator", "height", header.Number, "validator", validator)
+		}
+
+		return nil
+	}
+}
+
+// registerChainParamsHooks registers hooks to set the chain parameters voted by more than 2/3
+// of the validators in the chain parameters contract, weighted by the stakes if stakeWeighted
+func registerChainParamsHooks(
+	hooks *hook.Hooks,
+	logger hclog.Logger,
+	stakeWeighted bool,
+	getValidators func(uint64) (validators.Validators, error),
+) {
+	preCommitState := hooks.PreCommitStateFunc
+
+	hooks.PreCommitStateFunc = func(header *types.Header, txn *state.Transition) error {
+		if preCommitState != nil {
+			if err := preCommitState(header, txn); err != nil {
+				return err
+			}
+		}
+
+		vals, err := getValidators(header.Number)
+		if err != nil {
+			return err
+		}
+
+		addrs := make([]types.Address, vals.Len())
+		for idx := range addrs {
+			addrs[idx] = vals.At(uint64(idx)).Addr()
+		}
+
+		var powers validators.VotingPowers
+
+		if stakeWeighted {
+			powers = make(validators.VotingPowers, len(addrs))
+
+			for _, addr := range addrs {
+				if powers[addr], err = staking.SystemQueryAccountStake(txn, addr); err != nil {
+					return err
+				}
+			}
+		}
+
+		changes, err := chainparams.ApplyVotes(txn, addrs, powers)
+		if err != nil {
+			return err
+		}
+
+		for _, change := range changes {
+			logger.Info("chain parameter changed", "height", header.Number, "key", change.Key, "value", change.Value)
+		}
+
+		return nil
+	}
+}
+
 // getPreDeployParams returns PredeployParams for Staking Contract from IBFTFork
 func getPreDeployParams(fork *IBFTFork) stakingHelper.PredeployParams {
 	params := stakingHelper.PredeployParams{
@@ -132,5 +267,7 @@ func getPreDeployParams(fork *IBFTFork) stakingHelper.PredeployParams {
 		params.MaxValidatorCount = fork.MaxValidatorCount.Value
 	}
 
+	params.Jailing = fork.JailThreshold != nil
+
 	return params
 }
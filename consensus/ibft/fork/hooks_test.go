@@ -1,14 +1,21 @@
 package fork
 
 import (
+	"bytes"
 	"errors"
+	"math/big"
 	"testing"
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/consensus/ibft/hook"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/liveness"
+	"github.com/0xPolygon/polygon-edge/contracts/abis"
+	"github.com/0xPolygon/polygon-edge/contracts/chainparams"
 	"github.com/0xPolygon/polygon-edge/contracts/staking"
 	"github.com/0xPolygon/polygon-edge/crypto"
+	chainParamsHelper "github.com/0xPolygon/polygon-edge/helper/chainparams"
 	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
 	stakingHelper "github.com/0xPolygon/polygon-edge/helper/staking"
 	"github.com/0xPolygon/polygon-edge/state"
 	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
@@ -17,6 +24,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/validators/store"
 	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
+	"github.com/umbracle/ethgo"
 )
 
 type mockHeaderModifierStore struct {
@@ -308,7 +316,7 @@ func Test_registerStakingContractDeploymentHooks(t *testing.T) {
 		},
 	}
 
-	registerStakingContractDeploymentHooks(hooks, fork)
+	registerStakingContractDeploymentHooks(hooks, fork, fork.Deployment.Value)
 
 	assert.Nil(t, hooks.ShouldWriteTransactionFunc)
 	assert.Nil(t, hooks.ModifyHeaderFunc)
@@ -340,6 +348,12 @@ func Test_registerStakingContractDeploymentHooks(t *testing.T) {
 		txn.AccountExists(staking.AddrStakingContract),
 	)
 
+	assert.Equal(
+		t,
+		hex.MustDecodeHex(stakingHelper.StakingSCBytecode),
+		txn.GetCode(staking.AddrStakingContract),
+	)
+
 	// should update only bytecode (if contract is deployed again, it returns error)
 	assert.NoError(
 		t,
@@ -350,6 +364,359 @@ func Test_registerStakingContractDeploymentHooks(t *testing.T) {
 		t,
 		txn.AccountExists(staking.AddrStakingContract),
 	)
+
+	// should upgrade the contract to the one with the jail method in the jailing fork
+	jailHooks := &hook.Hooks{}
+
+	registerStakingContractDeploymentHooks(jailHooks, &IBFTFork{
+		JailThreshold: &common.JSONNumber{Value: 10},
+	}, 20)
+
+	assert.NoError(
+		t,
+		jailHooks.PreCommitState(&types.Header{Number: 20}, txn),
+	)
+
+	assert.Equal(
+		t,
+		hex.MustDecodeHex(stakingHelper.StakingSCJailBytecode),
+		txn.GetCode(staking.AddrStakingContract),
+	)
+}
+
+func Test_registerJailingHooks(t *testing.T) {
+	t.Parallel()
+
+	var (
+		validator1 = types.StringToAddress("100")
+		validator2 = types.StringToAddress("200")
+		validator3 = types.StringToAddress("300")
+
+		errTest = errors.New("test")
+	)
+
+	newTestHooks := func(t *testing.T, logger hclog.Logger, tracker *liveness.Tracker, err error) *hook.Hooks {
+		t.Helper()
+
+		hooks := &hook.Hooks{}
+
+		registerStakingContractDeploymentHooks(hooks, &IBFTFork{
+			Deployment:    &common.JSONNumber{Value: 10},
+			JailThreshold: &common.JSONNumber{Value: 1},
+			Validators: validators.NewECDSAValidatorSet(
+				validators.NewECDSAValidator(validator1),
+				validators.NewECDSAValidator(validator2),
+				validators.NewECDSAValidator(validator3),
+			),
+		}, 10)
+
+		registerJailingHooks(hooks, logger, 1, func(height uint64) (*liveness.Tracker, error) {
+			if tracker != nil {
+				assert.Equal(t, tracker.Height(), height)
+			}
+
+			return tracker, err
+		})
+
+		return hooks
+	}
+
+	queryValidators := func(t *testing.T, txn *state.Transition) []types.Address {
+		t.Helper()
+
+		method := abis.StakingABI.Methods["validators"]
+
+		res := txn.Call2(types.ZeroAddress, staking.AddrStakingContract, method.ID(), big.NewInt(0), 1000000)
+		assert.NoError(t, res.Err)
+
+		validators, err := staking.DecodeValidators(method, res.ReturnValue)
+		assert.NoError(t, err)
+
+		return validators
+	}
+
+	t.Run("should unstake the validators exceeding the threshold", func(t *testing.T) {
+		t.Parallel()
+
+		tracker := liveness.NewTracker(10)
+		allValidators := []types.Address{validator1, validator2, validator3}
+
+		for height := uint64(1); height <= 8; height++ {
+			tracker.Record(height, allValidators, []types.Address{validator1, validator3}, validator1)
+		}
+
+		txn := newTestTransition(t)
+
+		assert.NoError(
+			t,
+			newTestHooks(t, hclog.NewNullLogger(), tracker, nil).PreCommitState(&types.Header{Number: 10}, txn),
+		)
+
+		rawStakedBalance := stakingHelper.DefaultStakedBalance
+
+		stakedBalance, err := types.ParseUint256orHex(&rawStakedBalance)
+		assert.NoError(t, err)
+
+		assert.Equal(t, []types.Address{validator1, validator3}, queryValidators(t, txn))
+		assert.Equal(t, stakedBalance, txn.GetBalance(validator2))
+	})
+
+	t.Run("should ignore the validators that can't be unstaked", func(t *testing.T) {
+		t.Parallel()
+
+		tracker := liveness.NewTracker(10)
+		unknown := types.StringToAddress("400")
+
+		for height := uint64(1); height <= 8; height++ {
+			tracker.Record(height, []types.Address{validator1, unknown}, []types.Address{validator1}, validator1)
+		}
+
+		txn := newTestTransition(t)
+
+		assert.NoError(
+			t,
+			newTestHooks(t, hclog.NewNullLogger(), tracker, nil).PreCommitState(&types.Header{Number: 10}, txn),
+		)
+
+		assert.Equal(t, []types.Address{validator1, validator2, validator3}, queryValidators(t, txn))
+	})
+
+	t.Run("should skip the validators without stake", func(t *testing.T) {
+		t.Parallel()
+
+		tracker := liveness.NewTracker(10)
+		allValidators := []types.Address{validator1, validator2, validator3}
+
+		for height := uint64(1); height <= 8; height++ {
+			tracker.Record(height, allValidators, []types.Address{validator1, validator3}, validator1)
+		}
+
+		txn := newTestTransition(t)
+
+		assert.NoError(
+			t,
+			newTestHooks(t, hclog.NewNullLogger(), tracker, nil).PreCommitState(&types.Header{Number: 10}, txn),
+		)
+
+		// the jailed validator stays in the validator set until the end of the epoch
+		output := &bytes.Buffer{}
+		logger := hclog.New(&hclog.LoggerOptions{Output: output})
+
+		assert.NoError(
+			t,
+			newTestHooks(t, logger, tracker, nil).PreCommitState(&types.Header{Number: 10}, txn),
+		)
+
+		assert.NotContains(t, output.String(), "failed to jail validator")
+		assert.Equal(t, []types.Address{validator1, validator3}, queryValidators(t, txn))
+	})
+
+	t.Run("should skip the validators jailed in the window", func(t *testing.T) {
+		t.Parallel()
+
+		tracker := liveness.NewTracker(10)
+		allValidators := []types.Address{validator1, validator2, validator3}
+
+		for height := uint64(1); height <= 8; height++ {
+			tracker.Record(height, allValidators, []types.Address{validator1, validator3}, validator1)
+		}
+
+		txn := newTestTransition(t)
+		hooks := newTestHooks(t, hclog.NewNullLogger(), tracker, nil)
+
+		assert.NoError(t, hooks.PreCommitState(&types.Header{Number: 10}, txn))
+
+		// the validator stakes again before the misses are out of the window
+		input, err := abis.StakingABI.Methods["stake"].Encode([]interface{}{})
+		assert.NoError(t, err)
+
+		res := txn.Call2(validator2, staking.AddrStakingContract, input, ethgo.Ether(1), 1000000)
+		assert.NoError(t, res.Err)
+
+		tracker.Record(9, allValidators, []types.Address{validator1, validator3}, validator1)
+
+		assert.NoError(t, hooks.PreCommitState(&types.Header{Number: 11}, txn))
+
+		assert.Equal(t, []types.Address{validator1, validator3, validator2}, queryValidators(t, txn))
+	})
+
+	t.Run("should return error if liveness is not available", func(t *testing.T) {
+		t.Parallel()
+
+		assert.ErrorIs(
+			t,
+			newTestHooks(t, hclog.NewNullLogger(), nil, errTest).PreCommitState(&types.Header{Number: 10}, newTestTransition(t)),
+			errTest,
+		)
+	})
+}
+
+func Test_registerChainParamsHooks(t *testing.T) {
+	t.Parallel()
+
+	var (
+		validator1 = types.StringToAddress("100")
+		validator2 = types.StringToAddress("200")
+		validator3 = types.StringToAddress("300")
+
+		errTest = errors.New("test")
+	)
+
+	newTestChainParamsTransition := func(t *testing.T) *state.Transition {
+		t.Helper()
+
+		vals := validators.NewECDSAValidatorSet(
+			validators.NewECDSAValidator(validator1),
+			validators.NewECDSAValidator(validator2),
+			validators.NewECDSAValidator(validator3),
+		)
+
+		account, err := chainParamsHelper.PredeployChainParamsSC(vals)
+		assert.NoError(t, err)
+
+		stakingAccount, err := stakingHelper.PredeployStakingSC(vals, stakingHelper.PredeployParams{
+			MinValidatorCount: stakingHelper.MinValidatorCount,
+			MaxValidatorCount: stakingHelper.MaxValidatorCount,
+		})
+		assert.NoError(t, err)
+
+		ex := state.NewExecutor(&chain.Params{
+			Forks: chain.AllForksEnabled,
+		}, itrie.NewState(itrie.NewMemoryStorage()), hclog.NewNullLogger())
+
+		rootHash := ex.WriteGenesis(map[types.Address]*chain.GenesisAccount{
+			chainparams.AddrChainParamsContract: account,
+			staking.AddrStakingContract:         stakingAccount,
+			validator1:                          {Balance: ethgo.Ether(100)},
+		})
+		ex.GetHash = func(h *types.Header) state.GetHashByNumber {
+			return func(i uint64) types.Hash {
+				return rootHash
+			}
+		}
+
+		transition, err := ex.BeginTxn(rootHash, &types.Header{}, types.ZeroAddress)
+		assert.NoError(t, err)
+
+		return transition
+	}
+
+	vote := func(t *testing.T, txn *state.Transition, voter types.Address, value uint64) {
+		t.Helper()
+
+		input, err := abis.ChainParametersABI.Methods["vote"].Encode(
+			[]interface{}{chainparams.KeyMinGasPrice, new(big.Int).SetUint64(value)},
+		)
+		assert.NoError(t, err)
+
+		res := txn.Call2(voter, chainparams.AddrChainParamsContract, input, big.NewInt(0), 1000000)
+		assert.NoError(t, res.Err)
+	}
+
+	newTestHooks := func(t *testing.T, stakeWeighted bool, err error) *hook.Hooks {
+		t.Helper()
+
+		hooks := &hook.Hooks{}
+
+		registerChainParamsHooks(hooks, hclog.NewNullLogger(), stakeWeighted, func(height uint64) (validators.Validators, error) {
+			assert.Equal(t, uint64(10), height)
+
+			return validators.NewECDSAValidatorSet(
+				validators.NewECDSAValidator(validator1),
+				validators.NewECDSAValidator(validator2),
+				validators.NewECDSAValidator(validator3),
+			), err
+		})
+
+		return hooks
+	}
+
+	t.Run("should set the parameter voted by the quorum", func(t *testing.T) {
+		t.Parallel()
+
+		txn := newTestChainParamsTransition(t)
+
+		vote(t, txn, validator1, 100)
+		vote(t, txn, validator2, 100)
+		vote(t, txn, validator3, 100)
+
+		assert.NoError(
+			t,
+			newTestHooks(t, false, nil).PreCommitState(&types.Header{Number: 10}, txn),
+		)
+
+		value, ok, err := chainparams.QueryParameter(txn, chainparams.KeyMinGasPrice)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, big.NewInt(100), value)
+	})
+
+	t.Run("should not set the parameter without the quorum", func(t *testing.T) {
+		t.Parallel()
+
+		txn := newTestChainParamsTransition(t)
+
+		vote(t, txn, validator1, 100)
+		vote(t, txn, validator2, 200)
+
+		assert.NoError(
+			t,
+			newTestHooks(t, false, nil).PreCommitState(&types.Header{Number: 10}, txn),
+		)
+
+		_, ok, err := chainparams.QueryParameter(txn, chainparams.KeyMinGasPrice)
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("should weight the votes by the stakes", func(t *testing.T) {
+		t.Parallel()
+
+		txn := newTestChainParamsTransition(t)
+
+		input, err := abis.StakingABI.Methods["stake"].Encode([]interface{}{})
+		assert.NoError(t, err)
+
+		res := txn.Call2(validator1, staking.AddrStakingContract, input, ethgo.Ether(100), 1000000)
+		assert.NoError(t, res.Err)
+
+		// the stakes weight the votes of the round started at the end of the epoch
+		hooks := newTestHooks(t, true, nil)
+		assert.NoError(t, hooks.PreCommitState(&types.Header{Number: 10}, txn))
+
+		vote(t, txn, validator1, 100)
+
+		assert.NoError(t, hooks.PreCommitState(&types.Header{Number: 10}, txn))
+
+		value, ok, err := chainparams.QueryParameter(txn, chainparams.KeyMinGasPrice)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, big.NewInt(100), value)
+
+		// the vote of a validator isn't enough without the stakes
+		otherTxn := newTestChainParamsTransition(t)
+
+		hooks = newTestHooks(t, false, nil)
+		assert.NoError(t, hooks.PreCommitState(&types.Header{Number: 10}, otherTxn))
+
+		vote(t, otherTxn, validator1, 100)
+
+		assert.NoError(t, hooks.PreCommitState(&types.Header{Number: 10}, otherTxn))
+
+		_, ok, err = chainparams.QueryParameter(otherTxn, chainparams.KeyMinGasPrice)
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("should return error if validators are not available", func(t *testing.T) {
+		t.Parallel()
+
+		assert.ErrorIs(
+			t,
+			newTestHooks(t, false, errTest).PreCommitState(&types.Header{Number: 10}, newTestChainParamsTransition(t)),
+			errTest,
+		)
+	})
 }
 
 func Test_getPreDeployParams(t *testing.T) {
@@ -2,12 +2,15 @@ package fork
 
 import (
 	"errors"
+	"path/filepath"
+	"sync"
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/consensus/ibft/hook"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/liveness"
 	"github.com/0xPolygon/polygon-edge/consensus/ibft/signer"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/slashing"
 	"github.com/0xPolygon/polygon-edge/secrets"
-	"github.com/0xPolygon/polygon-edge/secrets/awskms"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/0xPolygon/polygon-edge/validators"
@@ -27,6 +30,9 @@ var (
 	ErrSignerNotFound         = errors.New("signer not found")
 	ErrValidatorStoreNotFound = errors.New("validator set not found")
 	ErrKeyManagerNotFound     = errors.New("key manager not found")
+	ErrGenesisNotFound        = errors.New("genesis header not found")
+	ErrVotingPowerUnsupported = errors.New("validator store doesn't support voting powers")
+	ErrHeaderNotFound         = errors.New("header not found")
 )
 
 // ValidatorStore is an interface that ForkManager calls for Validator Store
@@ -38,6 +44,12 @@ type ValidatorStore interface {
 	GetValidators(height, epochSize, forkFrom uint64) (validators.Validators, error)
 }
 
+// VotingPowerStore is an interface that ForkManager calls for the voting powers of the validators
+type VotingPowerStore interface {
+	// GetVotingPowers is a method to return the voting powers of validators at the given height
+	GetVotingPowers(height, epochSize, forkFrom uint64) (validators.VotingPowers, error)
+}
+
 // HookRegister is an interface that ForkManager calls for hook registrations
 type HooksRegister interface {
 	// RegisterHooks register hooks for the given block height
@@ -75,6 +87,16 @@ type ForkManager struct {
 	keyManagers     map[validators.ValidatorType]signer.KeyManager
 	validatorStores map[store.SourceType]ValidatorStore
 	hooksRegisters  map[IBFTType]HooksRegister
+
+	// hooks of the chain parameters contract, nil if not enabled
+	chainParamsHooksRegister HooksRegister
+
+	// slashing protection checked by the signers
+	slashingProtection *slashing.Store
+
+	// liveness of the validators, synced with the chain on demand
+	liveness     *liveness.Tracker
+	livenessLock sync.Mutex
 }
 
 // NewForkManager is a constructor of ForkManager
@@ -105,6 +127,7 @@ func NewForkManager(
 		keyManagers:     make(map[validators.ValidatorType]signer.KeyManager),
 		validatorStores: make(map[store.SourceType]ValidatorStore),
 		hooksRegisters:  make(map[IBFTType]HooksRegister),
+		liveness:        liveness.NewTracker(liveness.DefaultWindow),
 	}
 
 	// Need initialization of signers in the constructor
@@ -118,6 +141,10 @@ func NewForkManager(
 
 // Initialize initializes ForkManager on initialization phase
 func (m *ForkManager) Initialize() error {
+	if err := m.initializeSlashingProtection(); err != nil {
+		return err
+	}
+
 	if err := m.initializeValidatorStores(); err != nil {
 		return err
 	}
@@ -135,9 +162,23 @@ func (m *ForkManager) Close() error {
 		}
 	}
 
+	if m.slashingProtection != nil {
+		return m.slashingProtection.Close()
+	}
+
 	return nil
 }
 
+// GetFork returns the IBFT fork active at specified height
+func (m *ForkManager) GetFork(height uint64) (*IBFTFork, error) {
+	fork := m.forks.getFork(height)
+	if fork == nil {
+		return nil, ErrForkNotFound
+	}
+
+	return fork, nil
+}
+
 // GetSigner returns a proper signer at specified height
 func (m *ForkManager) GetSigner(height uint64) (signer.Signer, error) {
 	keyManager, err := m.getKeyManager(height)
@@ -153,10 +194,16 @@ func (m *ForkManager) GetSigner(height uint64) (signer.Signer, error) {
 		}
 	}
 
-	return signer.NewSigner(
+	ibftSigner := signer.NewSigner(
 		keyManager,
 		parentKeyManager,
-	), nil
+	)
+
+	if m.slashingProtection != nil {
+		ibftSigner.SetSlashingProtection(m.slashingProtection)
+	}
+
+	return ibftSigner, nil
 }
 
 // GetValidatorStore returns a proper validator set at specified height
@@ -193,6 +240,147 @@ func (m *ForkManager) GetValidators(height uint64) (validators.Validators, error
 	)
 }
 
+// GetVotingPowers returns the voting powers of validators at specified height,
+// it returns nil if the fork at the height is not stake weighted
+func (m *ForkManager) GetVotingPowers(height uint64) (validators.VotingPowers, error) {
+	fork := m.forks.getFork(height)
+	if fork == nil {
+		return nil, ErrForkNotFound
+	}
+
+	if !fork.StakeWeighted {
+		return nil, nil
+	}
+
+	set := m.getValidatorStoreByIBFTFork(fork)
+	if set == nil {
+		return nil, ErrValidatorStoreNotFound
+	}
+
+	powerStore, ok := set.(VotingPowerStore)
+	if !ok {
+		return nil, ErrVotingPowerUnsupported
+	}
+
+	return powerStore.GetVotingPowers(
+		height,
+		m.epochSize,
+		fork.From.Value,
+	)
+}
+
+// GetLiveness returns the liveness of the validators in the window until the specified height.
+// The committed seals of a block are taken from ParentCommittedSeals of the next block,
+// so the height must be lower than the latest block
+func (m *ForkManager) GetLiveness(height uint64) (*liveness.Tracker, error) {
+	m.livenessLock.Lock()
+	defer m.livenessLock.Unlock()
+
+	// the window is the one of the fork at the height and doesn't start before the fork
+	window, start := m.getLivenessWindow(height)
+
+	tracker := m.liveness
+	if tracker.Window() != window || tracker.Height() > height || tracker.From() < start {
+		tracker = liveness.NewTracker(window)
+
+		if m.liveness.Height() <= height {
+			// the window is changed by the fork, track the new window from now on
+			m.liveness = tracker
+		}
+
+		// otherwise the window has passed the height, the past window is tracked separately
+	}
+
+	from := tracker.Height() + 1
+	if height >= window && from+window <= height {
+		// the older heights are out of the window
+		from = height - window + 1
+	}
+
+	if from < start {
+		from = start
+	}
+
+	for h := from; h <= height; h++ {
+		if err := m.recordLiveness(tracker, h); err != nil {
+			return nil, err
+		}
+	}
+
+	return tracker, nil
+}
+
+// getLivenessWindow returns the liveness window of the fork at the specified height
+// and the height of the fork, the heights before the fork are not tracked
+func (m *ForkManager) getLivenessWindow(height uint64) (uint64, uint64) {
+	fork := m.forks.getFork(height)
+	if fork == nil {
+		return liveness.DefaultWindow, 0
+	}
+
+	if fork.LivenessWindow != nil {
+		return fork.LivenessWindow.Value, fork.From.Value
+	}
+
+	return liveness.DefaultWindow, fork.From.Value
+}
+
+// recordLiveness records the committed seals of the block at the specified height
+// and the proposer of the next block, who included them as ParentCommittedSeals
+func (m *ForkManager) recordLiveness(tracker *liveness.Tracker, height uint64) error {
+	header, ok := m.blockchain.GetHeaderByNumber(height)
+	if !ok {
+		return ErrHeaderNotFound
+	}
+
+	child, ok := m.blockchain.GetHeaderByNumber(height + 1)
+	if !ok {
+		return ErrHeaderNotFound
+	}
+
+	// ParentCommittedSeals are verified by the signer of the parent
+	signer, err := m.GetSigner(height)
+	if err != nil {
+		return err
+	}
+
+	vals, err := m.GetValidators(height)
+	if err != nil {
+		return err
+	}
+
+	signers, err := signer.GetParentCommittedSealsSigners(header, child, vals)
+	if err != nil {
+		return err
+	}
+
+	if signers == nil {
+		// the block doesn't have ParentCommittedSeals (Backward Compatibility), nobody is counted
+		tracker.Record(height, nil, nil, types.ZeroAddress)
+
+		return nil
+	}
+
+	childSigner, err := m.GetSigner(height + 1)
+	if err != nil {
+		return err
+	}
+
+	proposer, err := childSigner.EcrecoverFromHeader(child)
+	if err != nil {
+		return err
+	}
+
+	addrs := make([]types.Address, vals.Len())
+	for idx := range addrs {
+		addrs[idx] = vals.At(uint64(idx)).Addr()
+	}
+
+	tracker.Record(height, addrs, signers, proposer)
+
+	return nil
+}
+
 // GetHooks returns a hooks at specified height
 func (m *ForkManager) GetHooks(height uint64) HooksInterface {
 	hooks := &hook.Hooks{}
@@ -201,6 +389,11 @@ func (m *ForkManager) GetHooks(height uint64) HooksInterface {
 		r.RegisterHooks(hooks, height)
 	}
 
+	// the votes are applied after the other state changes of the block
+	if m.chainParamsHooksRegister != nil {
+		m.chainParamsHooksRegister.RegisterHooks(hooks, height)
+	}
+
 	return hooks
 }
 
@@ -244,8 +437,8 @@ func (m *ForkManager) initializeKeyManager(valType validators.ValidatorType) err
 		return nil
 	}
 
-	if _, ok := m.secretsManager.(*awskms.KmsSecretManager); ok {
-		m.logger.Info("###### Kms Secret Manager ######")
+	if secretsManagerType := m.secretsManager.GetSecretsManagerType(); secrets.IsRemoteSigner(secretsManagerType) {
+		m.logger.Info("validator key is held by the secrets manager", "type", secretsManagerType)
 	}
 
 	keyManager, err := signer.NewKeyManagerFromType(m.secretsManager, valType, m.chainParams.ChainID)
@@ -258,6 +451,29 @@ func (m *ForkManager) initializeKeyManager(valType validators.ValidatorType) err
 	return nil
 }
 
+// initializeSlashingProtection opens the slashing protection database of the chain
+func (m *ForkManager) initializeSlashingProtection() error {
+	genesis, ok := m.blockchain.GetHeaderByNumber(0)
+	if !ok {
+		return ErrGenesisNotFound
+	}
+
+	store, err := slashing.NewStore(filepath.Join(m.filePath, slashing.DirName))
+	if err != nil {
+		return err
+	}
+
+	if err := store.SetGenesisHash(genesis.Hash); err != nil {
+		_ = store.Close()
+
+		return err
+	}
+
+	m.slashingProtection = store
+
+	return nil
+}
+
 // initializeValidatorStores initializes all validator sets based on Fork configuration
 func (m *ForkManager) initializeValidatorStores() error {
 	for _, fork := range m.forks {
@@ -313,6 +529,15 @@ func (m *ForkManager) initializeHooksRegisters() {
 	for _, fork := range m.forks {
 		m.initializeHooksRegister(fork.Type)
 	}
+
+	if m.chainParams != nil && m.chainParams.ChainParametersContract {
+		m.chainParamsHooksRegister = NewChainParamsHookRegister(
+			m.logger,
+			m.epochSize,
+			m.forks,
+			m.GetValidators,
+		)
+	}
 }
 
 // initializeHooksRegister initialize HookRegister by IBFTType
@@ -329,8 +554,10 @@ func (m *ForkManager) initializeHooksRegister(ibftType IBFTType) {
 		)
 	case PoS:
 		m.hooksRegisters[PoS] = NewPoSHookRegister(
+			m.logger,
 			m.forks,
 			m.epochSize,
+			m.GetLiveness,
 		)
 	}
 }
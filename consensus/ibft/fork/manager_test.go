@@ -5,6 +5,7 @@ import (
 	"path"
 	"testing"
 
+	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/consensus/ibft/hook"
 	"github.com/0xPolygon/polygon-edge/consensus/ibft/signer"
 	"github.com/0xPolygon/polygon-edge/crypto"
@@ -57,6 +58,10 @@ func (m *mockSecretManager) GetSecret(name string) ([]byte, error) {
 	return m.GetSecretFunc(name)
 }
 
+func (m *mockSecretManager) GetSecretsManagerType() secrets.SecretsManagerType {
+	return secrets.Local
+}
+
 func TestNewForkManager(t *testing.T) {
 	t.Parallel()
 
@@ -79,6 +84,7 @@ func TestNewForkManager(t *testing.T) {
 			"",
 			0,
 			map[string]interface{}{},
+			&chain.Params{},
 		)
 
 		assert.ErrorIs(t, ErrUndefinedIBFTConfig, err)
@@ -111,6 +117,7 @@ func TestNewForkManager(t *testing.T) {
 				"type":           "PoS",
 				"validator_type": "bls",
 			},
+			&chain.Params{},
 		)
 
 		assert.ErrorIs(t, errTest, err)
@@ -128,6 +135,10 @@ func TestNewForkManager(t *testing.T) {
 					return &types.Header{Number: latestNumber}
 				},
 				GetHeaderByNumberFn: func(u uint64) (*types.Header, bool) {
+					if u == 0 {
+						return &types.Header{Number: 0}, true
+					}
+
 					return nil, false
 				},
 			}
@@ -159,6 +170,7 @@ func TestNewForkManager(t *testing.T) {
 				"type":           "PoA",
 				"validator_type": "ecdsa",
 			},
+			&chain.Params{},
 		)
 
 		assert.NoError(t, err)
@@ -201,6 +213,9 @@ func TestNewForkManager(t *testing.T) {
 				HeaderFn: func() *types.Header {
 					return &types.Header{Number: latestNumber}
 				},
+				GetHeaderByNumberFn: func(u uint64) (*types.Header, bool) {
+					return &types.Header{Number: u}, true
+				},
 			}
 
 			secretManager = &mockSecretManager{
@@ -240,6 +255,7 @@ func TestNewForkManager(t *testing.T) {
 				"type":           "PoA",
 				"validator_type": "ecdsa",
 			},
+			&chain.Params{},
 		)
 
 		assert.NoError(t, err)
@@ -256,6 +272,12 @@ func TestNewForkManager(t *testing.T) {
 		var (
 			epochSize uint64 = 10
 
+			blockchain = &store.MockBlockchain{
+				GetHeaderByNumberFn: func(u uint64) (*types.Header, bool) {
+					return &types.Header{Number: u}, true
+				},
+			}
+
 			secretManager = &mockSecretManager{
 				HasSecretFunc: func(name string) bool {
 					assert.True(t, name == secrets.ValidatorKey || name == secrets.ValidatorBLSKey)
@@ -274,17 +296,20 @@ func TestNewForkManager(t *testing.T) {
 			}
 		)
 
+		dirPath := createTestTempDirectory(t)
+
 		fm, err := NewForkManager(
 			logger,
-			nil,
+			blockchain,
 			nil,
 			secretManager,
-			"",
+			dirPath,
 			epochSize,
 			map[string]interface{}{
 				"type":           "PoS",
 				"validator_type": "bls",
 			},
+			&chain.Params{},
 		)
 
 		assert.NoError(t, err)
@@ -821,6 +846,7 @@ func TestForkManager_initializeKeyManagers(t *testing.T) {
 				forks:          test.forks,
 				secretsManager: test.secretManager,
 				keyManagers:    map[validators.ValidatorType]signer.KeyManager{},
+				chainParams:    &chain.Params{},
 			}
 
 			testHelper.AssertErrorMessageContains(
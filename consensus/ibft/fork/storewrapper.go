@@ -142,6 +142,25 @@ func (w *ContractValidatorStoreWrapper) GetValidators(
 	)
 }
 
+// GetVotingPowers gets and returns the voting powers of the validators at the given height
+func (w *ContractValidatorStoreWrapper) GetVotingPowers(
+	height, epochSize, forkFrom uint64,
+) (validators.VotingPowers, error) {
+	signer, err := w.getSigner(height)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.GetVotingPowersByHeight(
+		signer.Type(),
+		calculateContractStoreFetchingHeight(
+			height,
+			epochSize,
+			forkFrom,
+		),
+	)
+}
+
 // calculateContractStoreFetchingHeight calculates the block height at which ContractStore fetches validators
 // based on height, epoch, and fork beginning height
 func calculateContractStoreFetchingHeight(height, epochSize, forkFrom uint64) uint64 {
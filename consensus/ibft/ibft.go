@@ -3,14 +3,18 @@ package ibft
 import (
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/consensus"
 	"github.com/0xPolygon/polygon-edge/consensus/ibft/fork"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/liveness"
 	"github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
 	"github.com/0xPolygon/polygon-edge/consensus/ibft/signer"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/wal"
 	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/state"
@@ -33,6 +37,8 @@ const (
 	consensusMetrics = "consensus"
 )
 
+var otelTracer = tracing.Tracer("consensus/ibft")
+
 var (
 	ErrInvalidHookParam             = errors.New("invalid IBFT hook param passed in")
 	ErrProposerSealByNonValidator   = errors.New("proposer seal by non-validator")
@@ -56,10 +62,13 @@ type txPoolInterface interface {
 type forkManagerInterface interface {
 	Initialize() error
 	Close() error
+	GetFork(uint64) (*fork.IBFTFork, error)
 	GetSigner(uint64) (signer.Signer, error)
 	GetValidatorStore(uint64) (fork.ValidatorStore, error)
 	GetValidators(uint64) (validators.Validators, error)
+	GetVotingPowers(uint64) (validators.VotingPowers, error)
 	GetHooks(uint64) fork.HooksInterface
+	GetLiveness(uint64) (*liveness.Tracker, error)
 }
 
 // backendIBFT represents the IBFT consensus mechanism object
@@ -77,6 +86,10 @@ type backendIBFT struct {
 	Grpc           *grpc.Server           // Reference to the gRPC manager
 	operator       *operator              // Reference to the gRPC service of IBFT
 	transport      transport              // Reference to the transport protocol
+	wal            *wal.WAL               // Reference to the write-ahead log of IBFT messages
+
+	validatorPeers      *validatorPeers // Peer IDs of the validators, nil unless the direct transport is used
+	validatorPeersTopic *network.Topic  // Topic the validators announce the peer IDs in
 
 	// Dynamic References
 	forkManager       forkManagerInterface  // Manager to hold IBFT Forks
@@ -84,11 +97,26 @@ type backendIBFT struct {
 	currentValidators validators.Validators // signer at current sequence
 	currentHooks      fork.HooksInterface   // Hooks at current sequence
 
+	// Lock of currentSigner and currentValidators, which are also read outside of the consensus routine
+	currentModulesLock sync.RWMutex
+
+	currentVotingPowers validators.VotingPowers // voting powers at current sequence, nil if not stake weighted
+
+	currentMaxEmptyBlockInterval time.Duration // max interval between empty blocks at current sequence, 0 if not skipped
+
+	currentBaseRoundTimeout     time.Duration // timeout of the first round at current sequence
+	currentAdaptiveRoundTimeout bool          // whether the round timeout is lengthened by the recent round changes
+	roundHistory                *roundHistory // rounds the recent heights were committed in
+
 	// Configurations
 	config             *consensus.Config // Consensus configuration
 	epochSize          uint64
 	quorumSizeBlockNum uint64
-	blockTime          time.Duration // Minimum block generation time in seconds
+	blockTime          time.Duration // Minimum block generation time at current sequence
+	defaultBlockTime   time.Duration // Minimum block generation time unless the fork sets it
+	transportMode      string        // Transport of the IBFT messages
+
+	pendingMessageHeight uint64 // the pending height a validator has sent a consensus message of, accessed atomically
 
 	// Channels
 	closeCh chan struct{} // Channel for closing
@@ -123,6 +151,15 @@ func Factory(params *consensus.Params) (consensus.Consensus, error) {
 		quorumSizeBlockNum = uint64(readBlockNum)
 	}
 
+	transportMode := params.IBFTTransport
+	if transportMode == "" {
+		transportMode = TransportGossip
+	}
+
+	if transportMode != TransportGossip && transportMode != TransportDirect {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidTransport, transportMode)
+	}
+
 	logger := params.Logger.Named("ibft")
 
 	forkManager, err := fork.NewForkManager(
@@ -142,17 +179,11 @@ func Factory(params *consensus.Params) (consensus.Consensus, error) {
 
 	p := &backendIBFT{
 		// References
-		logger:     logger,
-		blockchain: params.Blockchain,
-		network:    params.Network,
-		executor:   params.Executor,
-		txpool:     params.TxPool,
-		syncer: syncer.NewSyncer(
-			params.Logger,
-			params.Network,
-			params.Blockchain,
-			time.Duration(params.BlockTime)*3*time.Second,
-		),
+		logger:         logger,
+		blockchain:     params.Blockchain,
+		network:        params.Network,
+		executor:       params.Executor,
+		txpool:         params.TxPool,
 		secretsManager: params.SecretsManager,
 		Grpc:           params.Grpc,
 		forkManager:    forkManager,
@@ -162,11 +193,25 @@ func Factory(params *consensus.Params) (consensus.Consensus, error) {
 		epochSize:          epochSize,
 		quorumSizeBlockNum: quorumSizeBlockNum,
 		blockTime:          time.Duration(params.BlockTime) * time.Second,
+		defaultBlockTime:   time.Duration(params.BlockTime) * time.Second,
+		roundHistory:       &roundHistory{},
+		transportMode:      transportMode,
 
 		// Channels
 		closeCh: make(chan struct{}),
 	}
 
+	// the syncer needs the networking, which isn't set up
+	// when the chain is opened offline
+	if params.Network != nil {
+		p.syncer = syncer.NewSyncer(
+			params.Logger,
+			params.Network,
+			params.Blockchain,
+			time.Duration(params.BlockTime)*3*time.Second,
+		)
+	}
+
 	// Istanbul requires a different header hash function
 	p.SetHeaderHash()
 
@@ -180,9 +225,11 @@ func (i *backendIBFT) Initialize() error {
 		proto.RegisterIbftOperatorServer(i.Grpc, i.operator)
 	}
 
-	// start the transport protocol
-	if err := i.setupTransport(); err != nil {
-		return err
+	// start the transport protocol, unless the chain is opened offline
+	if i.network != nil {
+		if err := i.setupTransport(); err != nil {
+			return err
+		}
 	}
 
 	// initialize fork manager
@@ -190,6 +237,11 @@ func (i *backendIBFT) Initialize() error {
 		return err
 	}
 
+	// open the write-ahead log to restore the round state after a crash
+	if err := i.setupWAL(); err != nil {
+		return err
+	}
+
 	if err := i.updateCurrentModules(i.blockchain.Header().Number + 1); err != nil {
 		return err
 	}
@@ -202,8 +254,8 @@ func (i *backendIBFT) Initialize() error {
 		i,
 	)
 
-	// Ensure consensus takes into account user configured block production time
-	i.consensus.ExtendRoundTimeout(i.blockTime)
+	// Ensure consensus takes into account the configured round timeout
+	i.updateRoundTimeout()
 
 	return nil
 }
@@ -241,6 +293,11 @@ func (i *backendIBFT) Start() error {
 	// Start syncing blocks from other peers
 	go i.startSyncing()
 
+	// Announce the peer ID of this node to be reached by the validators directly
+	if i.validatorPeersTopic != nil {
+		go i.runValidatorPeerAnnouncer()
+	}
+
 	// Start the actual consensus protocol
 	go i.startConsensus()
 
@@ -249,9 +306,24 @@ func (i *backendIBFT) Start() error {
 
 // GetSyncProgression gets the latest sync progression, if any
 func (i *backendIBFT) GetSyncProgression() *progress.Progression {
+	if i.syncer == nil {
+		return nil
+	}
+
 	return i.syncer.GetSyncProgression()
 }
 
+// GetFinalizedNumber returns the latest block number
+// since the blocks are finalized instantly once they are committed in IBFT
+func (i *backendIBFT) GetFinalizedNumber(latest uint64) uint64 {
+	return latest
+}
+
+// GetSafeNumber returns the latest block number as all the blocks are finalized in IBFT
+func (i *backendIBFT) GetSafeNumber(latest uint64) uint64 {
+	return latest
+}
+
 func (i *backendIBFT) startConsensus() {
 	var (
 		newBlockSub   = i.blockchain.SubscribeEvents()
@@ -282,12 +354,14 @@ func (i *backendIBFT) startConsensus() {
 	var (
 		sequenceCh  = make(<-chan struct{})
 		isValidator bool
+		isRestored  bool
 	)
 
 	for {
 		var (
-			latest  = i.blockchain.Header().Number
-			pending = latest + 1
+			latestHeader = i.blockchain.Header()
+			latest       = latestHeader.Number
+			pending      = latest + 1
 		)
 
 		if err := i.updateCurrentModules(pending); err != nil {
@@ -301,11 +375,38 @@ func (i *backendIBFT) startConsensus() {
 		// Update the No.of validator metric
 		metrics.SetGauge([]string{consensusMetrics, "validators"}, float32(i.currentValidators.Len()))
 
+		// Update the liveness metrics of the validators
+		i.updateLivenessMetrics(latest)
+
+		// The messages of the finalized heights are not needed anymore
+		i.pruneWAL(latest)
+
 		isValidator = i.isActiveValidator()
 
 		i.txpool.SetSealing(isValidator)
 
 		if isValidator {
+			// Resume the sequence the node was running before it stopped
+			if !isRestored {
+				i.restoreFromWAL(pending)
+
+				isRestored = true
+			}
+
+			// Don't start the sequence until there are transactions to include
+			// if empty blocks are skipped
+			if !i.waitForPendingTransactions(latestHeader, syncerBlockCh) {
+				select {
+				case <-i.closeCh:
+					return
+				default:
+				}
+
+				continue
+			}
+
+			i.updateRoundTimeout()
+
 			sequenceCh = i.consensus.runSequence(pending)
 		}
 
@@ -328,7 +429,49 @@ func (i *backendIBFT) startConsensus() {
 
 // isActiveValidator returns whether my signer belongs to current validators
 func (i *backendIBFT) isActiveValidator() bool {
-	return i.currentValidators.Includes(i.currentSigner.Address())
+	currentSigner, currentValidators := i.getCurrentModules()
+
+	return currentValidators.Includes(currentSigner.Address())
+}
+
+// getCurrentModules returns the signer and the validators at current sequence,
+// it's safe to be called outside of the consensus routine
+func (i *backendIBFT) getCurrentModules() (signer.Signer, validators.Validators) {
+	i.currentModulesLock.RLock()
+	defer i.currentModulesLock.RUnlock()
+
+	return i.currentSigner, i.currentValidators
+}
+
+// updateLivenessMetrics updates the missed committed seals of the validators
+// and the number of the offline validators until the parent of the latest block
+func (i *backendIBFT) updateLivenessMetrics(latest uint64) {
+	if latest < 2 {
+		return
+	}
+
+	tracker, err := i.forkManager.GetLiveness(latest - 1)
+	if err != nil {
+		i.logger.Debug("failed to get liveness", "height", latest-1, "err", err)
+
+		return
+	}
+
+	offline := 0
+
+	for _, stats := range tracker.Stats() {
+		metrics.SetGaugeWithLabels(
+			[]string{consensusMetrics, "validator_missed_seals"},
+			float32(stats.Missed),
+			[]metrics.Label{{Name: "validator", Value: stats.Address.String()}},
+		)
+
+		if stats.Offline() {
+			offline++
+		}
+	}
+
+	metrics.SetGauge([]string{consensusMetrics, "offline_validators"}, float32(offline))
 }
 
 // updateMetrics will update various metrics based on the given block
@@ -392,6 +535,11 @@ func (i *backendIBFT) verifyHeaderImpl(
 		return err
 	}
 
+	// verify the empty block is not produced before the max empty block interval
+	if err := i.verifyEmptyBlock(parent, header, hooks); err != nil {
+		return err
+	}
+
 	// Additional header verification
 	if err := hooks.VerifyHeader(header); err != nil {
 		return err
@@ -432,14 +580,34 @@ func (i *backendIBFT) VerifyHeader(header *types.Header) error {
 
 	// verify the Committed Seals
 	// CommittedSeals exists only in the finalized header
-	if err := headerSigner.VerifyCommittedSeals(
-		header,
-		validators,
-		i.quorumSize(header.Number)(validators),
-	); err != nil {
+	return i.verifyCommittedSeals(header, headerSigner, validators)
+}
+
+// verifyCommittedSeals verifies the committed seals of the header and that their signers reach the quorum
+func (i *backendIBFT) verifyCommittedSeals(
+	header *types.Header,
+	headerSigner signer.Signer,
+	validators validators.Validators,
+) error {
+	// every seal has to be valid, the quorum is checked by the signers
+	if err := headerSigner.VerifyCommittedSeals(header, validators, 1); err != nil {
+		return err
+	}
+
+	signers, err := headerSigner.GetCommittedSealsSigners(header, validators)
+	if err != nil {
 		return err
 	}
 
+	hasQuorum, err := i.hasQuorum(header.Number, validators, signers)
+	if err != nil {
+		return err
+	}
+
+	if !hasQuorum {
+		return signer.ErrNotEnoughCommittedSeals
+	}
+
 	return nil
 }
 
@@ -447,15 +615,29 @@ func (i *backendIBFT) VerifyHeader(header *types.Header) error {
 // number of votes required to reach quorum based on the size of the set.
 // The blockNumber argument indicates which formula was used to calculate the result (see PRs #513, #549)
 func (i *backendIBFT) quorumSize(blockNumber uint64) QuorumImplementation {
-	fmt.Printf(" i.quorumSizeBlockNum %d  \n ", i.quorumSizeBlockNum)
 	if blockNumber < i.quorumSizeBlockNum {
 		return LegacyQuorumSize
 	}
-	// fmt.Println("LegacyQuorumSize ", LegacyQuorumSize, " OptimalQuorumSize ", OptimalQuorumSize)
 
 	return OptimalQuorumSize
 }
 
+// hasQuorum returns true if the signers reach the quorum of the validators at the given height.
+// The voting powers of the signers are summed up if the validators are stake weighted,
+// otherwise the signers are counted. Each signer is counted once and non-validators are ignored
+func (i *backendIBFT) hasQuorum(height uint64, set validators.Validators, signers []types.Address) (bool, error) {
+	powers, err := i.forkManager.GetVotingPowers(height)
+	if err != nil {
+		return false, err
+	}
+
+	if powers != nil {
+		return HasWeightedQuorum(set, powers, signers), nil
+	}
+
+	return len(uniqueValidators(set, signers)) >= i.quorumSize(height)(set), nil
+}
+
 // ProcessHeaders updates the snapshot based on previously verified headers
 func (i *backendIBFT) ProcessHeaders(headers []*types.Header) error {
 	for _, header := range headers {
@@ -510,12 +692,24 @@ func (i *backendIBFT) Close() error {
 		}
 	}
 
+	if direct, ok := i.transport.(*directTransport); ok {
+		if err := direct.close(); err != nil {
+			return err
+		}
+	}
+
 	if i.forkManager != nil {
 		if err := i.forkManager.Close(); err != nil {
 			return err
 		}
 	}
 
+	if i.wal != nil {
+		if err := i.wal.Close(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -547,9 +741,37 @@ func (i *backendIBFT) updateCurrentModules(height uint64) error {
 		return err
 	}
 
+	votingPowers, err := i.forkManager.GetVotingPowers(height)
+	if err != nil {
+		return err
+	}
+
+	maxEmptyBlockInterval, err := getMaxEmptyBlockInterval(i.forkManager, height)
+	if err != nil {
+		return err
+	}
+
+	blockTime, err := getBlockTime(i.forkManager, height, i.defaultBlockTime)
+	if err != nil {
+		return err
+	}
+
+	baseRoundTimeout, adaptiveRoundTimeout, err := getBaseRoundTimeout(i.forkManager, height, blockTime)
+	if err != nil {
+		return err
+	}
+
+	i.currentModulesLock.Lock()
 	i.currentSigner = signer
 	i.currentValidators = validators
+	i.currentModulesLock.Unlock()
+
 	i.currentHooks = hooks
+	i.currentVotingPowers = votingPowers
+	i.currentMaxEmptyBlockInterval = maxEmptyBlockInterval
+	i.blockTime = blockTime
+	i.currentBaseRoundTimeout = baseRoundTimeout
+	i.currentAdaptiveRoundTimeout = adaptiveRoundTimeout
 
 	i.logFork(lastSigner, signer)
 
@@ -583,14 +805,38 @@ func (i *backendIBFT) verifyParentCommittedSeals(
 	}
 
 	// if shouldVerifyParentCommittedSeals is false, skip the verification
-	// when header doesn't have Parent Committed Seals (Backward Compatibility)
-	return parentSigner.VerifyParentCommittedSeals(
+	// when header doesn't have Parent Committed Seals (Backward Compatibility).
+	// Every seal has to be valid, the quorum is checked by the signers
+	if err := parentSigner.VerifyParentCommittedSeals(
 		parent,
 		header,
 		parentValidators,
-		i.quorumSize(parent.Number)(parentValidators),
+		1,
 		shouldVerifyParentCommittedSeals,
-	)
+	); err != nil {
+		return err
+	}
+
+	signers, err := parentSigner.GetParentCommittedSealsSigners(parent, header, parentValidators)
+	if err != nil {
+		return err
+	}
+
+	// the verification was skipped
+	if signers == nil {
+		return nil
+	}
+
+	hasQuorum, err := i.hasQuorum(parent.Number, parentValidators, signers)
+	if err != nil {
+		return err
+	}
+
+	if !hasQuorum {
+		return signer.ErrNotEnoughCommittedSeals
+	}
+
+	return nil
 }
 
 // getModulesFromForkManager is a helper function to get all modules from ForkManager
@@ -0,0 +1,271 @@
+package liveness
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// DefaultWindow is the default number of heights the liveness is tracked for
+	DefaultWindow = 100
+
+	// OfflineStreak is the number of consecutive missed seals
+	// after which a validator is considered as offline
+	OfflineStreak = 5
+)
+
+// Stats is the liveness of a validator in the window
+type Stats struct {
+	Address types.Address
+	// Signed is the number of the committed seals the validator made in the window
+	Signed uint64
+	// Missed is the number of the committed seals the validator missed in the window,
+	// except for the ones omitted by the proposers that omitted the seals of the validator the most
+	Missed uint64
+	// Disregarded is the number of the missed seals not counted in Missed.
+	// The committed seals of a block are chosen by the proposer of the next block,
+	// so up to the maximum number of faulty validators could omit the seals of an online validator
+	Disregarded uint64
+	// MissedInRow is the number of consecutive missed seals until the latest height
+	MissedInRow uint64
+	// LastSigned is the latest height the validator signed in the window, 0 if none
+	LastSigned uint64
+}
+
+// Offline returns whether the validator misses the committed seals in a row
+func (s *Stats) Offline() bool {
+	return s.MissedInRow >= OfflineStreak
+}
+
+// record is the committed seals of a block
+type record struct {
+	height     uint64
+	validators []types.Address
+	signers    map[types.Address]struct{}
+	// proposer is the proposer of the next block, who chose the committed seals
+	proposer types.Address
+}
+
+func (r *record) signed(addr types.Address) bool {
+	_, ok := r.signers[addr]
+
+	return ok
+}
+
+// Tracker keeps the committed seals of the validators in a rolling window of heights
+type Tracker struct {
+	window uint64
+
+	lock sync.RWMutex
+	// records ordered by height without gaps
+	records []*record
+}
+
+// NewTracker is a constructor of Tracker
+func NewTracker(window uint64) *Tracker {
+	if window == 0 {
+		window = DefaultWindow
+	}
+
+	return &Tracker{
+		window:  window,
+		records: make([]*record, 0, window),
+	}
+}
+
+// Window returns the number of heights tracked
+func (t *Tracker) Window() uint64 {
+	return t.window
+}
+
+// From returns the oldest recorded height, 0 if nothing is recorded
+func (t *Tracker) From() uint64 {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	if len(t.records) == 0 {
+		return 0
+	}
+
+	return t.records[0].height
+}
+
+// Height returns the latest recorded height, 0 if nothing is recorded
+func (t *Tracker) Height() uint64 {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	if len(t.records) == 0 {
+		return 0
+	}
+
+	return t.records[len(t.records)-1].height
+}
+
+// Record records the validators of the block at the given height, the signers of its committed seals
+// and the proposer of the next block, who included the committed seals.
+// The heights need to be recorded in order, the tracker starts over if a height is skipped
+func (t *Tracker) Record(height uint64, validators, signers []types.Address, proposer types.Address) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if len(t.records) > 0 && t.records[len(t.records)-1].height+1 != height {
+		t.records = t.records[:0]
+	}
+
+	r := &record{
+		height:     height,
+		validators: validators,
+		signers:    make(map[types.Address]struct{}, len(signers)),
+		proposer:   proposer,
+	}
+
+	for _, signer := range signers {
+		r.signers[signer] = struct{}{}
+	}
+
+	t.records = append(t.records, r)
+
+	if uint64(len(t.records)) > t.window {
+		t.records = t.records[uint64(len(t.records))-t.window:]
+	}
+}
+
+// Missed returns the number of the committed seals the validator missed in the window
+func (t *Tracker) Missed(addr types.Address) uint64 {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	return t.stats(addr).Missed
+}
+
+// Stats returns the liveness of the validators of the latest recorded height, sorted by address
+func (t *Tracker) Stats() []*Stats {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	if len(t.records) == 0 {
+		return []*Stats{}
+	}
+
+	validators := t.records[len(t.records)-1].validators
+
+	stats := make([]*Stats, len(validators))
+	for idx, addr := range validators {
+		stats[idx] = t.stats(addr)
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		return bytes.Compare(stats[i].Address.Bytes(), stats[j].Address.Bytes()) < 0
+	})
+
+	return stats
+}
+
+// Exceeding returns the validators of the latest recorded height
+// that missed more committed seals than the threshold in the window, sorted by address
+func (t *Tracker) Exceeding(threshold uint64) []types.Address {
+	exceeding := make([]types.Address, 0)
+
+	for _, stats := range t.Stats() {
+		if stats.Missed > threshold {
+			exceeding = append(exceeding, stats.Address)
+		}
+	}
+
+	return exceeding
+}
+
+// stats counts the committed seals of the validator in the window,
+// the heights where the validator is not in the validator set are not counted.
+// The seals missed in the blocks of the proposers that omitted the seals of the validator the most
+// are disregarded, up to the maximum number of faulty validators of the latest validator set,
+// so that the faulty proposers can't make an online validator miss the seals
+func (t *Tracker) stats(addr types.Address) *Stats {
+	stats := &Stats{
+		Address: addr,
+	}
+
+	if len(t.records) == 0 {
+		return stats
+	}
+
+	var (
+		inRow            = true
+		missedByProposer = make(map[types.Address]uint64)
+	)
+
+	for idx := len(t.records) - 1; idx >= 0; idx-- {
+		r := t.records[idx]
+
+		if !includes(r.validators, addr) {
+			continue
+		}
+
+		if r.signed(addr) {
+			stats.Signed++
+
+			if stats.LastSigned == 0 {
+				stats.LastSigned = r.height
+			}
+
+			inRow = false
+
+			continue
+		}
+
+		stats.Missed++
+		missedByProposer[r.proposer]++
+
+		if inRow {
+			stats.MissedInRow++
+		}
+	}
+
+	stats.Disregarded = sumLargest(missedByProposer, maxFaulty(len(t.records[len(t.records)-1].validators)))
+	stats.Missed -= stats.Disregarded
+
+	return stats
+}
+
+// maxFaulty returns the maximum number of faulty validators in the validator set of the given size
+func maxFaulty(size int) int {
+	if size == 0 {
+		return 0
+	}
+
+	return (size - 1) / 3
+}
+
+// sumLargest returns the sum of the n largest counts
+func sumLargest(counts map[types.Address]uint64, n int) uint64 {
+	values := make([]uint64, 0, len(counts))
+	for _, count := range counts {
+		values = append(values, count)
+	}
+
+	sort.Slice(values, func(i, j int) bool {
+		return values[i] > values[j]
+	})
+
+	sum := uint64(0)
+
+	for idx := 0; idx < n && idx < len(values); idx++ {
+		sum += values[idx]
+	}
+
+	return sum
+}
+
+func includes(addrs []types.Address, addr types.Address) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+
+	return false
+}
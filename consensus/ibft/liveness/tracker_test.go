@@ -0,0 +1,181 @@
+package liveness
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testValidator1 = types.StringToAddress("1")
+	testValidator2 = types.StringToAddress("2")
+	testValidator3 = types.StringToAddress("3")
+
+	testValidators = []types.Address{testValidator1, testValidator2, testValidator3}
+)
+
+func TestTrackerRecord(t *testing.T) {
+	t.Parallel()
+
+	type block struct {
+		height     uint64
+		validators []types.Address
+		signers    []types.Address
+	}
+
+	tests := []struct {
+		name           string
+		window         uint64
+		blocks         []block
+		expectedFrom   uint64
+		expectedHeight uint64
+		expectedStats  []*Stats
+	}{
+		{
+			name:           "should return empty stats if nothing is recorded",
+			window:         10,
+			expectedFrom:   0,
+			expectedHeight: 0,
+			expectedStats:  []*Stats{},
+		},
+		{
+			name:   "should count signed and missed seals",
+			window: 10,
+			blocks: []block{
+				{1, testValidators, []types.Address{testValidator1, testValidator2, testValidator3}},
+				{2, testValidators, []types.Address{testValidator1, testValidator2}},
+				{3, testValidators, []types.Address{testValidator1, testValidator3}},
+				{4, testValidators, []types.Address{testValidator1}},
+			},
+			expectedFrom:   1,
+			expectedHeight: 4,
+			expectedStats: []*Stats{
+				{Address: testValidator1, Signed: 4, Missed: 0, MissedInRow: 0, LastSigned: 4},
+				{Address: testValidator2, Signed: 2, Missed: 2, MissedInRow: 2, LastSigned: 2},
+				{Address: testValidator3, Signed: 2, Missed: 2, MissedInRow: 1, LastSigned: 3},
+			},
+		},
+		{
+			name:   "should evict the heights out of the window",
+			window: 2,
+			blocks: []block{
+				{1, testValidators, []types.Address{testValidator1}},
+				{2, testValidators, []types.Address{testValidator1, testValidator2}},
+				{3, testValidators, []types.Address{testValidator1, testValidator2, testValidator3}},
+			},
+			expectedFrom:   2,
+			expectedHeight: 3,
+			expectedStats: []*Stats{
+				{Address: testValidator1, Signed: 2, Missed: 0, MissedInRow: 0, LastSigned: 3},
+				{Address: testValidator2, Signed: 2, Missed: 0, MissedInRow: 0, LastSigned: 3},
+				{Address: testValidator3, Signed: 1, Missed: 1, MissedInRow: 0, LastSigned: 3},
+			},
+		},
+		{
+			name:   "should start over if a height is skipped",
+			window: 10,
+			blocks: []block{
+				{1, testValidators, []types.Address{testValidator1}},
+				{2, testValidators, []types.Address{testValidator1}},
+				{5, testValidators, []types.Address{testValidator1, testValidator2}},
+			},
+			expectedFrom:   5,
+			expectedHeight: 5,
+			expectedStats: []*Stats{
+				{Address: testValidator1, Signed: 1, Missed: 0, MissedInRow: 0, LastSigned: 5},
+				{Address: testValidator2, Signed: 1, Missed: 0, MissedInRow: 0, LastSigned: 5},
+				{Address: testValidator3, Signed: 0, Missed: 1, MissedInRow: 1, LastSigned: 0},
+			},
+		},
+		{
+			name:   "should not count the heights the validator is not in the validator set",
+			window: 10,
+			blocks: []block{
+				{1, []types.Address{testValidator1}, []types.Address{testValidator1}},
+				{2, []types.Address{testValidator1}, []types.Address{testValidator1}},
+				{3, testValidators, []types.Address{testValidator1, testValidator3}},
+			},
+			expectedFrom:   1,
+			expectedHeight: 3,
+			expectedStats: []*Stats{
+				{Address: testValidator1, Signed: 3, Missed: 0, MissedInRow: 0, LastSigned: 3},
+				{Address: testValidator2, Signed: 0, Missed: 1, MissedInRow: 1, LastSigned: 0},
+				{Address: testValidator3, Signed: 1, Missed: 0, MissedInRow: 0, LastSigned: 3},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			tracker := NewTracker(test.window)
+
+			for _, b := range test.blocks {
+				tracker.Record(b.height, b.validators, b.signers, testValidator1)
+			}
+
+			assert.Equal(t, test.expectedFrom, tracker.From())
+			assert.Equal(t, test.expectedHeight, tracker.Height())
+			assert.Equal(t, test.expectedStats, tracker.Stats())
+		})
+	}
+}
+
+func TestTrackerExceeding(t *testing.T) {
+	t.Parallel()
+
+	tracker := NewTracker(0)
+
+	assert.Equal(t, uint64(DefaultWindow), tracker.Window())
+
+	for height := uint64(1); height <= OfflineStreak; height++ {
+		tracker.Record(height, testValidators, []types.Address{testValidator1, testValidator3}, testValidator1)
+	}
+
+	tracker.Record(OfflineStreak+1, testValidators, []types.Address{testValidator1, testValidator2}, testValidator1)
+
+	assert.Equal(t, uint64(OfflineStreak), tracker.Missed(testValidator2))
+	assert.Equal(t, uint64(1), tracker.Missed(testValidator3))
+
+	assert.Equal(t, []types.Address{testValidator2, testValidator3}, tracker.Exceeding(0))
+	assert.Equal(t, []types.Address{testValidator2}, tracker.Exceeding(OfflineStreak-1))
+	assert.Equal(t, []types.Address{}, tracker.Exceeding(OfflineStreak))
+
+	stats := tracker.Stats()
+
+	assert.False(t, stats[1].Offline())
+	assert.False(t, stats[2].Offline())
+}
+
+func TestTrackerDisregardFaultyProposers(t *testing.T) {
+	t.Parallel()
+
+	testValidator4 := types.StringToAddress("4")
+	validators := []types.Address{testValidator1, testValidator2, testValidator3, testValidator4}
+
+	tracker := NewTracker(10)
+
+	// testValidator4 omits the seals of testValidator2 in all its blocks,
+	// testValidator3 omits them once
+	tracker.Record(1, validators, []types.Address{testValidator1, testValidator3, testValidator4}, testValidator4)
+	tracker.Record(2, validators, validators, testValidator1)
+	tracker.Record(3, validators, []types.Address{testValidator1, testValidator3, testValidator4}, testValidator4)
+	tracker.Record(4, validators, []types.Address{testValidator1, testValidator3, testValidator4}, testValidator3)
+	tracker.Record(5, validators, []types.Address{testValidator1, testValidator3, testValidator4}, testValidator4)
+
+	// a single faulty validator is tolerated in the validator set of 4,
+	// so the seals omitted by the proposer omitting the most are disregarded
+	assert.Equal(t, []*Stats{
+		{Address: testValidator1, Signed: 5, LastSigned: 5},
+		{Address: testValidator2, Signed: 1, Missed: 1, Disregarded: 3, MissedInRow: 3, LastSigned: 2},
+		{Address: testValidator3, Signed: 5, LastSigned: 5},
+		{Address: testValidator4, Signed: 5, LastSigned: 5},
+	}, tracker.Stats())
+
+	assert.Equal(t, []types.Address{testValidator2}, tracker.Exceeding(0))
+	assert.Equal(t, []types.Address{}, tracker.Exceeding(1))
+}
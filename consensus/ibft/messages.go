@@ -1,32 +1,59 @@
 package ibft
 
 import (
-	"google.golang.org/protobuf/proto"
-
 	protoIBFT "github.com/0xPolygon/go-ibft/messages/proto"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
 func (i *backendIBFT) signMessage(msg *protoIBFT.Message) *protoIBFT.Message {
-	raw, err := proto.Marshal(msg)
-	if err != nil {
-		return nil
-	}
+	var err error
+
+	if msg.Signature, err = i.currentSigner.SignIBFTMessage(msg); err != nil {
+		i.logger.Error("Unable to sign message", "type", msg.Type, "err", err)
 
-	if msg.Signature, err = i.currentSigner.SignIBFTMessage(raw); err != nil {
 		return nil
 	}
 
 	return msg
 }
 
+// sealProposal writes the proposer seal into the block built by this node for the given round
+// and returns the sealed proposal. The proposals of the previous rounds are already sealed
+func (i *backendIBFT) sealProposal(proposal []byte, round uint64) ([]byte, *types.Block, error) {
+	block := &types.Block{}
+	if err := block.UnmarshalRLP(proposal); err != nil {
+		return nil, nil, err
+	}
+
+	extra, err := i.currentSigner.GetIBFTExtra(block.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(extra.ProposerSeal) > 0 {
+		return proposal, block, nil
+	}
+
+	if block.Header, err = i.currentSigner.WriteProposerSeal(block.Header, round); err != nil {
+		return nil, nil, err
+	}
+
+	// compute the hash, this is only a provisional hash since the final one
+	// is sealed after all the committed seals
+	block.Header.ComputeHash()
+
+	return block.MarshalRLP(), block, nil
+}
+
 func (i *backendIBFT) BuildPrePrepareMessage(
 	proposal []byte,
 	certificate *protoIBFT.RoundChangeCertificate,
 	view *protoIBFT.View,
 ) *protoIBFT.Message {
-	block := &types.Block{}
-	if err := block.UnmarshalRLP(proposal); err != nil {
+	proposal, block, err := i.sealProposal(proposal, view.Round)
+	if err != nil {
+		i.logger.Error("Unable to seal proposal", "err", err)
+
 		return nil
 	}
 
@@ -64,7 +91,10 @@ func (i *backendIBFT) BuildPrepareMessage(proposalHash []byte, view *protoIBFT.V
 }
 
 func (i *backendIBFT) BuildCommitMessage(proposalHash []byte, view *protoIBFT.View) *protoIBFT.Message {
-	committedSeal, err := i.currentSigner.CreateCommittedSeal(proposalHash)
+	// the round the height is committed in lengthens the adaptive round timeout
+	i.roundHistory.observe(view.Height, view.Round)
+
+	committedSeal, err := i.currentSigner.CreateCommittedSeal(proposalHash, view)
 	if err != nil {
 		i.logger.Error("Unable to build commit message, %v", err)
 
@@ -83,7 +113,12 @@ func (i *backendIBFT) BuildCommitMessage(proposalHash []byte, view *protoIBFT.Vi
 		},
 	}
 
-	return i.signMessage(msg)
+	if msg = i.signMessage(msg); msg != nil {
+		// the node is locked on the proposal once it commits
+		i.lockInWAL(view, proposalHash)
+	}
+
+	return msg
 }
 
 func (i *backendIBFT) BuildRoundChangeMessage(
@@ -91,6 +126,11 @@ func (i *backendIBFT) BuildRoundChangeMessage(
 	certificate *protoIBFT.PreparedCertificate,
 	view *protoIBFT.View,
 ) *protoIBFT.Message {
+	// go-ibft loses the prepared certificate on restart, take it from WAL
+	if certificate == nil {
+		proposal, certificate = i.lockedProposal(view.Height)
+	}
+
 	msg := &protoIBFT.Message{
 		View: view,
 		From: i.ID(),
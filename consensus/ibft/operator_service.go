@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/liveness"
 	"github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
 	"github.com/0xPolygon/polygon-edge/consensus/ibft/signer"
 	"github.com/0xPolygon/polygon-edge/crypto"
@@ -87,6 +88,35 @@ func (o *operator) GetSnapshot(ctx context.Context, req *proto.SnapshotReq) (*pr
 	return resp, nil
 }
 
+// Liveness returns the committed seals the validators signed and missed in the liveness window
+func (o *operator) Liveness(ctx context.Context, req *proto.LivenessReq) (*proto.LivenessResp, error) {
+	// the committed seals of the latest block are in the next block
+	latest := o.ibft.blockchain.Header().Number
+	if latest > 0 {
+		latest--
+	}
+
+	height := req.Number
+	if req.Latest {
+		height = latest
+	}
+
+	if height > latest {
+		return nil, ErrHeaderNotFound
+	}
+
+	tracker, err := o.ibft.forkManager.GetLiveness(height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.LivenessResp{
+		Number:     height,
+		Window:     tracker.Window(),
+		Validators: livenessToProtoValidators(tracker.Stats()),
+	}, nil
+}
+
 // Propose proposes a new candidate to be added / removed from the validator set
 func (o *operator) Propose(ctx context.Context, req *proto.Candidate) (*empty.Empty, error) {
 	votableSet, err := o.getVotableValidatorStore()
@@ -173,8 +203,8 @@ func (o *operator) getVotableValidatorStore() (Votable, error) {
 
 // getLatestSigner gets the latest signer IBFT uses
 func (o *operator) getLatestSigner() (signer.Signer, error) {
-	if o.ibft.currentSigner != nil {
-		return o.ibft.currentSigner, nil
+	if currentSigner, _ := o.ibft.getCurrentModules(); currentSigner != nil {
+		return currentSigner, nil
 	}
 
 	return o.ibft.forkManager.GetSigner(o.ibft.blockchain.Header().Number)
@@ -197,6 +227,24 @@ func validatorsToProtoValidators(validators validators.Validators) []*proto.Snap
 	return protoValidators
 }
 
+// livenessToProtoValidators converts liveness of validators to response of validators
+func livenessToProtoValidators(stats []*liveness.Stats) []*proto.LivenessResp_Validator {
+	protoValidators := make([]*proto.LivenessResp_Validator, len(stats))
+
+	for idx, s := range stats {
+		protoValidators[idx] = &proto.LivenessResp_Validator{
+			Address:     s.Address.String(),
+			Signed:      s.Signed,
+			Missed:      s.Missed,
+			MissedInRow: s.MissedInRow,
+			LastSigned:  s.LastSigned,
+			Offline:     s.Offline(),
+		}
+	}
+
+	return protoValidators
+}
+
 // votesToProtoVotes converts votes to response of votes
 func votesToProtoVotes(votes []*store.Vote) []*proto.Snapshot_Vote {
 	protoVotes := make([]*proto.Snapshot_Vote, len(votes))
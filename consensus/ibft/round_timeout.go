@@ -0,0 +1,140 @@
+package ibft
+
+import (
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+)
+
+const (
+	// goIBFTRound0Timeout is the timeout of the first round in go-ibft the extension is added to,
+	// go-ibft doubles the timeout in every next round
+	goIBFTRound0Timeout = 10 * time.Second
+
+	// roundHistoryWindow is the number of the recent heights the adaptive round timeout is based on
+	roundHistoryWindow = 10
+
+	// maxAdaptiveTimeoutFactor is the limit of the factor the adaptive mode multiplies the base round timeout by
+	maxAdaptiveTimeoutFactor = 4
+)
+
+// getBlockTime returns the block time of the fork at the height, the default one if the fork doesn't set it
+func getBlockTime(forkManager forkManagerInterface, height uint64, defaultBlockTime time.Duration) (time.Duration, error) {
+	ibftFork, err := forkManager.GetFork(height)
+	if err != nil {
+		return 0, err
+	}
+
+	if ibftFork.BlockTime == nil {
+		return defaultBlockTime, nil
+	}
+
+	return time.Duration(ibftFork.BlockTime.Value) * time.Second, nil
+}
+
+// getBaseRoundTimeout returns the timeout of the first round of the fork at the height
+// and whether it's adapted to the recent round changes.
+// The block time is added to the go-ibft timeout if the fork doesn't set it
+func getBaseRoundTimeout(
+	forkManager forkManagerInterface,
+	height uint64,
+	blockTime time.Duration,
+) (time.Duration, bool, error) {
+	ibftFork, err := forkManager.GetFork(height)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if ibftFork.BaseRoundTimeout == nil {
+		return goIBFTRound0Timeout + blockTime, ibftFork.AdaptiveRoundTimeout, nil
+	}
+
+	return time.Duration(ibftFork.BaseRoundTimeout.Value) * time.Second, ibftFork.AdaptiveRoundTimeout, nil
+}
+
+// roundHistory keeps the rounds the recent heights were committed in
+type roundHistory struct {
+	sync.Mutex
+
+	height uint64   // the height the round is observed at
+	round  uint64   // the highest round a commit was sent in at the height
+	rounds []uint64 // the rounds of the recent heights, the oldest first
+}
+
+// observe records the round this node sent a commit in
+func (h *roundHistory) observe(height, round uint64) {
+	h.Lock()
+	defer h.Unlock()
+
+	if height != h.height {
+		h.height = height
+		h.round = round
+
+		return
+	}
+
+	if round > h.round {
+		h.round = round
+	}
+}
+
+// commit adds the round of the committed height to the history,
+// returns the round and false if no commit has been observed at the height
+func (h *roundHistory) commit(height uint64) (uint64, bool) {
+	h.Lock()
+	defer h.Unlock()
+
+	if height != h.height {
+		return 0, false
+	}
+
+	h.rounds = append(h.rounds, h.round)
+	if len(h.rounds) > roundHistoryWindow {
+		h.rounds = h.rounds[len(h.rounds)-roundHistoryWindow:]
+	}
+
+	return h.round, true
+}
+
+// factor returns the factor the base round timeout is multiplied by,
+// it grows by the average number of the round changes in the recent heights
+func (h *roundHistory) factor() float64 {
+	h.Lock()
+	defer h.Unlock()
+
+	if len(h.rounds) == 0 {
+		return 1
+	}
+
+	total := uint64(0)
+	for _, round := range h.rounds {
+		total += round
+	}
+
+	factor := 1 + float64(total)/float64(len(h.rounds))
+	if factor > maxAdaptiveTimeoutFactor {
+		return maxAdaptiveTimeoutFactor
+	}
+
+	return factor
+}
+
+// roundTimeout returns the timeout of the first round at the current sequence
+func (i *backendIBFT) roundTimeout() time.Duration {
+	if !i.currentAdaptiveRoundTimeout {
+		return i.currentBaseRoundTimeout
+	}
+
+	return time.Duration(float64(i.currentBaseRoundTimeout) * i.roundHistory.factor())
+}
+
+// updateRoundTimeout sets the timeout of the rounds for the next sequence,
+// the timeout of the first round is the round timeout and it grows by go-ibft in the next rounds
+func (i *backendIBFT) updateRoundTimeout() {
+	timeout := i.roundTimeout()
+
+	i.consensus.ExtendRoundTimeout(timeout - goIBFTRound0Timeout)
+
+	metrics.SetGauge([]string{consensusMetrics, "round_timeout"}, float32(timeout.Seconds()))
+}
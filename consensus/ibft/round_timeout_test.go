@@ -0,0 +1,96 @@
+package ibft
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundHistory_Commit(t *testing.T) {
+	t.Parallel()
+
+	history := &roundHistory{}
+
+	// no commit has been sent at the height
+	_, ok := history.commit(1)
+	assert.False(t, ok)
+
+	history.observe(1, 0)
+	history.observe(1, 2)
+	history.observe(1, 1)
+
+	round, ok := history.commit(1)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(2), round)
+
+	// the round of the previous height is not counted for the next one
+	history.observe(2, 0)
+
+	round, ok = history.commit(2)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(0), round)
+
+	assert.Equal(t, []uint64{2, 0}, history.rounds)
+
+	// only the recent heights are kept
+	for height := uint64(3); height < 3+roundHistoryWindow; height++ {
+		history.observe(height, 1)
+		history.commit(height)
+	}
+
+	assert.Len(t, history.rounds, roundHistoryWindow)
+	assert.Equal(t, float64(2), history.factor())
+}
+
+func TestIBFTBackend_RoundTimeout(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name     string
+		adaptive bool
+		rounds   []uint64
+		expected time.Duration
+	}{
+		{
+			"Base round timeout if not adaptive",
+			false,
+			[]uint64{2, 2},
+			20 * time.Second,
+		},
+		{
+			"Base round timeout without history",
+			true,
+			nil,
+			20 * time.Second,
+		},
+		{
+			"Lengthened by the average round changes",
+			true,
+			[]uint64{0, 1, 0, 1},
+			30 * time.Second,
+		},
+		{
+			"Limited by the max factor",
+			true,
+			[]uint64{5, 10},
+			80 * time.Second,
+		},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			i := &backendIBFT{
+				currentBaseRoundTimeout:     20 * time.Second,
+				currentAdaptiveRoundTimeout: testCase.adaptive,
+				roundHistory:                &roundHistory{rounds: testCase.rounds},
+			}
+
+			assert.Equal(t, testCase.expected, i.roundTimeout())
+		})
+	}
+}
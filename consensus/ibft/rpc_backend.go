@@ -0,0 +1,121 @@
+package ibft
+
+import (
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/fork"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/validators"
+	"github.com/0xPolygon/polygon-edge/validators/store"
+)
+
+// Methods serving the IBFT data to the ibft JSON-RPC endpoint
+
+// GetIBFTFork returns the IBFT fork active at the given height
+func (i *backendIBFT) GetIBFTFork(height uint64) (*fork.IBFTFork, error) {
+	return i.forkManager.GetFork(height)
+}
+
+// GetIBFTValidators returns the validators of the block at the given height
+// and the number of the committed seals required for the block,
+// the fewest committed seals reaching the quorum voting power if the validators are stake weighted
+func (i *backendIBFT) GetIBFTValidators(height uint64) (validators.Validators, int, error) {
+	vals, err := i.forkManager.GetValidators(height)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	powers, err := i.forkManager.GetVotingPowers(height)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if powers != nil {
+		return vals, minWeightedQuorumSize(vals, powers), nil
+	}
+
+	return vals, i.quorumSize(height)(vals), nil
+}
+
+// GetIBFTVotes returns the votes at the given height,
+// it returns nil if the validator store doesn't have voting function
+func (i *backendIBFT) GetIBFTVotes(height uint64) ([]*store.Vote, error) {
+	validatorStore, err := i.forkManager.GetValidatorStore(height)
+	if err != nil {
+		return nil, err
+	}
+
+	return getVotes(validatorStore, height)
+}
+
+// GetIBFTCandidates returns the candidates proposed by the node
+func (i *backendIBFT) GetIBFTCandidates() ([]*store.Candidate, error) {
+	validatorStore, err := i.forkManager.GetValidatorStore(i.blockchain.Header().Number)
+	if err != nil {
+		return nil, err
+	}
+
+	votableStore, ok := validatorStore.(Votable)
+	if !ok {
+		return nil, ErrVotingNotSupported
+	}
+
+	return votableStore.Candidates(), nil
+}
+
+// GetIBFTProposer returns the proposer of the block at the given height and round
+func (i *backendIBFT) GetIBFTProposer(height, round uint64) (types.Address, error) {
+	vals, err := i.forkManager.GetValidators(height)
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+
+	powers, err := i.forkManager.GetVotingPowers(height)
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+
+	proposer, err := i.calcProposer(vals, powers, height, round)
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+
+	return proposer.Addr(), nil
+}
+
+// GetIBFTSigners returns the proposer of the block and the validators who signed its committed seals
+func (i *backendIBFT) GetIBFTSigners(header *types.Header) (types.Address, []types.Address, error) {
+	if header.Number == 0 {
+		// genesis is not sealed
+		return types.ZeroAddress, []types.Address{}, nil
+	}
+
+	headerSigner, vals, _, err := getModulesFromForkManager(i.forkManager, header.Number)
+	if err != nil {
+		return types.ZeroAddress, nil, err
+	}
+
+	proposer, err := headerSigner.EcrecoverFromHeader(header)
+	if err != nil {
+		return types.ZeroAddress, nil, err
+	}
+
+	committers, err := headerSigner.GetCommittedSealsSigners(header, vals)
+	if err != nil {
+		return types.ZeroAddress, nil, err
+	}
+
+	return proposer, committers, nil
+}
+
+// GetIBFTValidatorKey returns the address of the validator key of the node
+func (i *backendIBFT) GetIBFTValidatorKey() (types.Address, error) {
+	if currentSigner, _ := i.getCurrentModules(); currentSigner != nil {
+		return currentSigner.Address(), nil
+	}
+
+	signer, err := i.forkManager.GetSigner(i.blockchain.Header().Number)
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+
+	return signer.Address(), nil
+}
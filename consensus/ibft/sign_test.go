@@ -3,6 +3,7 @@ package ibft
 import (
 	"testing"
 
+	protoIBFT "github.com/0xPolygon/go-ibft/messages/proto"
 	"github.com/0xPolygon/polygon-edge/consensus/ibft/signer"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/stretchr/testify/assert"
@@ -37,11 +38,11 @@ func TestSign_Sealer(t *testing.T) {
 		signer.NewECDSAKeyManagerFromKey(pool.get("A").priv),
 	)
 
-	badSealedBlock, _ := signerX.WriteProposerSeal(h)
+	badSealedBlock, _ := signerX.WriteProposerSeal(h, 0)
 	assert.Error(t, verifyProposerSeal(badSealedBlock, signerA, correctValset))
 
 	// seal the block with a validator
-	goodSealedBlock, _ := signerA.WriteProposerSeal(h)
+	goodSealedBlock, _ := signerA.WriteProposerSeal(h, 0)
 	assert.NoError(t, verifyProposerSeal(goodSealedBlock, signerA, correctValset))
 }
 
@@ -88,7 +89,7 @@ func TestSign_CommittedSeals(t *testing.T) {
 				),
 			)
 
-			seal, err := signer.CreateCommittedSeal(h.Hash.Bytes())
+			seal, err := signer.CreateCommittedSeal(h.Hash.Bytes(), &protoIBFT.View{Height: h.Number})
 
 			assert.NoError(t, err)
 
@@ -150,6 +150,23 @@ func (s *BLSKeyManager) VerifyCommittedSeals(
 	return verifyBLSCommittedSealsImpl(committedSeal, message, vals)
 }
 
+func (s *BLSKeyManager) GetCommittedSealsSigners(
+	rawCommittedSeal Seals,
+	_ []byte,
+	vals validators.Validators,
+) ([]types.Address, error) {
+	committedSeal, ok := rawCommittedSeal.(*AggregatedSeal)
+	if !ok {
+		return nil, ErrInvalidCommittedSealType
+	}
+
+	if vals.Type() != s.Type() {
+		return nil, ErrInvalidValidators
+	}
+
+	return getAggregatedSealSigners(committedSeal, vals)
+}
+
 func (s *BLSKeyManager) SignIBFTMessage(msg []byte) ([]byte, error) {
 	return crypto.Sign(s.ecdsaKey, msg)
 }
@@ -242,6 +259,32 @@ func getBLSSignatures(
 	return blsSignatures, bitMap, nil
 }
 
+// getAggregatedSealSigners returns the addresses of the validators set in the bitmap of the committed seals
+func getAggregatedSealSigners(
+	committedSeal *AggregatedSeal,
+	vals validators.Validators,
+) ([]types.Address, error) {
+	if committedSeal.Bitmap == nil {
+		return nil, nil
+	}
+
+	signers := make([]types.Address, 0, vals.Len())
+
+	for idx := 0; idx < committedSeal.Bitmap.BitLen(); idx++ {
+		if committedSeal.Bitmap.Bit(idx) == 0 {
+			continue
+		}
+
+		if idx >= vals.Len() {
+			return nil, ErrValidatorNotFound
+		}
+
+		signers = append(signers, vals.At(uint64(idx)).Addr())
+	}
+
+	return signers, nil
+}
+
 func createAggregatedBLSPubKeys(
 	vals validators.Validators,
 	bitMap *big.Int,
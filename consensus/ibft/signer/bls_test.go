@@ -587,6 +587,87 @@ func TestBLSKeyManagerVerifyCommittedSeals(t *testing.T) {
 	}
 }
 
+func TestBLSKeyManagerGetCommittedSealsSigners(t *testing.T) {
+	t.Parallel()
+
+	blsKeyManager1, _, _ := newTestBLSKeyManager(t)
+	blsKeyManager2, _, _ := newTestBLSKeyManager(t)
+	blsKeyManager3, _, _ := newTestBLSKeyManager(t)
+
+	validatorSet := validators.NewBLSValidatorSet(
+		testBLSKeyManagerToBLSValidator(t, blsKeyManager1),
+		testBLSKeyManagerToBLSValidator(t, blsKeyManager2),
+		testBLSKeyManagerToBLSValidator(t, blsKeyManager3),
+	)
+
+	bitmap := new(big.Int)
+	bitmap.SetBit(bitmap, 0, 1)
+	bitmap.SetBit(bitmap, 2, 1)
+
+	tests := []struct {
+		name              string
+		rawCommittedSeals Seals
+		validators        validators.Validators
+		expectedRes       []types.Address
+		expectedErr       error
+	}{
+		{
+			name:              "should return ErrInvalidCommittedSealType if rawCommittedSeal is not *AggregatedSeal",
+			rawCommittedSeals: &SerializedSeal{},
+			validators:        validatorSet,
+			expectedRes:       nil,
+			expectedErr:       ErrInvalidCommittedSealType,
+		},
+		{
+			name: "should return ErrInvalidValidators if rawValidators is not *BLSValidators",
+			rawCommittedSeals: &AggregatedSeal{
+				Bitmap: bitmap,
+			},
+			validators:  validators.NewECDSAValidatorSet(),
+			expectedRes: nil,
+			expectedErr: ErrInvalidValidators,
+		},
+		{
+			name: "should return ErrValidatorNotFound if the bitmap is out of validators",
+			rawCommittedSeals: &AggregatedSeal{
+				Bitmap: big.NewInt(0).SetBit(new(big.Int), 3, 1),
+			},
+			validators:  validatorSet,
+			expectedRes: nil,
+			expectedErr: ErrValidatorNotFound,
+		},
+		{
+			name: "should return the validators in the bitmap",
+			rawCommittedSeals: &AggregatedSeal{
+				Bitmap: bitmap,
+			},
+			validators: validatorSet,
+			expectedRes: []types.Address{
+				blsKeyManager1.Address(),
+				blsKeyManager3.Address(),
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := blsKeyManager1.GetCommittedSealsSigners(
+				test.rawCommittedSeals,
+				nil,
+				test.validators,
+			)
+
+			assert.Equal(t, test.expectedRes, res)
+			testHelper.AssertErrorMessageContains(t, test.expectedErr, err)
+		})
+	}
+}
+
 func TestBLSKeyManagerSignIBFTMessageAndEcrecover(t *testing.T) {
 	t.Parallel()
 
@@ -86,9 +86,9 @@ func (s *ECDSAKeyManager) VerifyCommittedSeal(
 		return ErrSignerMismatch
 	}
 
-	// if !vals.Includes(address) {
-	// 	return ErrNonValidatorCommittedSeal
-	// }
+	if !vals.Includes(address) {
+		return ErrNonValidatorCommittedSeal
+	}
 
 	return nil
 }
@@ -129,6 +129,19 @@ func (s *ECDSAKeyManager) VerifyCommittedSeals(
 	return s.verifyCommittedSealsImpl(committedSeal, digest, vals)
 }
 
+func (s *ECDSAKeyManager) GetCommittedSealsSigners(
+	rawCommittedSeal Seals,
+	digest []byte,
+	_ validators.Validators,
+) ([]types.Address, error) {
+	committedSeal, ok := rawCommittedSeal.(*SerializedSeal)
+	if !ok {
+		return nil, ErrInvalidCommittedSealType
+	}
+
+	return getSerializedSealSigners(committedSeal, digest)
+}
+
 func (s *ECDSAKeyManager) SignIBFTMessage(msg []byte) ([]byte, error) {
 	return crypto.Sign(s.key, msg)
 }
@@ -143,8 +156,6 @@ func (s *ECDSAKeyManager) verifyCommittedSealsImpl(
 	validators validators.Validators,
 ) (int, error) {
 	numSeals := committedSeal.Num()
-	// fmt.Printf(" numSeals ------- %d \n", numSeals)
-	// debug.PrintStack()
 	if numSeals == 0 {
 		return 0, ErrEmptyCommittedSeals
 	}
@@ -161,9 +172,9 @@ func (s *ECDSAKeyManager) verifyCommittedSealsImpl(
 			return 0, ErrRepeatedCommittedSeal
 		}
 
-		// if !validators.Includes(addr) {
-		// 	return 0, ErrNonValidatorCommittedSeal
-		// }
+		if !validators.Includes(addr) {
+			return 0, ErrNonValidatorCommittedSeal
+		}
 
 		visited[addr] = true
 	}
@@ -171,6 +182,22 @@ func (s *ECDSAKeyManager) verifyCommittedSealsImpl(
 	return numSeals, nil
 }
 
+// getSerializedSealSigners recovers the addresses of the signers from the committed seals
+func getSerializedSealSigners(committedSeal *SerializedSeal, msg []byte) ([]types.Address, error) {
+	signers := make([]types.Address, 0, committedSeal.Num())
+
+	for _, seal := range *committedSeal {
+		addr, err := ecrecover(seal, msg)
+		if err != nil {
+			return nil, err
+		}
+
+		signers = append(signers, addr)
+	}
+
+	return signers, nil
+}
+
 type SerializedSeal [][]byte
 
 func (s *SerializedSeal) Num() int {
@@ -424,6 +424,68 @@ func TestECDSAKeyManagerVerifyCommittedSeals(t *testing.T) {
 	}
 }
 
+func TestECDSAKeyManagerGetCommittedSealsSigners(t *testing.T) {
+	t.Parallel()
+
+	ecdsaKeyManager1, _ := newTestECDSAKeyManager(t)
+	ecdsaKeyManager2, _ := newTestECDSAKeyManager(t)
+
+	msg := crypto.Keccak256(
+		wrapCommitHash(
+			hex.MustDecodeHex(testHeaderHashHex),
+		),
+	)
+
+	committedSeal1, err := ecdsaKeyManager1.SignCommittedSeal(msg)
+	assert.NoError(t, err)
+
+	committedSeal2, err := ecdsaKeyManager2.SignCommittedSeal(msg)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		committedSeals Seals
+		expectedRes    []types.Address
+		expectedErr    error
+	}{
+		{
+			name:           "should return ErrInvalidCommittedSealType if the Seals is not *SerializedSeal",
+			committedSeals: &AggregatedSeal{},
+			expectedRes:    nil,
+			expectedErr:    ErrInvalidCommittedSealType,
+		},
+		{
+			name: "should return the signers of CommittedSeals",
+			committedSeals: &SerializedSeal{
+				committedSeal2,
+				committedSeal1,
+			},
+			expectedRes: []types.Address{
+				ecdsaKeyManager2.Address(),
+				ecdsaKeyManager1.Address(),
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := ecdsaKeyManager1.GetCommittedSealsSigners(
+				test.committedSeals,
+				msg,
+				validators.NewECDSAValidatorSet(),
+			)
+
+			assert.Equal(t, test.expectedRes, res)
+			assert.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
 func TestECDSAKeyManagerSignIBFTMessageAndEcrecover(t *testing.T) {
 	t.Parallel()
 
@@ -2,13 +2,13 @@ package signer
 
 import (
 	"crypto/ecdsa"
+	"encoding/binary"
 	"fmt"
 	"testing"
 
 	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/helper/keccak"
 	"github.com/0xPolygon/polygon-edge/secrets"
-	"github.com/0xPolygon/polygon-edge/secrets/awskms"
 	"github.com/0xPolygon/polygon-edge/secrets/helper"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/0xPolygon/polygon-edge/validators"
@@ -21,6 +21,9 @@ const (
 	// legacy committed seals, so it needs to be preserved in order
 	// for new clients to read old committed seals
 	legacyCommitCode = 2
+
+	// validatorPeerPrefix is the prefix of the signed mapping of the validator address to the peer ID
+	validatorPeerPrefix = "ibft validator peer"
 )
 
 // wrapCommitHash calculates digest for CommittedSeal
@@ -28,6 +31,27 @@ func wrapCommitHash(data []byte) []byte {
 	return crypto.Keccak256(data, []byte{byte(legacyCommitCode)})
 }
 
+// validatorPeerHash calculates digest for the mapping of the validator address to the peer ID
+func validatorPeerHash(addr types.Address, peerID string, timestamp uint64) []byte {
+	return crypto.Keccak256(validatorPeerPreimage(addr, peerID, timestamp))
+}
+
+// validatorPeerPreimage returns the signed data of the mapping of the validator address to the peer ID,
+// the prefix separates it from the other signed data
+func validatorPeerPreimage(addr types.Address, peerID string, timestamp uint64) []byte {
+	rawTimestamp := make([]byte, 8)
+	binary.BigEndian.PutUint64(rawTimestamp, timestamp)
+
+	preimage := make([]byte, 0, len(validatorPeerPrefix)+types.AddressLength+len(peerID)+len(rawTimestamp))
+
+	preimage = append(preimage, validatorPeerPrefix...)
+	preimage = append(preimage, addr.Bytes()...)
+	preimage = append(preimage, peerID...)
+	preimage = append(preimage, rawTimestamp...)
+
+	return preimage
+}
+
 // getOrCreateECDSAKey loads ECDSA key or creates a new key
 func getOrCreateECDSAKey(manager secrets.SecretsManager) (*ecdsa.PrivateKey, error) {
 	if !manager.HasSecret(secrets.ValidatorKey) {
@@ -103,10 +127,10 @@ func NewKeyManagerFromType(
 ) (KeyManager, error) {
 	switch validatorType {
 	case validators.ECDSAValidatorType:
-		_, ok := secretManager.(*awskms.KmsSecretManager)
-		if ok {
+		if secrets.IsRemoteSigner(secretManager.GetSecretsManagerType()) {
 			return NewKmsKeyManager(secretManager, chainId)
 		}
+
 		return NewECDSAKeyManager(secretManager)
 	case validators.BLSValidatorType:
 		return NewBLSKeyManager(secretManager)
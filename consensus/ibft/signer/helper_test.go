@@ -334,6 +334,18 @@ func TestNewKeyManagerFromType(t *testing.T) {
 
 	testECDSAKey, testECDSAKeyEncoded := newTestECDSAKey(t)
 	testBLSKey, testBLSKeyEncoded := newTestBLSKey(t)
+	testAddress := crypto.PubKeyToAddress(&testECDSAKey.PublicKey)
+
+	remoteSigner := &MockSecretManager{
+		GetSecretInfoFn: func(name string) (*secrets.SecretInfo, error) {
+			return &secrets.SecretInfo{
+				Address: testAddress.String(),
+			}, nil
+		},
+		GetSecretsManagerTypeFn: func() secrets.SecretsManagerType {
+			return secrets.PKCS11
+		},
+	}
 
 	tests := []struct {
 		name              string
@@ -352,10 +364,24 @@ func TestNewKeyManagerFromType(t *testing.T) {
 				GetSecretFn: func(name string) ([]byte, error) {
 					return testECDSAKeyEncoded, nil
 				},
+				GetSecretsManagerTypeFn: func() secrets.SecretsManagerType {
+					return secrets.Local
+				},
 			},
 			expectedRes: NewECDSAKeyManagerFromKey(testECDSAKey),
 			expectedErr: nil,
 		},
+		{
+			name:              "ECDSAValidatorType with remote signer",
+			validatorType:     validators.ECDSAValidatorType,
+			mockSecretManager: remoteSigner,
+			expectedRes: &KmsKeyManager{
+				manager: remoteSigner,
+				address: testAddress,
+				chainId: 100,
+			},
+			expectedErr: nil,
+		},
 		{
 			name:          "BLSValidatorType",
 			validatorType: validators.BLSValidatorType,
@@ -390,7 +416,7 @@ func TestNewKeyManagerFromType(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := NewKeyManagerFromType(test.mockSecretManager, test.validatorType)
+			res, err := NewKeyManagerFromType(test.mockSecretManager, test.validatorType, 100)
 
 			assert.Equal(t, test.expectedRes, res)
 
@@ -25,8 +25,17 @@ type KeyManager interface {
 	GenerateCommittedSeals(sealsByValidator map[types.Address][]byte, vals validators.Validators) (Seals, error)
 	// VerifyCommittedSeals verifies CommittedSeals
 	VerifyCommittedSeals(seals Seals, hash []byte, vals validators.Validators) (int, error)
+	// GetCommittedSealsSigners returns the addresses of the validators who signed CommittedSeals
+	GetCommittedSealsSigners(seals Seals, hash []byte, vals validators.Validators) ([]types.Address, error)
 	// SignIBFTMessage signs for arbitrary bytes message
 	SignIBFTMessage(msg []byte) ([]byte, error)
 	// Ecrecover recovers address from signature and message
 	Ecrecover(sig []byte, msg []byte) (types.Address, error)
 }
+
+// PreimageKeyManager is implemented by the KeyManagers that sign the keccak256 hash of the preimage
+// instead of the digest, as the remote signers hashing the data themselves need the preimage
+type PreimageKeyManager interface {
+	// SignPreimage signs the keccak256 hash of the preimage
+	SignPreimage(preimage []byte) ([]byte, error)
+}
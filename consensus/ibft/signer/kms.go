@@ -1,6 +1,7 @@
 package signer
 
 import (
+	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/0xPolygon/polygon-edge/validators"
@@ -81,9 +82,9 @@ func (k *KmsKeyManager) VerifyCommittedSeal(
 		return ErrSignerMismatch
 	}
 
-	// if !vals.Includes(address) {
-	// 	return ErrNonValidatorCommittedSeal
-	// }
+	if !vals.Includes(address) {
+		return ErrNonValidatorCommittedSeal
+	}
 
 	return nil
 }
@@ -124,10 +125,33 @@ func (k *KmsKeyManager) VerifyCommittedSeals(
 	return k.verifyCommittedSealsImpl(committedSeal, digest, vals)
 }
 
+func (k *KmsKeyManager) GetCommittedSealsSigners(
+	rawCommittedSeal Seals,
+	digest []byte,
+	_ validators.Validators,
+) ([]types.Address, error) {
+	committedSeal, ok := rawCommittedSeal.(*SerializedSeal)
+	if !ok {
+		return nil, ErrInvalidCommittedSealType
+	}
+
+	return getSerializedSealSigners(committedSeal, digest)
+}
+
 func (k *KmsKeyManager) SignIBFTMessage(msg []byte) ([]byte, error) {
 	return k.manager.SignBySecret(secrets.ValidatorKey, k.chainId, msg)
 }
 
+// SignPreimage signs the keccak256 hash of the preimage. The preimage is passed to the secrets managers
+// hashing the data themselves, the others sign the digest
+func (k *KmsKeyManager) SignPreimage(preimage []byte) ([]byte, error) {
+	if signer, ok := k.manager.(secrets.PreimageSigner); ok {
+		return signer.SignPreimageBySecret(secrets.ValidatorKey, k.chainId, preimage)
+	}
+
+	return k.manager.SignBySecret(secrets.ValidatorKey, k.chainId, crypto.Keccak256(preimage))
+}
+
 func (k *KmsKeyManager) Ecrecover(sig, digest []byte) (types.Address, error) {
 	return ecrecover(sig, digest)
 }
@@ -138,7 +162,6 @@ func (k *KmsKeyManager) verifyCommittedSealsImpl(
 	validators validators.Validators,
 ) (int, error) {
 	numSeals := committedSeal.Num()
-	// debug.PrintStack()
 	if numSeals == 0 {
 		return 0, ErrEmptyCommittedSeals
 	}
@@ -155,9 +178,9 @@ func (k *KmsKeyManager) verifyCommittedSealsImpl(
 			return 0, ErrRepeatedCommittedSeal
 		}
 
-		// if !validators.Includes(addr) {
-		// 	return 0, ErrNonValidatorCommittedSeal
-		// }
+		if !validators.Includes(addr) {
+			return 0, ErrNonValidatorCommittedSeal
+		}
 
 		visited[addr] = true
 	}
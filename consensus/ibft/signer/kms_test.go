@@ -0,0 +1,71 @@
+package signer
+
+import (
+	protoIBFT "github.com/0xPolygon/go-ibft/messages/proto"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/secrets/remotesigner"
+	"github.com/0xPolygon/polygon-edge/secrets/remotesigner/fakesigner"
+	"github.com/0xPolygon/polygon-edge/validators"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKmsKeyManager_RemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	testKey, _ := newTestECDSAKey(t)
+
+	server := fakesigner.NewServer(testKey)
+	t.Cleanup(server.Close)
+
+	secretsManager, err := remotesigner.SecretsManagerFactory(
+		&secrets.SecretsManagerConfig{
+			Type:      secrets.RemoteSigner,
+			ServerURL: server.URL,
+		},
+		&secrets.SecretsManagerParams{
+			Logger: hclog.NewNullLogger(),
+			Extra: map[string]interface{}{
+				secrets.Path: t.TempDir(),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	keyManager, err := NewKeyManagerFromType(secretsManager, validators.ECDSAValidatorType, 100)
+	require.NoError(t, err)
+
+	assert.IsType(t, &KmsKeyManager{}, keyManager)
+	assert.Equal(t, crypto.PubKeyToAddress(&testKey.PublicKey), keyManager.Address())
+
+	// The remote signer signs the preimages, so the seals are signed through SignerImpl
+	signer := NewSigner(keyManager, nil)
+
+	hash := hex.MustDecodeHex(testHeaderHashHex)
+	msg := crypto.Keccak256(wrapCommitHash(hash))
+
+	committedSeal, err := signer.CreateCommittedSeal(hash, &protoIBFT.View{Height: 1})
+	require.NoError(t, err)
+
+	addr, err := keyManager.Ecrecover(committedSeal, msg)
+	require.NoError(t, err)
+	assert.Equal(t, keyManager.Address(), addr)
+
+	assert.NoError(t, keyManager.VerifyCommittedSeal(
+		validators.NewECDSAValidatorSet(
+			validators.NewECDSAValidator(keyManager.Address()),
+		),
+		keyManager.Address(),
+		committedSeal,
+		msg,
+	))
+
+	// The digests can't be signed as is
+	_, err = keyManager.SignCommittedSeal(msg)
+	assert.Error(t, err)
+}
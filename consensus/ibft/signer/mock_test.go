@@ -10,9 +10,11 @@ type MockSecretManager struct {
 	// skip implementing the methods not to be used
 	secrets.SecretsManager
 
-	HasSecretFn func(string) bool
-	GetSecretFn func(string) ([]byte, error)
-	SetSecretFn func(string, []byte) error
+	HasSecretFn             func(string) bool
+	GetSecretFn             func(string) ([]byte, error)
+	SetSecretFn             func(string, []byte) error
+	GetSecretInfoFn         func(string) (*secrets.SecretInfo, error)
+	GetSecretsManagerTypeFn func() secrets.SecretsManagerType
 }
 
 func (m *MockSecretManager) HasSecret(name string) bool {
@@ -27,18 +29,27 @@ func (m *MockSecretManager) SetSecret(name string, key []byte) error {
 	return m.SetSecretFn(name, key)
 }
 
+func (m *MockSecretManager) GetSecretInfo(name string) (*secrets.SecretInfo, error) {
+	return m.GetSecretInfoFn(name)
+}
+
+func (m *MockSecretManager) GetSecretsManagerType() secrets.SecretsManagerType {
+	return m.GetSecretsManagerTypeFn()
+}
+
 type MockKeyManager struct {
-	TypeFunc                   func() validators.ValidatorType
-	AddressFunc                func() types.Address
-	NewEmptyValidatorsFunc     func() validators.Validators
-	NewEmptyCommittedSealsFunc func() Seals
-	SignProposerSealFunc       func([]byte) ([]byte, error)
-	SignCommittedSealFunc      func([]byte) ([]byte, error)
-	VerifyCommittedSealFunc    func(validators.Validators, types.Address, []byte, []byte) error
-	GenerateCommittedSealsFunc func(map[types.Address][]byte, validators.Validators) (Seals, error)
-	VerifyCommittedSealsFunc   func(Seals, []byte, validators.Validators) (int, error)
-	SignIBFTMessageFunc        func([]byte) ([]byte, error)
-	EcrecoverFunc              func([]byte, []byte) (types.Address, error)
+	TypeFunc                     func() validators.ValidatorType
+	AddressFunc                  func() types.Address
+	NewEmptyValidatorsFunc       func() validators.Validators
+	NewEmptyCommittedSealsFunc   func() Seals
+	SignProposerSealFunc         func([]byte) ([]byte, error)
+	SignCommittedSealFunc        func([]byte) ([]byte, error)
+	VerifyCommittedSealFunc      func(validators.Validators, types.Address, []byte, []byte) error
+	GenerateCommittedSealsFunc   func(map[types.Address][]byte, validators.Validators) (Seals, error)
+	VerifyCommittedSealsFunc     func(Seals, []byte, validators.Validators) (int, error)
+	GetCommittedSealsSignersFunc func(Seals, []byte, validators.Validators) ([]types.Address, error)
+	SignIBFTMessageFunc          func([]byte) ([]byte, error)
+	EcrecoverFunc                func([]byte, []byte) (types.Address, error)
 }
 
 func (m *MockKeyManager) Type() validators.ValidatorType {
@@ -79,6 +90,14 @@ func (m *MockKeyManager) VerifyCommittedSeals(seals Seals, hash []byte, vals val
 	return m.VerifyCommittedSealsFunc(seals, hash, vals)
 }
 
+func (m *MockKeyManager) GetCommittedSealsSigners(
+	seals Seals,
+	hash []byte,
+	vals validators.Validators,
+) ([]types.Address, error) {
+	return m.GetCommittedSealsSignersFunc(seals, hash, vals)
+}
+
 func (m *MockKeyManager) SignIBFTMessage(msg []byte) ([]byte, error) {
 	return m.SignIBFTMessageFunc(msg)
 }
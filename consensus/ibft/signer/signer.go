@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 
+	protoIBFT "github.com/0xPolygon/go-ibft/messages/proto"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/slashing"
 	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/0xPolygon/polygon-edge/validators"
@@ -22,8 +24,17 @@ var (
 	ErrInvalidValidators          = errors.New("invalid validators type")
 	ErrInvalidValidator           = errors.New("invalid validator type")
 	ErrInvalidSignature           = errors.New("invalid signature")
+	ErrUnknownMessageType         = errors.New("unknown IBFT message type")
 )
 
+// messageKinds maps the IBFT message types to the signature kinds of slashing protection
+var messageKinds = map[protoIBFT.MessageType]slashing.Kind{
+	protoIBFT.MessageType_PREPREPARE:   slashing.PrePrepare,
+	protoIBFT.MessageType_PREPARE:      slashing.Prepare,
+	protoIBFT.MessageType_COMMIT:       slashing.Commit,
+	protoIBFT.MessageType_ROUND_CHANGE: slashing.RoundChange,
+}
+
 // Signer is responsible for signing for blocks and messages in IBFT
 type Signer interface {
 	Type() validators.ValidatorType
@@ -35,11 +46,11 @@ type Signer interface {
 	GetValidators(*types.Header) (validators.Validators, error)
 
 	// ProposerSeal
-	WriteProposerSeal(*types.Header) (*types.Header, error)
+	WriteProposerSeal(*types.Header, uint64) (*types.Header, error)
 	EcrecoverFromHeader(*types.Header) (types.Address, error)
 
 	// CommittedSeal
-	CreateCommittedSeal([]byte) ([]byte, error)
+	CreateCommittedSeal([]byte, *protoIBFT.View) ([]byte, error)
 	VerifyCommittedSeal(validators.Validators, types.Address, []byte, []byte) error
 
 	// CommittedSeals
@@ -50,6 +61,8 @@ type Signer interface {
 		quorumSize int,
 	) error
 
+	GetCommittedSealsSigners(*types.Header, validators.Validators) ([]types.Address, error)
+
 	// ParentCommittedSeals
 	VerifyParentCommittedSeals(
 		parent, header *types.Header,
@@ -57,19 +70,33 @@ type Signer interface {
 		quorum int,
 		mustExist bool,
 	) error
+	GetParentCommittedSealsSigners(
+		parent, header *types.Header,
+		parentValidators validators.Validators,
+	) ([]types.Address, error)
 
 	// IBFTMessage
-	SignIBFTMessage([]byte) ([]byte, error)
+	SignIBFTMessage(*protoIBFT.Message) ([]byte, error)
 	EcrecoverFromIBFTMessage([]byte, []byte) (types.Address, error)
 
+	// ValidatorPeer
+	SignValidatorPeer(peerID string, timestamp uint64) ([]byte, error)
+	EcrecoverFromValidatorPeer(signature []byte, addr types.Address, peerID string, timestamp uint64) (types.Address, error)
+
 	// Hash of Header
 	CalculateHeaderHash(*types.Header) (types.Hash, error)
 }
 
+// SlashingProtection is checked before any signature is made by the key manager
+type SlashingProtection interface {
+	CheckAndRecord(signer types.Address, kind slashing.Kind, height, round uint64, signingRoot types.Hash) error
+}
+
 // SignerImpl is an implementation that meets Signer
 type SignerImpl struct {
-	keyManager       KeyManager
-	parentKeyManager KeyManager
+	keyManager         KeyManager
+	parentKeyManager   KeyManager
+	slashingProtection SlashingProtection
 }
 
 // NewSigner is a constructor of SignerImpl
@@ -83,6 +110,36 @@ func NewSigner(
 	}
 }
 
+// SetSlashingProtection sets the slashing protection checked before signing
+func (s *SignerImpl) SetSlashingProtection(protection SlashingProtection) {
+	s.slashingProtection = protection
+}
+
+// sign signs the keccak256 digest of the preimage by signDigest. The key managers signing
+// the preimage get the preimage instead, to sign by the signers hashing the data themselves
+func (s *SignerImpl) sign(preimage []byte, signDigest func([]byte) ([]byte, error)) ([]byte, error) {
+	if keyManager, ok := s.keyManager.(PreimageKeyManager); ok {
+		return keyManager.SignPreimage(preimage)
+	}
+
+	return signDigest(crypto.Keccak256(preimage))
+}
+
+// checkSlashingProtection checks and records the digest about to be signed by the key manager
+func (s *SignerImpl) checkSlashingProtection(kind slashing.Kind, height, round uint64, digest []byte) error {
+	if s.slashingProtection == nil {
+		return nil
+	}
+
+	return s.slashingProtection.CheckAndRecord(
+		s.keyManager.Address(),
+		kind,
+		height,
+		round,
+		types.BytesToHash(digest),
+	)
+}
+
 // Type returns that validator type the signer expects
 func (s *SignerImpl) Type() validators.ValidatorType {
 	return s.keyManager.Type()
@@ -131,16 +188,22 @@ func (s *SignerImpl) GetIBFTExtra(header *types.Header) (*IstanbulExtra, error)
 	return extra, nil
 }
 
-// WriteProposerSeal signs and set ProposerSeal into IBFT Extra of the header
-func (s *SignerImpl) WriteProposerSeal(header *types.Header) (*types.Header, error) {
+// WriteProposerSeal signs and set ProposerSeal into IBFT Extra of the header proposed at the given round
+func (s *SignerImpl) WriteProposerSeal(header *types.Header, round uint64) (*types.Header, error) {
 	hash, err := s.CalculateHeaderHash(header)
 	if err != nil {
 		return nil, err
 	}
 
-	seal, err := s.keyManager.SignProposerSeal(
-		crypto.Keccak256(hash.Bytes()),
-	)
+	preimage := hash.Bytes()
+
+	if err := s.checkSlashingProtection(
+		slashing.ProposerSeal, header.Number, round, crypto.Keccak256(preimage),
+	); err != nil {
+		return nil, err
+	}
+
+	seal, err := s.sign(preimage, s.keyManager.SignProposerSeal)
 	if err != nil {
 		return nil, err
 	}
@@ -163,17 +226,21 @@ func (s *SignerImpl) EcrecoverFromHeader(header *types.Header) (types.Address, e
 	return s.keyManager.Ecrecover(extra.ProposerSeal, crypto.Keccak256(header.Hash.Bytes()))
 }
 
-// CreateCommittedSeal returns CommittedSeal from given hash
-func (s *SignerImpl) CreateCommittedSeal(hash []byte) ([]byte, error) {
-	return s.keyManager.SignCommittedSeal(
-		// Of course, this keccaking of an extended array is not according to the IBFT 2.0 spec,
-		// but almost nothing in this legacy signing package is. This is kept
-		// in order to preserve the running chains that used these
-		// old (and very, very incorrect) signing schemes
-		crypto.Keccak256(
-			wrapCommitHash(hash[:]),
-		),
-	)
+// CreateCommittedSeal returns CommittedSeal from given hash committed at the given view
+func (s *SignerImpl) CreateCommittedSeal(hash []byte, view *protoIBFT.View) ([]byte, error) {
+	// Of course, this keccaking of an extended array is not according to the IBFT 2.0 spec,
+	// but almost nothing in this legacy signing package is. This is kept
+	// in order to preserve the running chains that used these
+	// old (and very, very incorrect) signing schemes
+	preimage := wrapCommitHash(hash[:])
+
+	if err := s.checkSlashingProtection(
+		slashing.CommittedSeal, view.Height, view.Round, crypto.Keccak256(preimage),
+	); err != nil {
+		return nil, err
+	}
+
+	return s.sign(preimage, s.keyManager.SignCommittedSeal)
 }
 
 // CreateCommittedSeal verifies a CommittedSeal
@@ -248,7 +315,6 @@ func (s *SignerImpl) VerifyCommittedSeals(
 		return err
 	}
 
-	fmt.Printf(" +++++ numSeals: %d, quorumSize: %d  height:%d validator_lens: %d \n ", numSeals, quorumSize, header.Number, validators.Len())
 	if numSeals < quorumSize {
 		return ErrNotEnoughCommittedSeals
 	}
@@ -256,6 +322,32 @@ func (s *SignerImpl) VerifyCommittedSeals(
 	return nil
 }
 
+// GetCommittedSealsSigners returns the addresses of the validators who signed CommittedSeals in the header
+func (s *SignerImpl) GetCommittedSealsSigners(
+	header *types.Header,
+	validators validators.Validators,
+) ([]types.Address, error) {
+	extra, err := s.GetIBFTExtra(header)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := s.CalculateHeaderHash(header)
+	if err != nil {
+		return nil, err
+	}
+
+	rawMsg := crypto.Keccak256(
+		wrapCommitHash(hash[:]),
+	)
+
+	return s.keyManager.GetCommittedSealsSigners(
+		extra.CommittedSeals,
+		rawMsg,
+		validators,
+	)
+}
+
 // VerifyParentCommittedSeals verifies ParentCommittedSeals in IBFT Extra of the header
 func (s *SignerImpl) VerifyParentCommittedSeals(
 	parent, header *types.Header,
@@ -299,9 +391,51 @@ func (s *SignerImpl) VerifyParentCommittedSeals(
 	return nil
 }
 
-// SignIBFTMessage signs arbitrary message
-func (s *SignerImpl) SignIBFTMessage(msg []byte) ([]byte, error) {
-	return s.keyManager.SignIBFTMessage(crypto.Keccak256(msg))
+// GetParentCommittedSealsSigners returns the addresses of the validators who signed ParentCommittedSeals in the header,
+// it returns nil if the header doesn't have ParentCommittedSeals
+func (s *SignerImpl) GetParentCommittedSealsSigners(
+	parent, header *types.Header,
+	parentValidators validators.Validators,
+) ([]types.Address, error) {
+	parentCommittedSeals, err := s.GetParentCommittedSeals(header)
+	if err != nil {
+		return nil, err
+	}
+
+	if parentCommittedSeals == nil || parentCommittedSeals.Num() == 0 {
+		return nil, nil
+	}
+
+	rawMsg := crypto.Keccak256(
+		wrapCommitHash(parent.Hash.Bytes()),
+	)
+
+	return s.keyManager.GetCommittedSealsSigners(
+		parentCommittedSeals,
+		rawMsg,
+		parentValidators,
+	)
+}
+
+// SignIBFTMessage signs the payload of IBFT message without signature
+func (s *SignerImpl) SignIBFTMessage(msg *protoIBFT.Message) ([]byte, error) {
+	kind, ok := messageKinds[msg.Type]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownMessageType, msg.Type)
+	}
+
+	raw, err := msg.PayloadNoSig()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkSlashingProtection(
+		kind, msg.View.GetHeight(), msg.View.GetRound(), crypto.Keccak256(raw),
+	); err != nil {
+		return nil, err
+	}
+
+	return s.sign(raw, s.keyManager.SignIBFTMessage)
 }
 
 // EcrecoverFromIBFTMessage recovers signer address from given signature and digest
@@ -309,6 +443,25 @@ func (s *SignerImpl) EcrecoverFromIBFTMessage(signature, digest []byte) (types.A
 	return s.keyManager.Ecrecover(signature, crypto.Keccak256(digest))
 }
 
+// SignValidatorPeer signs the mapping of the signer address to the peer ID of its node
+func (s *SignerImpl) SignValidatorPeer(peerID string, timestamp uint64) ([]byte, error) {
+	return s.sign(
+		validatorPeerPreimage(s.Address(), peerID, timestamp),
+		s.keyManager.SignIBFTMessage,
+	)
+}
+
+// EcrecoverFromValidatorPeer recovers signer address from the signature of the mapping
+// of the validator address to the peer ID
+func (s *SignerImpl) EcrecoverFromValidatorPeer(
+	signature []byte,
+	addr types.Address,
+	peerID string,
+	timestamp uint64,
+) (types.Address, error) {
+	return s.keyManager.Ecrecover(signature, validatorPeerHash(addr, peerID, timestamp))
+}
+
 // InitIBFTExtra initializes the extra field
 func (s *SignerImpl) initIbftExtra(
 	header *types.Header,
@@ -6,11 +6,14 @@ import (
 	"math/big"
 	"testing"
 
+	protoIBFT "github.com/0xPolygon/go-ibft/messages/proto"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/slashing"
 	"github.com/0xPolygon/polygon-edge/crypto"
 	testHelper "github.com/0xPolygon/polygon-edge/helper/tests"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/0xPolygon/polygon-edge/validators"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -86,6 +89,22 @@ func newTestSingleKeyManagerSigner(km KeyManager) *SignerImpl {
 	}
 }
 
+func newTestIBFTMessage(msgType protoIBFT.MessageType, height, round uint64, proposalHash []byte) *protoIBFT.Message {
+	return &protoIBFT.Message{
+		View: &protoIBFT.View{
+			Height: height,
+			Round:  round,
+		},
+		From: testAddr1.Bytes(),
+		Type: msgType,
+		Payload: &protoIBFT.Message_PrepareData{
+			PrepareData: &protoIBFT.PrepareMessage{
+				ProposalHash: proposalHash,
+			},
+		},
+	}
+}
+
 func getTestExtraBytes(
 	validators validators.Validators,
 	proposerSeal []byte,
@@ -564,7 +583,7 @@ func TestSignerWriteProposerSeal(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			UseIstanbulHeaderHashInTest(t, test.signer)
 
-			header, err := test.signer.WriteProposerSeal(test.header)
+			header, err := test.signer.WriteProposerSeal(test.header, 0)
 
 			assert.Equal(
 				t,
@@ -684,7 +703,7 @@ func TestSignerCreateCommittedSeal(t *testing.T) {
 		},
 	)
 
-	res, err := signer.CreateCommittedSeal(hash)
+	res, err := signer.CreateCommittedSeal(hash, &protoIBFT.View{Height: 1})
 
 	assert.Equal(t, sig, res)
 	assert.NoError(t, err)
@@ -959,6 +978,92 @@ func TestSignerVerifyCommittedSeals(t *testing.T) {
 	}
 }
 
+func TestSignerGetCommittedSealsSigners(t *testing.T) {
+	tests := []struct {
+		name        string
+		header      *types.Header
+		signers     []types.Address
+		signersErr  error
+		expectedRes []types.Address
+		expectedErr error
+	}{
+		{
+			name:   "should return error if GetIBFTExtra fails",
+			header: &types.Header{},
+			expectedErr: fmt.Errorf(
+				"wrong extra size, expected greater than or equal to %d but actual %d",
+				IstanbulExtraVanity,
+				0,
+			),
+		},
+		{
+			name: "should return error if GetCommittedSealsSigners fails",
+			header: &types.Header{
+				Number: 1,
+				ExtraData: getTestExtraBytes(
+					ecdsaValidators,
+					testProposerSeal,
+					testSerializedSeals1,
+					nil,
+				),
+			},
+			signersErr:  errTest,
+			expectedErr: errTest,
+		},
+		{
+			name: "should return the signers of CommittedSeals",
+			header: &types.Header{
+				Number: 1,
+				ExtraData: getTestExtraBytes(
+					ecdsaValidators,
+					testProposerSeal,
+					testSerializedSeals1,
+					nil,
+				),
+			},
+			signers:     []types.Address{ecdsaValidator1.Addr(), ecdsaValidator2.Addr()},
+			expectedRes: []types.Address{ecdsaValidator1.Addr(), ecdsaValidator2.Addr()},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			var expectedSig []byte
+
+			signer := newTestSingleKeyManagerSigner(&MockKeyManager{
+				NewEmptyValidatorsFunc: func() validators.Validators {
+					return ecdsaValidators
+				},
+				NewEmptyCommittedSealsFunc: func() Seals {
+					return &SerializedSeal{}
+				},
+				GetCommittedSealsSignersFunc: func(s Seals, b []byte, v validators.Validators) ([]types.Address, error) {
+					assert.Equal(t, testSerializedSeals1, s)
+					assert.Equal(t, ecdsaValidators, v)
+					assert.Equal(t, expectedSig, b)
+
+					return test.signers, test.signersErr
+				},
+			})
+
+			UseIstanbulHeaderHashInTest(t, signer)
+
+			expectedSig = crypto.Keccak256(
+				wrapCommitHash(
+					test.header.ComputeHash().Hash.Bytes(),
+				),
+			)
+
+			res, err := signer.GetCommittedSealsSigners(test.header, ecdsaValidators)
+
+			assert.Equal(t, test.expectedRes, res)
+			testHelper.AssertErrorMessageContains(t, test.expectedErr, err)
+		})
+	}
+}
+
 func TestSignerVerifyParentCommittedSeals(t *testing.T) {
 	t.Parallel()
 
@@ -1139,16 +1244,117 @@ func TestSignerVerifyParentCommittedSeals(t *testing.T) {
 	}
 }
 
+func TestSignerGetParentCommittedSealsSigners(t *testing.T) {
+	t.Parallel()
+
+	parentHeader := &types.Header{
+		Hash: types.BytesToHash(crypto.Keccak256(types.ZeroAddress.Bytes())),
+	}
+
+	expectedSig := crypto.Keccak256(
+		wrapCommitHash(
+			parentHeader.Hash.Bytes(),
+		),
+	)
+
+	tests := []struct {
+		name        string
+		header      *types.Header
+		signers     []types.Address
+		signersErr  error
+		expectedRes []types.Address
+		expectedErr error
+	}{
+		{
+			name: "should return nil if header doesn't have ParentCommittedSeals",
+			header: &types.Header{
+				ExtraData: getTestExtraBytes(
+					ecdsaValidators,
+					testProposerSeal,
+					testSerializedSeals1,
+					nil,
+				),
+			},
+			expectedRes: nil,
+			expectedErr: nil,
+		},
+		{
+			name: "should return error if GetCommittedSealsSigners fails",
+			header: &types.Header{
+				ExtraData: getTestExtraBytes(
+					ecdsaValidators,
+					testProposerSeal,
+					testSerializedSeals1,
+					testSerializedSeals2,
+				),
+			},
+			signersErr:  errTest,
+			expectedRes: nil,
+			expectedErr: errTest,
+		},
+		{
+			name: "should return the signers of ParentCommittedSeals",
+			header: &types.Header{
+				ExtraData: getTestExtraBytes(
+					ecdsaValidators,
+					testProposerSeal,
+					testSerializedSeals1,
+					testSerializedSeals2,
+				),
+			},
+			signers:     []types.Address{ecdsaValidator1.Addr()},
+			expectedRes: []types.Address{ecdsaValidator1.Addr()},
+			expectedErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			signer := newTestSingleKeyManagerSigner(&MockKeyManager{
+				NewEmptyValidatorsFunc: func() validators.Validators {
+					return ecdsaValidators
+				},
+				NewEmptyCommittedSealsFunc: func() Seals {
+					return &SerializedSeal{}
+				},
+				GetCommittedSealsSignersFunc: func(s Seals, b []byte, v validators.Validators) ([]types.Address, error) {
+					assert.Equal(t, testSerializedSeals2, s)
+					assert.Equal(t, ecdsaValidators, v)
+					assert.Equal(t, expectedSig, b)
+
+					return test.signers, test.signersErr
+				},
+			})
+
+			res, err := signer.GetParentCommittedSealsSigners(
+				parentHeader,
+				test.header,
+				ecdsaValidators,
+			)
+
+			assert.Equal(t, test.expectedRes, res)
+			assert.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
 func TestSignerSignIBFTMessage(t *testing.T) {
 	t.Parallel()
 
-	msg := []byte("test")
+	msg := newTestIBFTMessage(protoIBFT.MessageType_PREPARE, 1, 0, []byte("test"))
 	sig := []byte("signature")
 
+	payload, err := msg.PayloadNoSig()
+	assert.NoError(t, err)
+
 	signer := &SignerImpl{
 		keyManager: &MockKeyManager{
 			SignIBFTMessageFunc: func(data []byte) ([]byte, error) {
-				assert.Equal(t, crypto.Keccak256(msg), data)
+				assert.Equal(t, crypto.Keccak256(payload), data)
 
 				return sig, errTest
 			},
@@ -1205,7 +1411,10 @@ func TestEcrecoverFromIBFTMessage(t *testing.T) {
 func TestSignerSignIBFTMessageAndEcrecoverFromIBFTMessage(t *testing.T) {
 	t.Parallel()
 
-	msg := []byte("message")
+	msg := newTestIBFTMessage(protoIBFT.MessageType_COMMIT, 1, 0, []byte("message"))
+
+	payload, err := msg.PayloadNoSig()
+	assert.NoError(t, err)
 
 	ecdsaKeyManager, _ := newTestECDSAKeyManager(t)
 	blsKeyManager, _, _ := newTestBLSKeyManager(t)
@@ -1235,7 +1444,7 @@ func TestSignerSignIBFTMessageAndEcrecoverFromIBFTMessage(t *testing.T) {
 			sig, err := signer.SignIBFTMessage(msg)
 			assert.NoError(t, err)
 
-			recovered, err := signer.EcrecoverFromIBFTMessage(sig, msg)
+			recovered, err := signer.EcrecoverFromIBFTMessage(sig, payload)
 			assert.NoError(t, err)
 
 			assert.Equal(
@@ -1246,3 +1455,144 @@ func TestSignerSignIBFTMessageAndEcrecoverFromIBFTMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestSignerSignValidatorPeerAndEcrecoverFromValidatorPeer(t *testing.T) {
+	t.Parallel()
+
+	ecdsaKeyManager, _ := newTestECDSAKeyManager(t)
+	blsKeyManager, _, _ := newTestBLSKeyManager(t)
+
+	tests := []struct {
+		name       string
+		keyManager KeyManager
+	}{
+		{
+			name:       "ECDSA Signer",
+			keyManager: ecdsaKeyManager,
+		},
+		{
+			name:       "BLS Signer",
+			keyManager: blsKeyManager,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			signer := newTestSingleKeyManagerSigner(test.keyManager)
+
+			sig, err := signer.SignValidatorPeer("peer", 10)
+			assert.NoError(t, err)
+
+			recovered, err := signer.EcrecoverFromValidatorPeer(sig, signer.Address(), "peer", 10)
+			assert.NoError(t, err)
+			assert.Equal(t, signer.Address(), recovered)
+
+			// the signature doesn't match the other peer ID
+			recovered, err = signer.EcrecoverFromValidatorPeer(sig, signer.Address(), "other", 10)
+			assert.NoError(t, err)
+			assert.NotEqual(t, signer.Address(), recovered)
+
+			// nor the other timestamp
+			recovered, err = signer.EcrecoverFromValidatorPeer(sig, signer.Address(), "peer", 11)
+			assert.NoError(t, err)
+			assert.NotEqual(t, signer.Address(), recovered)
+		})
+	}
+}
+
+func TestSignerSlashingProtection(t *testing.T) {
+	t.Parallel()
+
+	var (
+		hash1 = crypto.Keccak256([]byte{0x1})
+		hash2 = crypto.Keccak256([]byte{0x2})
+	)
+
+	newHeader := func(s *SignerImpl, height uint64, seed byte) *types.Header {
+		header := &types.Header{
+			Number:    height,
+			ExtraData: []byte{seed},
+		}
+
+		s.InitIBFTExtra(header, validators.NewECDSAValidatorSet(), nil)
+
+		return header
+	}
+
+	tests := []struct {
+		name string
+		sign func(*SignerImpl, uint64, uint64, []byte) error
+	}{
+		{
+			name: "ProposerSeal",
+			sign: func(s *SignerImpl, height, round uint64, hash []byte) error {
+				_, err := s.WriteProposerSeal(newHeader(s, height, hash[0]), round)
+
+				return err
+			},
+		},
+		{
+			name: "CommittedSeal",
+			sign: func(s *SignerImpl, height, round uint64, hash []byte) error {
+				_, err := s.CreateCommittedSeal(hash, &protoIBFT.View{Height: height, Round: round})
+
+				return err
+			},
+		},
+		{
+			name: "IBFTMessage",
+			sign: func(s *SignerImpl, height, round uint64, hash []byte) error {
+				_, err := s.SignIBFTMessage(newTestIBFTMessage(protoIBFT.MessageType_PREPARE, height, round, hash))
+
+				return err
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			store, err := slashing.NewMemoryStore()
+			require.NoError(t, err)
+
+			t.Cleanup(func() {
+				_ = store.Close()
+			})
+
+			keyManager, _ := newTestECDSAKeyManager(t)
+
+			signer := NewSigner(keyManager, keyManager)
+			signer.SetSlashingProtection(store)
+
+			// signing the same message again is allowed
+			assert.NoError(t, test.sign(signer, 10, 0, hash1))
+			assert.NoError(t, test.sign(signer, 10, 0, hash1))
+
+			// different message at the same height and round
+			assert.ErrorIs(t, test.sign(signer, 10, 0, hash2), slashing.ErrSlashableSignature)
+
+			// different message at another round or height
+			assert.NoError(t, test.sign(signer, 10, 1, hash2))
+			assert.NoError(t, test.sign(signer, 11, 0, hash2))
+		})
+	}
+}
+
+func TestSignerSignIBFTMessageUnknownType(t *testing.T) {
+	t.Parallel()
+
+	signer := newTestSingleKeyManagerSigner(&MockKeyManager{})
+
+	_, err := signer.SignIBFTMessage(
+		newTestIBFTMessage(protoIBFT.MessageType(100), 1, 0, nil),
+	)
+
+	assert.ErrorIs(t, err, ErrUnknownMessageType)
+}
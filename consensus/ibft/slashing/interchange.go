@@ -0,0 +1,220 @@
+package slashing
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// InterchangeVersion is the version of the interchange format
+const InterchangeVersion = "1"
+
+var ErrUnsupportedVersion = errors.New("unsupported slashing protection interchange version")
+
+// Interchange is the JSON format of the slashing protection history,
+// used to move the history with the validator key between nodes:
+//
+//	{
+//	  "metadata": {
+//	    "interchange_format_version": "1",
+//	    "genesis_hash": "0x..."
+//	  },
+//	  "data": [
+//	    {
+//	      "address": "0x...",
+//	      "watermark": "1000",
+//	      "signatures": [
+//	        {
+//	          "kind": "committed_seal",
+//	          "height": "1200",
+//	          "round": "0",
+//	          "signing_root": "0x..."
+//	        }
+//	      ]
+//	    }
+//	  ]
+//	}
+//
+// The kind is one of proposer_seal, committed_seal, preprepare, prepare, commit and round_change.
+// The signing root is the digest signed by the validator key, the zero hash stands for an unknown
+// digest and refuses any signature at the height and round. No signatures below the watermark are made.
+// The genesis hash can be left empty, otherwise it has to match the chain of the importing node
+type Interchange struct {
+	Metadata InterchangeMetadata `json:"metadata"`
+	Data     []*SignerHistory    `json:"data"`
+}
+
+// InterchangeMetadata is the metadata of the interchange file
+type InterchangeMetadata struct {
+	Version     string     `json:"interchange_format_version"`
+	GenesisHash types.Hash `json:"genesis_hash"`
+}
+
+// SignerHistory is the slashing protection history of a single validator
+type SignerHistory struct {
+	Address    types.Address      `json:"address"`
+	Watermark  uint64             `json:"watermark,string"`
+	Signatures []*SignatureRecord `json:"signatures"`
+}
+
+// SignatureRecord is a signature made by the validator
+type SignatureRecord struct {
+	Kind        Kind       `json:"kind"`
+	Height      uint64     `json:"height,string"`
+	Round       uint64     `json:"round,string"`
+	SigningRoot types.Hash `json:"signing_root"`
+}
+
+// Export returns the slashing protection history of all the signers in the database
+func (s *Store) Export() (*Interchange, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	genesisHash, err := s.getGenesisHash()
+	if err != nil {
+		return nil, err
+	}
+
+	interchange := &Interchange{
+		Metadata: InterchangeMetadata{
+			Version:     InterchangeVersion,
+			GenesisHash: genesisHash,
+		},
+		Data: []*SignerHistory{},
+	}
+
+	histories := make(map[types.Address]*SignerHistory)
+
+	getHistory := func(signer types.Address) *SignerHistory {
+		history, ok := histories[signer]
+		if !ok {
+			history = &SignerHistory{
+				Address:    signer,
+				Signatures: []*SignatureRecord{},
+			}
+
+			histories[signer] = history
+			interchange.Data = append(interchange.Data, history)
+		}
+
+		return history
+	}
+
+	// The signatures are iterated in order of signer, height and round
+	iter := s.db.NewIterator(util.BytesPrefix(signaturePrefix), nil)
+
+	for iter.Next() {
+		signer, height, round, kind := parseSignatureKey(iter.Key())
+
+		history := getHistory(signer)
+		history.Signatures = append(history.Signatures, &SignatureRecord{
+			Kind:        kind,
+			Height:      height,
+			Round:       round,
+			SigningRoot: types.BytesToHash(iter.Value()),
+		})
+	}
+
+	iter.Release()
+
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+
+	iter = s.db.NewIterator(util.BytesPrefix(watermarkPrefix), nil)
+
+	for iter.Next() {
+		signer := types.BytesToAddress(iter.Key()[len(watermarkPrefix):])
+
+		getHistory(signer).Watermark = decodeUint64(iter.Value())
+	}
+
+	iter.Release()
+
+	return interchange, iter.Error()
+}
+
+// Import merges the slashing protection history into the database.
+// A signature conflicting with the local one refuses any signature at its height and round,
+// as both of them may have been made
+func (s *Store) Import(interchange *Interchange) error {
+	if interchange.Metadata.Version != InterchangeVersion {
+		return fmt.Errorf("%w: %s", ErrUnsupportedVersion, interchange.Metadata.Version)
+	}
+
+	for _, history := range interchange.Data {
+		for _, record := range history.Signatures {
+			if !isKnownKind(record.Kind) {
+				return fmt.Errorf("%w: %s", ErrUnknownKind, record.Kind)
+			}
+		}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if genesisHash := interchange.Metadata.GenesisHash; genesisHash != types.ZeroHash {
+		if err := s.setGenesisHash(genesisHash); err != nil {
+			return err
+		}
+	}
+
+	for _, history := range interchange.Data {
+		if err := s.importHistory(history); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Store) importHistory(history *SignerHistory) error {
+	if err := s.prune(history.Address, history.Watermark); err != nil {
+		return err
+	}
+
+	watermark, err := s.getWatermark(history.Address)
+	if err != nil {
+		return err
+	}
+
+	// the signing roots to write, the file may have several records with the same key
+	roots := make(map[string]types.Hash)
+
+	for _, record := range history.Signatures {
+		if record.Height < watermark {
+			continue
+		}
+
+		key := string(signatureKey(history.Address, record.Height, record.Round, record.Kind))
+
+		existing, ok := roots[key]
+		if !ok {
+			raw, err := s.db.Get([]byte(key), nil)
+			if errors.Is(err, leveldb.ErrNotFound) {
+				roots[key] = record.SigningRoot
+
+				continue
+			} else if err != nil {
+				return err
+			}
+
+			existing = types.BytesToHash(raw)
+		}
+
+		if existing != record.SigningRoot {
+			roots[key] = types.ZeroHash
+		}
+	}
+
+	batch := new(leveldb.Batch)
+
+	for key, root := range roots {
+		batch.Put([]byte(key), root.Bytes())
+	}
+
+	return s.db.Write(batch, nil)
+}
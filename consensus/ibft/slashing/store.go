@@ -0,0 +1,306 @@
+package slashing
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// Kind is the kind of the signature made by a validator
+type Kind string
+
+const (
+	ProposerSeal  Kind = "proposer_seal"
+	CommittedSeal Kind = "committed_seal"
+	PrePrepare    Kind = "preprepare"
+	Prepare       Kind = "prepare"
+	Commit        Kind = "commit"
+	RoundChange   Kind = "round_change"
+)
+
+// kinds is the list of the known signature kinds
+var kinds = []Kind{
+	ProposerSeal,
+	CommittedSeal,
+	PrePrepare,
+	Prepare,
+	Commit,
+	RoundChange,
+}
+
+const (
+	// DirName is the directory of the database in the consensus directory
+	DirName = "slashing-protection"
+
+	// Retention is the number of heights the signatures are kept for,
+	// the signatures below are pruned and refused by the watermark
+	Retention = 1024
+
+	// pruneInterval is the number of heights between the prunings
+	pruneInterval = 128
+)
+
+var (
+	// key prefixes of the signature records and the signer watermarks
+	signaturePrefix = []byte("s")
+	watermarkPrefix = []byte("w")
+
+	// genesisKey holds the hash of the genesis block of the chain the signatures were made for
+	genesisKey = []byte("genesis")
+)
+
+var (
+	ErrSlashableSignature = errors.New("refusing to sign, conflicting signature was already made")
+	ErrBelowWatermark     = errors.New("refusing to sign, height is below the slashing protection watermark")
+	ErrGenesisMismatch    = errors.New("slashing protection history belongs to another chain")
+	ErrUnknownKind        = errors.New("unknown signature kind")
+)
+
+// syncWrite makes the writes durable before returning. A record lost on a power failure
+// after the signature is made would let the validator sign a conflicting message on restart
+var syncWrite = &opt.WriteOptions{Sync: true}
+
+// Store is the local slashing protection database.
+// It records the signatures made by validators and refuses to sign
+// a different message of the same kind at the same height and round
+type Store struct {
+	db *leveldb.DB
+
+	lock sync.Mutex
+
+	// the last pruned height by signer
+	pruned map[types.Address]uint64
+}
+
+// NewStore opens the slashing protection database at the given path
+func NewStore(path string) (*Store, error) {
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open slashing protection database, %w", err)
+	}
+
+	return newStore(db), nil
+}
+
+// NewMemoryStore creates an in-memory slashing protection database
+func NewMemoryStore() (*Store, error) {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return newStore(db), nil
+}
+
+func newStore(db *leveldb.DB) *Store {
+	return &Store{
+		db:     db,
+		pruned: make(map[types.Address]uint64),
+	}
+}
+
+// Close closes the database
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// SetGenesisHash binds the database to the chain with the given genesis hash.
+// It fails if the database has the history of another chain
+func (s *Store) SetGenesisHash(hash types.Hash) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.setGenesisHash(hash)
+}
+
+// GenesisHash returns the genesis hash of the chain the database belongs to, if set
+func (s *Store) GenesisHash() (types.Hash, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.getGenesisHash()
+}
+
+// CheckAndRecord checks that the signer didn't sign a different message of the same kind
+// at the given height and round, and records the signing root before the signature is made.
+// Signing the same signing root again is allowed
+func (s *Store) CheckAndRecord(
+	signer types.Address,
+	kind Kind,
+	height, round uint64,
+	signingRoot types.Hash,
+) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	watermark, err := s.getWatermark(signer)
+	if err != nil {
+		return err
+	}
+
+	if height < watermark {
+		return fmt.Errorf("%w: height %d, watermark %d", ErrBelowWatermark, height, watermark)
+	}
+
+	key := signatureKey(signer, height, round, kind)
+
+	existing, err := s.db.Get(key, nil)
+
+	switch {
+	case errors.Is(err, leveldb.ErrNotFound):
+		if err := s.db.Put(key, signingRoot.Bytes(), syncWrite); err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	case types.BytesToHash(existing) != signingRoot || signingRoot == types.ZeroHash:
+		return fmt.Errorf(
+			"%w: %s at height %d, round %d",
+			ErrSlashableSignature, kind, height, round,
+		)
+	}
+
+	return s.pruneIfNeeded(signer, height)
+}
+
+// pruneIfNeeded removes the signatures older than the retention, once per prune interval
+func (s *Store) pruneIfNeeded(signer types.Address, height uint64) error {
+	if height < Retention || height < s.pruned[signer]+pruneInterval {
+		return nil
+	}
+
+	if err := s.prune(signer, height-Retention); err != nil {
+		return err
+	}
+
+	s.pruned[signer] = height
+
+	return nil
+}
+
+// prune removes the signatures below the given height and raises the watermark to it
+func (s *Store) prune(signer types.Address, height uint64) error {
+	watermark, err := s.getWatermark(signer)
+	if err != nil {
+		return err
+	}
+
+	if height <= watermark {
+		return nil
+	}
+
+	batch := new(leveldb.Batch)
+
+	iter := s.db.NewIterator(&util.Range{
+		Start: signatureKey(signer, 0, 0, ""),
+		Limit: signatureKey(signer, height, 0, ""),
+	}, nil)
+
+	for iter.Next() {
+		batch.Delete(append([]byte{}, iter.Key()...))
+	}
+
+	iter.Release()
+
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	batch.Put(watermarkKey(signer), encodeUint64(height))
+
+	return s.db.Write(batch, syncWrite)
+}
+
+func (s *Store) getWatermark(signer types.Address) (uint64, error) {
+	raw, err := s.db.Get(watermarkKey(signer), nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+
+	return decodeUint64(raw), nil
+}
+
+func (s *Store) getGenesisHash() (types.Hash, error) {
+	raw, err := s.db.Get(genesisKey, nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return types.ZeroHash, nil
+	} else if err != nil {
+		return types.ZeroHash, err
+	}
+
+	return types.BytesToHash(raw), nil
+}
+
+func (s *Store) setGenesisHash(hash types.Hash) error {
+	current, err := s.getGenesisHash()
+	if err != nil {
+		return err
+	}
+
+	if current == hash {
+		return nil
+	}
+
+	if current != types.ZeroHash {
+		return fmt.Errorf("%w: expected genesis %s, got %s", ErrGenesisMismatch, current, hash)
+	}
+
+	return s.db.Put(genesisKey, hash.Bytes(), syncWrite)
+}
+
+// signatureKey returns the key of the signature record, the records are ordered by signer and height
+func signatureKey(signer types.Address, height, round uint64, kind Kind) []byte {
+	key := make([]byte, 0, len(signaturePrefix)+types.AddressLength+16+len(kind))
+
+	key = append(key, signaturePrefix...)
+	key = append(key, signer.Bytes()...)
+	key = append(key, encodeUint64(height)...)
+	key = append(key, encodeUint64(round)...)
+	key = append(key, kind...)
+
+	return key
+}
+
+// parseSignatureKey is the reverse of signatureKey
+func parseSignatureKey(key []byte) (types.Address, uint64, uint64, Kind) {
+	key = key[len(signaturePrefix):]
+
+	return types.BytesToAddress(key[:types.AddressLength]),
+		decodeUint64(key[types.AddressLength:]),
+		decodeUint64(key[types.AddressLength+8:]),
+		Kind(key[types.AddressLength+16:])
+}
+
+func watermarkKey(signer types.Address) []byte {
+	return append(append([]byte{}, watermarkPrefix...), signer.Bytes()...)
+}
+
+func encodeUint64(n uint64) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, n)
+
+	return buf
+}
+
+func decodeUint64(buf []byte) uint64 {
+	return binary.BigEndian.Uint64(buf)
+}
+
+func isKnownKind(kind Kind) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,273 @@
+package slashing
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testSigner1 = types.StringToAddress("1")
+	testSigner2 = types.StringToAddress("2")
+
+	testRoot1 = types.StringToHash("1")
+	testRoot2 = types.StringToHash("2")
+
+	testGenesis = types.StringToHash("a")
+)
+
+func newTestStore(t *testing.T) *Store {
+	t.Helper()
+
+	store, err := NewMemoryStore()
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = store.Close()
+	})
+
+	return store
+}
+
+func TestStoreCheckAndRecord(t *testing.T) {
+	t.Parallel()
+
+	type signature struct {
+		signer types.Address
+		kind   Kind
+		height uint64
+		round  uint64
+		root   types.Hash
+	}
+
+	tests := []struct {
+		name        string
+		previous    []signature
+		signature   signature
+		expectedErr error
+	}{
+		{
+			name:      "should allow the first signature",
+			signature: signature{testSigner1, CommittedSeal, 1, 0, testRoot1},
+		},
+		{
+			name: "should allow signing the same root again",
+			previous: []signature{
+				{testSigner1, CommittedSeal, 1, 0, testRoot1},
+			},
+			signature: signature{testSigner1, CommittedSeal, 1, 0, testRoot1},
+		},
+		{
+			name: "should refuse another root at the same height and round",
+			previous: []signature{
+				{testSigner1, CommittedSeal, 1, 0, testRoot1},
+			},
+			signature:   signature{testSigner1, CommittedSeal, 1, 0, testRoot2},
+			expectedErr: ErrSlashableSignature,
+		},
+		{
+			name: "should allow another root at the next round",
+			previous: []signature{
+				{testSigner1, CommittedSeal, 1, 0, testRoot1},
+			},
+			signature: signature{testSigner1, CommittedSeal, 1, 1, testRoot2},
+		},
+		{
+			name: "should allow another kind at the same height and round",
+			previous: []signature{
+				{testSigner1, Prepare, 1, 0, testRoot1},
+			},
+			signature: signature{testSigner1, Commit, 1, 0, testRoot2},
+		},
+		{
+			name: "should allow another signer at the same height and round",
+			previous: []signature{
+				{testSigner1, ProposerSeal, 1, 0, testRoot1},
+			},
+			signature: signature{testSigner2, ProposerSeal, 1, 0, testRoot2},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := newTestStore(t)
+
+			for _, sig := range test.previous {
+				require.NoError(t, store.CheckAndRecord(sig.signer, sig.kind, sig.height, sig.round, sig.root))
+			}
+
+			sig := test.signature
+
+			assert.ErrorIs(
+				t,
+				store.CheckAndRecord(sig.signer, sig.kind, sig.height, sig.round, sig.root),
+				test.expectedErr,
+			)
+		})
+	}
+}
+
+func TestStorePruning(t *testing.T) {
+	t.Parallel()
+
+	store := newTestStore(t)
+
+	for height := uint64(1); height <= Retention+pruneInterval; height++ {
+		require.NoError(t, store.CheckAndRecord(testSigner1, CommittedSeal, height, 0, testRoot1))
+	}
+
+	interchange, err := store.Export()
+	require.NoError(t, err)
+
+	require.Len(t, interchange.Data, 1)
+	assert.Equal(t, uint64(pruneInterval), interchange.Data[0].Watermark)
+	assert.Len(t, interchange.Data[0].Signatures, Retention+1)
+	assert.Equal(t, uint64(pruneInterval), interchange.Data[0].Signatures[0].Height)
+
+	// the pruned signatures are refused
+	assert.ErrorIs(
+		t,
+		store.CheckAndRecord(testSigner1, CommittedSeal, 1, 0, testRoot1),
+		ErrBelowWatermark,
+	)
+
+	// other signers are not affected
+	assert.NoError(t, store.CheckAndRecord(testSigner2, CommittedSeal, 1, 0, testRoot1))
+}
+
+func TestStoreGenesisHash(t *testing.T) {
+	t.Parallel()
+
+	store := newTestStore(t)
+
+	require.NoError(t, store.SetGenesisHash(testGenesis))
+	require.NoError(t, store.SetGenesisHash(testGenesis))
+
+	assert.ErrorIs(t, store.SetGenesisHash(testRoot1), ErrGenesisMismatch)
+
+	genesis, err := store.GenesisHash()
+	require.NoError(t, err)
+	assert.Equal(t, testGenesis, genesis)
+}
+
+func TestStoreExportImport(t *testing.T) {
+	t.Parallel()
+
+	source := newTestStore(t)
+
+	require.NoError(t, source.SetGenesisHash(testGenesis))
+	require.NoError(t, source.CheckAndRecord(testSigner1, PrePrepare, 5, 0, testRoot1))
+	require.NoError(t, source.CheckAndRecord(testSigner1, CommittedSeal, 5, 1, testRoot2))
+	require.NoError(t, source.CheckAndRecord(testSigner2, RoundChange, 3, 2, testRoot1))
+
+	exported, err := source.Export()
+	require.NoError(t, err)
+
+	// the history survives the JSON encoding
+	raw, err := json.Marshal(exported)
+	require.NoError(t, err)
+
+	interchange := &Interchange{}
+	require.NoError(t, json.Unmarshal(raw, interchange))
+	assert.Equal(t, exported, interchange)
+
+	target := newTestStore(t)
+	require.NoError(t, target.Import(interchange))
+
+	imported, err := target.Export()
+	require.NoError(t, err)
+	assert.Equal(t, exported, imported)
+
+	// the imported signatures protect the target
+	assert.ErrorIs(
+		t,
+		target.CheckAndRecord(testSigner1, CommittedSeal, 5, 1, testRoot1),
+		ErrSlashableSignature,
+	)
+	assert.NoError(t, target.CheckAndRecord(testSigner1, CommittedSeal, 5, 1, testRoot2))
+}
+
+func TestStoreImport(t *testing.T) {
+	t.Parallel()
+
+	newInterchange := func(genesis types.Hash, watermark uint64, records ...*SignatureRecord) *Interchange {
+		return &Interchange{
+			Metadata: InterchangeMetadata{
+				Version:     InterchangeVersion,
+				GenesisHash: genesis,
+			},
+			Data: []*SignerHistory{
+				{
+					Address:    testSigner1,
+					Watermark:  watermark,
+					Signatures: records,
+				},
+			},
+		}
+	}
+
+	t.Run("should refuse any signature on conflicting records", func(t *testing.T) {
+		t.Parallel()
+
+		store := newTestStore(t)
+		require.NoError(t, store.CheckAndRecord(testSigner1, Commit, 7, 0, testRoot1))
+
+		require.NoError(t, store.Import(newInterchange(types.ZeroHash, 0, &SignatureRecord{
+			Kind:        Commit,
+			Height:      7,
+			Round:       0,
+			SigningRoot: testRoot2,
+		})))
+
+		assert.ErrorIs(t, store.CheckAndRecord(testSigner1, Commit, 7, 0, testRoot1), ErrSlashableSignature)
+		assert.ErrorIs(t, store.CheckAndRecord(testSigner1, Commit, 7, 0, testRoot2), ErrSlashableSignature)
+	})
+
+	t.Run("should apply the watermark", func(t *testing.T) {
+		t.Parallel()
+
+		store := newTestStore(t)
+		require.NoError(t, store.CheckAndRecord(testSigner1, Commit, 7, 0, testRoot1))
+
+		require.NoError(t, store.Import(newInterchange(types.ZeroHash, 10)))
+
+		assert.ErrorIs(t, store.CheckAndRecord(testSigner1, Commit, 9, 0, testRoot1), ErrBelowWatermark)
+		assert.NoError(t, store.CheckAndRecord(testSigner1, Commit, 10, 0, testRoot1))
+	})
+
+	t.Run("should refuse the history of another chain", func(t *testing.T) {
+		t.Parallel()
+
+		store := newTestStore(t)
+		require.NoError(t, store.SetGenesisHash(testGenesis))
+
+		assert.ErrorIs(t, store.Import(newInterchange(testRoot1, 0)), ErrGenesisMismatch)
+	})
+
+	t.Run("should refuse unsupported version", func(t *testing.T) {
+		t.Parallel()
+
+		interchange := newInterchange(types.ZeroHash, 0)
+		interchange.Metadata.Version = "2"
+
+		assert.ErrorIs(t, newTestStore(t).Import(interchange), ErrUnsupportedVersion)
+	})
+
+	t.Run("should refuse unknown kind", func(t *testing.T) {
+		t.Parallel()
+
+		interchange := newInterchange(types.ZeroHash, 0, &SignatureRecord{
+			Kind:        "attestation",
+			SigningRoot: testRoot1,
+		})
+
+		assert.ErrorIs(t, newTestStore(t).Import(interchange), ErrUnknownKind)
+	})
+}
@@ -1,9 +1,12 @@
 package ibft
 
 import (
+	"math/big"
 	"strconv"
 	"testing"
 
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/validators"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -65,3 +68,271 @@ func TestNumValid(t *testing.T) {
 		)
 	}
 }
+
+func newTestVotingPowers(stakes ...int64) (validators.Validators, validators.VotingPowers) {
+	set := validators.NewECDSAValidatorSet()
+	powers := make(validators.VotingPowers, len(stakes))
+
+	for idx, stake := range stakes {
+		addr := types.StringToAddress(strconv.Itoa(idx + 1))
+
+		_ = set.Add(validators.NewECDSAValidator(addr))
+		powers[addr] = big.NewInt(stake)
+	}
+
+	return set, powers
+}
+
+func TestCalcWeightedMaxFaultyPower(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		stakes []int64
+		faulty int64
+	}{
+		{"single validator", []int64{10}, 3},
+		{"same stakes", []int64{1, 1, 1, 1}, 1},
+		{"same stakes of 7 validators", []int64{1, 1, 1, 1, 1, 1, 1}, 2},
+		{"skewed stakes", []int64{1, 1, 1, 1000000}, 333334},
+		{"no stake", []int64{0, 0, 0, 0}, 0},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			set, powers := newTestVotingPowers(test.stakes...)
+
+			assert.Equal(t, big.NewInt(test.faulty), CalcWeightedMaxFaultyPower(set, powers))
+		})
+	}
+}
+
+func TestWeightedQuorumPower(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should be the total power without the faulty power", func(t *testing.T) {
+		t.Parallel()
+
+		for total := int64(1); total <= 30; total++ {
+			set, powers := newTestVotingPowers(total)
+
+			expected := new(big.Int).Sub(big.NewInt(total), CalcWeightedMaxFaultyPower(set, powers))
+
+			assert.Equal(t, expected, WeightedQuorumPower(set, powers), "total %d", total)
+		}
+	})
+
+	tests := []struct {
+		name   string
+		stakes []int64
+		quorum int64
+	}{
+		{"same stakes", []int64{1, 1, 1, 1}, 3},
+		{"skewed stakes", []int64{1, 1, 1, 1000000}, 666669},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			set, powers := newTestVotingPowers(test.stakes...)
+
+			assert.Equal(t, big.NewInt(test.quorum), WeightedQuorumPower(set, powers))
+		})
+	}
+}
+
+func TestHasWeightedQuorum(t *testing.T) {
+	t.Parallel()
+
+	signers := func(names ...string) []types.Address {
+		addrs := make([]types.Address, len(names))
+		for idx, name := range names {
+			addrs[idx] = types.StringToAddress(name)
+		}
+
+		return addrs
+	}
+
+	tests := []struct {
+		name      string
+		stakes    []int64
+		signers   []types.Address
+		hasQuorum bool
+	}{
+		{"all the validators", []int64{1, 1, 1, 1000000}, signers("1", "2", "3", "4"), true},
+		{"the dominant validator alone", []int64{1, 1, 1, 1000000}, signers("4"), true},
+		{"all the small validators", []int64{1, 1, 1, 1000000}, signers("1", "2", "3"), false},
+		{"repeated signers are counted once", []int64{1, 1, 1, 1000000}, signers("1", "1", "2", "2", "3", "3"), false},
+		{"non-validators are ignored", []int64{1, 1, 1, 1000000}, signers("1", "2", "3", "5", "6"), false},
+		{"2/3 of the same stakes", []int64{1, 1, 1, 1}, signers("1", "2", "3"), true},
+		{"less than 2/3 of the same stakes", []int64{1, 1, 1, 1}, signers("1", "2", "2"), false},
+		{"enough signers without stake", []int64{0, 0, 0, 0}, signers("1", "2", "3"), true},
+		{"not enough signers without stake", []int64{0, 0, 0, 0}, signers("1", "2"), false},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			set, powers := newTestVotingPowers(test.stakes...)
+
+			assert.Equal(t, test.hasQuorum, HasWeightedQuorum(set, powers, test.signers))
+		})
+	}
+}
+
+func TestMinWeightedQuorumSize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		stakes []int64
+		size   int
+	}{
+		{"the dominant validator", []int64{1, 1, 1, 1000000}, 1},
+		{"same stakes", []int64{1, 1, 1, 1}, 3},
+		{"the largest stakes", []int64{1, 10, 10, 10}, 3},
+		{"no stake", []int64{0, 0, 0, 0}, 3},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			set, powers := newTestVotingPowers(test.stakes...)
+
+			assert.Equal(t, test.size, minWeightedQuorumSize(set, powers))
+		})
+	}
+}
+
+func TestCalcWeightedMaxFaultyNodes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		stakes []int64
+		faulty int
+	}{
+		{"same stakes", []int64{1, 1, 1, 1}, 1},
+		{"the dominant validator", []int64{1, 1, 1, 1000000}, 0},
+		{"the largest stakes", []int64{1, 10, 10, 10}, 1},
+		{"no stake", []int64{0, 0, 0, 0}, 1},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			set, powers := newTestVotingPowers(test.stakes...)
+
+			assert.Equal(t, test.faulty, CalcWeightedMaxFaultyNodes(set, powers))
+		})
+	}
+}
+
+func TestWeightedQuorumSize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		stakes []int64
+		size   int
+	}{
+		{"same stakes", []int64{1, 1, 1, 1}, 3},
+		{"same stakes of 7 validators", []int64{1, 1, 1, 1, 1, 1, 1}, 5},
+		{"the dominant validator", []int64{1, 1, 1, 1000000}, 4},
+		{"the largest stakes", []int64{1, 10, 10, 10}, 3},
+		{"no stake", []int64{0, 0, 0, 0}, 3},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			set, powers := newTestVotingPowers(test.stakes...)
+
+			assert.Equal(t, test.size, WeightedQuorumSize(set, powers))
+		})
+	}
+}
+
+func TestCalcWeightedProposer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should pick validators in proportion to their stakes", func(t *testing.T) {
+		t.Parallel()
+
+		set, powers := newTestVotingPowers(1, 2, 7)
+
+		picks := make(map[types.Address]int)
+
+		for height := uint64(0); height < proposerWeightScale; height++ {
+			picks[CalcWeightedProposer(set, powers, height, 0).Addr()]++
+		}
+
+		assert.Equal(t, 100, picks[set.At(0).Addr()])
+		assert.Equal(t, 200, picks[set.At(1).Addr()])
+		assert.Equal(t, 700, picks[set.At(2).Addr()])
+	})
+
+	t.Run("should be deterministic", func(t *testing.T) {
+		t.Parallel()
+
+		set, powers := newTestVotingPowers(3, 5, 1, 1)
+
+		for height := uint64(1); height < 20; height++ {
+			assert.Equal(
+				t,
+				CalcWeightedProposer(set, powers, height, 1),
+				CalcWeightedProposer(set, powers, height, 1),
+			)
+		}
+
+		// the next round has the proposer of the next height
+		assert.Equal(
+			t,
+			CalcWeightedProposer(set, powers, 11, 0),
+			CalcWeightedProposer(set, powers, 10, 1),
+		)
+	})
+
+	t.Run("should give a turn to the validators with the smallest stakes", func(t *testing.T) {
+		t.Parallel()
+
+		set, powers := newTestVotingPowers(1, 1_000_000)
+
+		picked := false
+
+		for height := uint64(0); height < proposerWeightScale+1; height++ {
+			if CalcWeightedProposer(set, powers, height, 0).Addr() == set.At(0).Addr() {
+				picked = true
+			}
+		}
+
+		assert.True(t, picked)
+	})
+
+	t.Run("should fall back to round-robin without stake", func(t *testing.T) {
+		t.Parallel()
+
+		set, powers := newTestVotingPowers(0, 0, 0)
+
+		assert.Equal(t, set.At(2), CalcWeightedProposer(set, powers, 10, 2))
+	})
+}
@@ -1,12 +1,25 @@
 package ibft
 
 import (
+	"errors"
+
 	"github.com/0xPolygon/go-ibft/messages/proto"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/libp2p/go-libp2p/core/peer"
 )
 
+const (
+	// TransportGossip relays the messages through the gossip of all the nodes
+	TransportGossip = "gossip"
+	// TransportDirect sends the messages to the validators over the dedicated streams,
+	// the gossip is used for the validators which can't be reached directly
+	TransportDirect = "direct"
+)
+
+// ErrInvalidTransport is returned when the transport mode is unknown
+var ErrInvalidTransport = errors.New("invalid IBFT transport, expected gossip or direct")
+
 type transport interface {
 	Multicast(msg *proto.Message) error
 }
@@ -20,12 +33,15 @@ func (g *gossipTransport) Multicast(msg *proto.Message) error {
 }
 
 func (i *backendIBFT) Multicast(msg *proto.Message) {
+	// record the message before sending so that it can be sent again after a crash
+	i.appendToWAL(msg, true)
+
 	if err := i.transport.Multicast(msg); err != nil {
 		i.logger.Error("fail to gossip", "err", err)
 	}
 }
 
-// setupTransport sets up the gossip transport protocol
+// setupTransport sets up the transport protocol of the configured mode
 func (i *backendIBFT) setupTransport() error {
 	// Define a new topic
 	topic, err := i.network.NewTopic(ibftProto, &proto.Message{})
@@ -36,10 +52,6 @@ func (i *backendIBFT) setupTransport() error {
 	// Subscribe to the newly created topic
 	if err := topic.Subscribe(
 		func(obj interface{}, _ peer.ID) {
-			if !i.isActiveValidator() {
-				return
-			}
-
 			msg, ok := obj.(*proto.Message)
 			if !ok {
 				i.logger.Error("invalid type assertion for message request")
@@ -47,21 +59,59 @@ func (i *backendIBFT) setupTransport() error {
 				return
 			}
 
-			i.consensus.AddMessage(msg)
-
-			i.logger.Debug(
-				"validator message received",
-				"type", msg.Type.String(),
-				"height", msg.GetView().Height,
-				"round", msg.GetView().Round,
-				"addr", types.BytesToAddress(msg.From).String(),
-			)
+			i.handleMessage(msg)
 		},
 	); err != nil {
 		return err
 	}
 
-	i.transport = &gossipTransport{topic: topic}
+	gossip := &gossipTransport{topic: topic}
+
+	if i.transportMode != TransportDirect {
+		i.transport = gossip
+
+		return nil
+	}
+
+	// the validators announce the peer IDs to be reached directly
+	if err := i.setupValidatorPeers(); err != nil {
+		return err
+	}
+
+	direct := newDirectTransport(
+		i.logger.Named("transport"),
+		i.network,
+		gossip,
+		i.validatorPeers,
+		i.forkManager.GetValidators,
+		i.handleMessage,
+	)
+
+	direct.start()
+
+	i.transport = direct
 
 	return nil
 }
+
+// handleMessage passes the message received from a validator to the consensus
+func (i *backendIBFT) handleMessage(msg *proto.Message) {
+	if !i.isActiveValidator() {
+		return
+	}
+
+	if msg.View != nil && isWALHeight(i.blockchain.Header().Number, msg.View.Height) && i.IsValidSender(msg) {
+		i.appendToWAL(msg, false)
+		i.observeMessageHeight(msg.View.Height)
+	}
+
+	i.consensus.AddMessage(msg)
+
+	i.logger.Debug(
+		"validator message received",
+		"type", msg.Type.String(),
+		"height", msg.GetView().Height,
+		"round", msg.GetView().Round,
+		"addr", types.BytesToAddress(msg.From).String(),
+	)
+}
@@ -0,0 +1,180 @@
+package ibft
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/signer"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+const (
+	ibftValidatorPeerProto = "/ibft/validator-peer/0.1"
+
+	// validatorPeerAnnounceInterval is the interval the validator announces the peer ID of its node
+	validatorPeerAnnounceInterval = 30 * time.Second
+)
+
+var (
+	errValidatorPeerSignerMismatch = errors.New("validator peer is signed by another account")
+	errValidatorPeerNotValidator   = errors.New("validator peer is announced by non-validator")
+	errValidatorPeerInvalidPeerID  = errors.New("invalid peer ID in validator peer")
+)
+
+// validatorPeer is the peer ID of the node a validator runs on
+type validatorPeer struct {
+	peerID    peer.ID
+	timestamp uint64
+}
+
+// validatorPeers keeps the peer IDs the validators announced
+type validatorPeers struct {
+	sync.RWMutex
+
+	peers map[types.Address]validatorPeer
+}
+
+func newValidatorPeers() *validatorPeers {
+	return &validatorPeers{
+		peers: make(map[types.Address]validatorPeer),
+	}
+}
+
+// get returns the peer ID of the validator, false if the validator has not announced it
+func (v *validatorPeers) get(addr types.Address) (peer.ID, bool) {
+	v.RLock()
+	defer v.RUnlock()
+
+	p, ok := v.peers[addr]
+
+	return p.peerID, ok
+}
+
+// set stores the peer ID of the validator unless a newer one is already known,
+// returns whether the peer ID has been stored
+func (v *validatorPeers) set(addr types.Address, peerID peer.ID, timestamp uint64) bool {
+	v.Lock()
+	defer v.Unlock()
+
+	if p, ok := v.peers[addr]; ok && p.timestamp >= timestamp {
+		return false
+	}
+
+	v.peers[addr] = validatorPeer{
+		peerID:    peerID,
+		timestamp: timestamp,
+	}
+
+	return true
+}
+
+// setupValidatorPeers sets up the topic the validators announce the peer IDs of their nodes in
+func (i *backendIBFT) setupValidatorPeers() error {
+	topic, err := i.network.NewTopic(ibftValidatorPeerProto, &proto.ValidatorPeer{})
+	if err != nil {
+		return err
+	}
+
+	if err := topic.Subscribe(func(obj interface{}, _ peer.ID) {
+		msg, ok := obj.(*proto.ValidatorPeer)
+		if !ok {
+			i.logger.Error("invalid type assertion for validator peer")
+
+			return
+		}
+
+		if err := i.handleValidatorPeer(msg); err != nil {
+			i.logger.Debug("rejected validator peer", "err", err)
+		}
+	}); err != nil {
+		return err
+	}
+
+	i.validatorPeers = newValidatorPeers()
+	i.validatorPeersTopic = topic
+
+	return nil
+}
+
+// handleValidatorPeer verifies the announced peer ID and stores it
+func (i *backendIBFT) handleValidatorPeer(msg *proto.ValidatorPeer) error {
+	addr := types.BytesToAddress(msg.Address)
+
+	peerID, err := peer.Decode(msg.PeerID)
+	if err != nil {
+		return errValidatorPeerInvalidPeerID
+	}
+
+	signer, err := i.forkManager.GetSigner(i.blockchain.Header().Number + 1)
+	if err != nil {
+		return err
+	}
+
+	recovered, err := signer.EcrecoverFromValidatorPeer(msg.Signature, addr, msg.PeerID, msg.Timestamp)
+	if err != nil {
+		return err
+	}
+
+	if recovered != addr {
+		return errValidatorPeerSignerMismatch
+	}
+
+	vals, err := i.forkManager.GetValidators(i.blockchain.Header().Number + 1)
+	if err != nil {
+		return err
+	}
+
+	if !vals.Includes(addr) {
+		return errValidatorPeerNotValidator
+	}
+
+	if i.validatorPeers.set(addr, peerID, msg.Timestamp) {
+		i.logger.Debug("validator peer updated", "addr", addr, "peer", peerID)
+	}
+
+	return nil
+}
+
+// announceValidatorPeer publishes the peer ID of this node signed by the validator key
+func (i *backendIBFT) announceValidatorPeer(currentSigner signer.Signer) error {
+	peerID := i.network.AddrInfo().ID.String()
+	timestamp := uint64(time.Now().Unix())
+
+	signature, err := currentSigner.SignValidatorPeer(peerID, timestamp)
+	if err != nil {
+		return err
+	}
+
+	return i.validatorPeersTopic.Publish(&proto.ValidatorPeer{
+		Address:   currentSigner.Address().Bytes(),
+		PeerID:    peerID,
+		Timestamp: timestamp,
+		Signature: signature,
+	})
+}
+
+// runValidatorPeerAnnouncer announces the peer ID of this node periodically while it's a validator
+func (i *backendIBFT) runValidatorPeerAnnouncer() {
+	ticker := time.NewTicker(validatorPeerAnnounceInterval)
+	defer ticker.Stop()
+
+	for {
+		// the modules are updated by the consensus routine concurrently
+		currentSigner, currentValidators := i.getCurrentModules()
+
+		if currentSigner != nil && currentValidators != nil && currentValidators.Includes(currentSigner.Address()) {
+			if err := i.announceValidatorPeer(currentSigner); err != nil {
+				i.logger.Error("failed to announce validator peer", "err", err)
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-i.closeCh:
+			return
+		}
+	}
+}
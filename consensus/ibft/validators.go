@@ -2,6 +2,8 @@ package ibft
 
 import (
 	"math"
+	"math/big"
+	"sort"
 
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/0xPolygon/polygon-edge/validators"
@@ -71,3 +73,213 @@ func CalcProposer(
 
 	return validators.At(pick)
 }
+
+// proposerWeightScale is the total weight the voting powers are normalized to in the proposer selection
+const proposerWeightScale = 1000
+
+// sortedVotingPowers returns the voting powers of the validators in ascending order and their sum
+func sortedVotingPowers(set validators.Validators, powers validators.VotingPowers) ([]*big.Int, *big.Int) {
+	sorted := make([]*big.Int, set.Len())
+	for idx := range sorted {
+		sorted[idx] = powers.Get(set.At(uint64(idx)).Addr())
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Cmp(sorted[j]) < 0
+	})
+
+	return sorted, powers.Total(set)
+}
+
+// CalcWeightedMaxFaultyPower returns the voting power of the faulty validators tolerated by the validator set.
+// The validators tolerate the faulty voting power F where the total voting power N = 3F + 1,
+// it takes the floor of F = (N - 1) / 3
+func CalcWeightedMaxFaultyPower(set validators.Validators, powers validators.VotingPowers) *big.Int {
+	total := powers.Total(set)
+	if total.Sign() == 0 {
+		return big.NewInt(0)
+	}
+
+	faulty := new(big.Int).Sub(total, big.NewInt(1))
+
+	return faulty.Div(faulty, big.NewInt(3))
+}
+
+// WeightedQuorumPower returns the voting power the signers need for a quorum,
+// more than 2/3 of the total voting power. It's equal to N - F
+func WeightedQuorumPower(set validators.Validators, powers validators.VotingPowers) *big.Int {
+	quorum := new(big.Int).Mul(powers.Total(set), big.NewInt(2))
+	quorum.Div(quorum, big.NewInt(3))
+
+	return quorum.Add(quorum, big.NewInt(1))
+}
+
+// CalcWeightedMaxFaultyNodes returns the number of faulty validators tolerated by the stake weighted set,
+// the largest number of validators such that any of them hold at most the faulty voting power
+func CalcWeightedMaxFaultyNodes(set validators.Validators, powers validators.VotingPowers) int {
+	sorted, total := sortedVotingPowers(set, powers)
+	if total.Sign() == 0 {
+		return CalcMaxFaultyNodes(set)
+	}
+
+	var (
+		faulty = CalcWeightedMaxFaultyPower(set, powers)
+		sum    = big.NewInt(0)
+	)
+
+	// the largest voting powers first
+	for idx := len(sorted) - 1; idx >= 0; idx-- {
+		sum.Add(sum, sorted[idx])
+
+		if sum.Cmp(faulty) > 0 {
+			return len(sorted) - 1 - idx
+		}
+	}
+
+	return len(sorted)
+}
+
+// WeightedQuorumSize returns the number of the messages go-ibft counts for a quorum of the stake weighted set.
+// The messages are counted, so it's the smallest number of validators such that any of them reach the quorum power.
+// It's equal to OptimalQuorumSize when all the validators have the same voting power
+func WeightedQuorumSize(set validators.Validators, powers validators.VotingPowers) int {
+	sorted, total := sortedVotingPowers(set, powers)
+	if total.Sign() == 0 {
+		return OptimalQuorumSize(set)
+	}
+
+	var (
+		quorum = WeightedQuorumPower(set, powers)
+		sum    = big.NewInt(0)
+	)
+
+	// the smallest voting powers first
+	for idx, power := range sorted {
+		sum.Add(sum, power)
+
+		if sum.Cmp(quorum) >= 0 {
+			return idx + 1
+		}
+	}
+
+	return set.Len()
+}
+
+// HasWeightedQuorum returns true if the sum of the voting powers of the signers reaches the quorum.
+// Each signer is counted once and the signers who aren't in the set are ignored.
+// The signers are counted if the validators have no voting power
+func HasWeightedQuorum(set validators.Validators, powers validators.VotingPowers, signers []types.Address) bool {
+	validatorSigners := uniqueValidators(set, signers)
+
+	if powers.Total(set).Sign() == 0 {
+		return len(validatorSigners) >= OptimalQuorumSize(set)
+	}
+
+	sum := big.NewInt(0)
+	for _, addr := range validatorSigners {
+		sum.Add(sum, powers.Get(addr))
+	}
+
+	return sum.Cmp(WeightedQuorumPower(set, powers)) >= 0
+}
+
+// minWeightedQuorumSize returns the smallest number of validators whose voting power reaches the quorum
+func minWeightedQuorumSize(set validators.Validators, powers validators.VotingPowers) int {
+	sorted, total := sortedVotingPowers(set, powers)
+	if total.Sign() == 0 {
+		return OptimalQuorumSize(set)
+	}
+
+	var (
+		quorum = WeightedQuorumPower(set, powers)
+		sum    = big.NewInt(0)
+	)
+
+	// the largest voting powers first
+	for idx := len(sorted) - 1; idx >= 0; idx-- {
+		sum.Add(sum, sorted[idx])
+
+		if sum.Cmp(quorum) >= 0 {
+			return len(sorted) - idx
+		}
+	}
+
+	return set.Len()
+}
+
+// uniqueValidators returns the signers who are in the validator set, each of them once
+func uniqueValidators(set validators.Validators, signers []types.Address) []types.Address {
+	var (
+		visited = make(map[types.Address]bool, len(signers))
+		result  = make([]types.Address, 0, len(signers))
+	)
+
+	for _, addr := range signers {
+		if visited[addr] || !set.Includes(addr) {
+			continue
+		}
+
+		visited[addr] = true
+
+		result = append(result, addr)
+	}
+
+	return result
+}
+
+// CalcWeightedProposer returns the proposer at the given height and round
+// by the smooth weighted round-robin with priority accumulation.
+// Each validator is picked in proportion to its voting power, normalized to proposerWeightScale,
+// and the validators with the same priority are picked in the order of the set
+func CalcWeightedProposer(
+	set validators.Validators,
+	powers validators.VotingPowers,
+	height uint64,
+	round uint64,
+) validators.Validator {
+	total := powers.Total(set)
+	if total.Sign() == 0 {
+		return CalcProposer(set, round, types.ZeroAddress)
+	}
+
+	var (
+		weights     = make([]int64, set.Len())
+		totalWeight = int64(0)
+	)
+
+	for idx := range weights {
+		weight := new(big.Int).Mul(powers.Get(set.At(uint64(idx)).Addr()), big.NewInt(proposerWeightScale))
+		weight.Div(weight, total)
+
+		// every validator takes a turn
+		weights[idx] = weight.Int64()
+		if weights[idx] == 0 {
+			weights[idx] = 1
+		}
+
+		totalWeight += weights[idx]
+	}
+
+	// the sequence repeats every totalWeight steps
+	var (
+		steps      = (height+round)%uint64(totalWeight) + 1
+		priorities = make([]int64, set.Len())
+		pick       = 0
+	)
+
+	for step := uint64(0); step < steps; step++ {
+		pick = 0
+
+		for idx := range priorities {
+			priorities[idx] += weights[idx]
+
+			if priorities[idx] > priorities[pick] {
+				pick = idx
+			}
+		}
+
+		priorities[pick] -= totalWeight
+	}
+
+	return set.At(uint64(pick))
+}
@@ -3,10 +3,12 @@ package ibft
 import (
 	"bytes"
 	"encoding/hex"
+	"fmt"
 
 	"github.com/0xPolygon/go-ibft/messages"
 	protoIBFT "github.com/0xPolygon/go-ibft/messages/proto"
 	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/validators"
 )
 
 // Verifier impl for go-ibft
@@ -111,27 +113,42 @@ func (i *backendIBFT) IsValidSender(msg *protoIBFT.Message) bool {
 }
 
 func (i *backendIBFT) IsProposer(id []byte, height, round uint64) bool {
-	previousHeader, exists := i.blockchain.GetHeaderByNumber(height - 1)
-	if !exists {
-		i.logger.Error("header not found", "height", height-1)
+	nextProposer, err := i.calcProposer(
+		i.currentValidators,
+		i.currentVotingPowers,
+		height,
+		round,
+	)
+	if err != nil {
+		i.logger.Error("failed to calculate the proposer", "height", height, "round", round, "err", err)
 
 		return false
 	}
 
-	previousProposer, err := i.extractProposer(previousHeader)
-	if err != nil {
-		i.logger.Error("failed to extract the last proposer", "height", height-1, "err", err)
+	return types.BytesToAddress(id) == nextProposer.Addr()
+}
 
-		return false
+// calcProposer calculates the proposer of the block at the given height and round
+func (i *backendIBFT) calcProposer(
+	set validators.Validators,
+	powers validators.VotingPowers,
+	height, round uint64,
+) (validators.Validator, error) {
+	if powers != nil {
+		return CalcWeightedProposer(set, powers, height, round), nil
 	}
 
-	nextProposer := CalcProposer(
-		i.currentValidators,
-		round,
-		previousProposer,
-	)
+	previousHeader, exists := i.blockchain.GetHeaderByNumber(height - 1)
+	if !exists {
+		return nil, fmt.Errorf("%w: %d", ErrHeaderNotFound, height-1)
+	}
 
-	return types.BytesToAddress(id) == nextProposer.Addr()
+	previousProposer, err := i.extractProposer(previousHeader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract the last proposer: %w", err)
+	}
+
+	return CalcProposer(set, round, previousProposer), nil
 }
 
 func (i *backendIBFT) IsValidProposalHash(proposal, hash []byte) bool {
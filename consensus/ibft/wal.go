@@ -0,0 +1,119 @@
+package ibft
+
+import (
+	"path/filepath"
+
+	protoIBFT "github.com/0xPolygon/go-ibft/messages/proto"
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/wal"
+)
+
+// setupWAL opens the WAL of IBFT messages in the consensus directory
+func (i *backendIBFT) setupWAL() error {
+	w, err := wal.Open(filepath.Join(i.config.Path, wal.DirName))
+	if err != nil {
+		return err
+	}
+
+	i.wal = w
+
+	return nil
+}
+
+// walHeightWindow is the number of heights above the latest block the received messages are recorded for.
+// The messages of the further heights are not persisted, the WAL only prunes the heights the chain has passed
+const walHeightWindow = 2
+
+// isWALHeight returns true if the received messages of the height are recorded in the WAL,
+// the height is the pending height or right above it
+func isWALHeight(latestHeight, height uint64) bool {
+	return height > latestHeight && height <= latestHeight+walHeightWindow
+}
+
+// appendToWAL records the message sent or received by the node
+func (i *backendIBFT) appendToWAL(msg *protoIBFT.Message, sent bool) {
+	if i.wal == nil || msg == nil {
+		return
+	}
+
+	if err := i.wal.Append(msg, sent); err != nil {
+		i.logger.Error("failed to write message to WAL", "type", msg.Type, "err", err)
+	}
+}
+
+// lockInWAL records the proposal the node is going to send a COMMIT for
+func (i *backendIBFT) lockInWAL(view *protoIBFT.View, proposalHash []byte) {
+	if i.wal == nil {
+		return
+	}
+
+	if err := i.wal.SetLock(view, proposalHash); err != nil {
+		i.logger.Error("failed to write lock to WAL", "height", view.Height, "round", view.Round, "err", err)
+	}
+}
+
+// lockedProposal returns the proposal the node sent a COMMIT for at the given height
+// with its prepared certificate, nil if the node is not locked on any proposal
+func (i *backendIBFT) lockedProposal(height uint64) ([]byte, *protoIBFT.PreparedCertificate) {
+	if i.wal == nil {
+		return nil, nil
+	}
+
+	certificate, proposal, err := i.wal.PreparedCertificate(height)
+	if err != nil {
+		i.logger.Error("failed to read lock from WAL", "height", height, "err", err)
+
+		return nil, nil
+	}
+
+	return proposal, certificate
+}
+
+// restoreFromWAL feeds the messages recorded at the given height into the consensus
+// and sends the messages the node sent before again, so that the sequence resumes
+// from the round state the node had before it stopped
+func (i *backendIBFT) restoreFromWAL(height uint64) {
+	if i.wal == nil {
+		return
+	}
+
+	entries, err := i.wal.Messages(height)
+	if err != nil {
+		i.logger.Error("failed to read messages from WAL", "height", height, "err", err)
+
+		return
+	}
+
+	if len(entries) == 0 {
+		return
+	}
+
+	// the sequence was running before, no need to wait for transactions
+	i.observeMessageHeight(height)
+
+	sent := 0
+
+	for _, entry := range entries {
+		i.consensus.AddMessage(entry.Message)
+
+		if entry.Sent {
+			if err := i.transport.Multicast(entry.Message); err != nil {
+				i.logger.Error("fail to gossip restored message", "err", err)
+			}
+
+			sent++
+		}
+	}
+
+	i.logger.Info("restored messages from WAL", "height", height, "messages", len(entries), "sent", sent)
+}
+
+// pruneWAL removes the messages and the locks at and below the given finalized height
+func (i *backendIBFT) pruneWAL(height uint64) {
+	if i.wal == nil {
+		return
+	}
+
+	if err := i.wal.Prune(height); err != nil {
+		i.logger.Error("failed to prune WAL", "height", height, "err", err)
+	}
+}
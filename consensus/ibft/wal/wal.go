@@ -0,0 +1,300 @@
+package wal
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/0xPolygon/go-ibft/messages"
+	protoIBFT "github.com/0xPolygon/go-ibft/messages/proto"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// DirName is the directory of the WAL in the consensus directory
+	DirName = "wal"
+)
+
+var (
+	// key prefixes of the messages and the locks
+	messagePrefix = []byte("m")
+	lockPrefix    = []byte("l")
+)
+
+var (
+	// syncWrite flushes the writes to the disk before returning
+	// so that the recorded messages and locks survive a crash of the machine
+	syncWrite = &opt.WriteOptions{Sync: true}
+)
+
+var (
+	ErrInvalidMessage = errors.New("invalid IBFT message")
+	ErrInvalidRecord  = errors.New("invalid WAL record")
+)
+
+const (
+	flagReceived byte = iota
+	flagSent
+)
+
+// Entry is a message recorded in the WAL
+type Entry struct {
+	Message *protoIBFT.Message
+	// Sent is true if the message was sent by the node, false if it was received
+	Sent bool
+}
+
+// Lock is the proposal the node sent a COMMIT for at a height
+type Lock struct {
+	Round        uint64
+	ProposalHash []byte
+}
+
+// WAL is the write-ahead log of the IBFT messages sent and received by the node.
+// It's used to restore the round state of the current height after a crash
+type WAL struct {
+	db *leveldb.DB
+
+	lock sync.Mutex
+}
+
+// Open opens the WAL at the given path
+func Open(path string) (*WAL, error) {
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open IBFT WAL, %w", err)
+	}
+
+	return &WAL{db: db}, nil
+}
+
+// NewMemoryWAL creates an in-memory WAL
+func NewMemoryWAL() (*WAL, error) {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WAL{db: db}, nil
+}
+
+// Close closes the WAL
+func (w *WAL) Close() error {
+	return w.db.Close()
+}
+
+// Append records the message sent or received by the node.
+// A message received after it was sent by the node stays recorded as sent
+func (w *WAL) Append(msg *protoIBFT.Message, sent bool) error {
+	if msg == nil || msg.View == nil {
+		return ErrInvalidMessage
+	}
+
+	raw, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	key := messageKey(msg.View.Height, crypto.Keccak256(raw))
+
+	existing, err := w.db.Get(key, nil)
+
+	switch {
+	case errors.Is(err, leveldb.ErrNotFound):
+	case err != nil:
+		return err
+	case existing[0] == flagSent || !sent:
+		return nil
+	}
+
+	flag := flagReceived
+	if sent {
+		flag = flagSent
+	}
+
+	return w.db.Put(key, append([]byte{flag}, raw...), syncWrite)
+}
+
+// Messages returns the messages recorded at the given height
+func (w *WAL) Messages(height uint64) ([]*Entry, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	return w.messages(height)
+}
+
+// SetLock records the proposal the node is going to send a COMMIT for
+func (w *WAL) SetLock(view *protoIBFT.View, proposalHash []byte) error {
+	if view == nil {
+		return ErrInvalidMessage
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	value := append(encodeUint64(view.Round), proposalHash...)
+
+	return w.db.Put(lockKey(view.Height), value, syncWrite)
+}
+
+// GetLock returns the latest proposal the node sent a COMMIT for at the given height, nil if none
+func (w *WAL) GetLock(height uint64) (*Lock, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	return w.getLock(height)
+}
+
+// PreparedCertificate rebuilds the prepared certificate of the locked proposal at the given height
+// from the recorded messages and returns it with the proposal. It returns nil if there is no lock
+// or the proposal of the lock has not been recorded
+func (w *WAL) PreparedCertificate(height uint64) (*protoIBFT.PreparedCertificate, []byte, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	lock, err := w.getLock(height)
+	if err != nil || lock == nil {
+		return nil, nil, err
+	}
+
+	entries, err := w.messages(height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	certificate := &protoIBFT.PreparedCertificate{
+		PrepareMessages: make([]*protoIBFT.Message, 0),
+	}
+
+	for _, entry := range entries {
+		msg := entry.Message
+
+		if msg.View.Round != lock.Round {
+			continue
+		}
+
+		switch msg.Type {
+		case protoIBFT.MessageType_PREPREPARE:
+			if bytes.Equal(messages.ExtractProposalHash(msg), lock.ProposalHash) {
+				certificate.ProposalMessage = msg
+			}
+		case protoIBFT.MessageType_PREPARE:
+			if bytes.Equal(messages.ExtractPrepareHash(msg), lock.ProposalHash) {
+				certificate.PrepareMessages = append(certificate.PrepareMessages, msg)
+			}
+		}
+	}
+
+	if certificate.ProposalMessage == nil {
+		return nil, nil, nil
+	}
+
+	return certificate, messages.ExtractProposal(certificate.ProposalMessage), nil
+}
+
+// Prune removes the messages and the locks at and below the given height
+func (w *WAL) Prune(height uint64) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	batch := new(leveldb.Batch)
+
+	for _, prefix := range [][]byte{messagePrefix, lockPrefix} {
+		iter := w.db.NewIterator(&util.Range{
+			Start: prefix,
+			Limit: append(append([]byte{}, prefix...), encodeUint64(height+1)...),
+		}, nil)
+
+		for iter.Next() {
+			batch.Delete(append([]byte{}, iter.Key()...))
+		}
+
+		iter.Release()
+
+		if err := iter.Error(); err != nil {
+			return err
+		}
+	}
+
+	return w.db.Write(batch, syncWrite)
+}
+
+func (w *WAL) messages(height uint64) ([]*Entry, error) {
+	iter := w.db.NewIterator(util.BytesPrefix(messageKey(height, nil)), nil)
+	defer iter.Release()
+
+	entries := make([]*Entry, 0)
+
+	for iter.Next() {
+		value := iter.Value()
+		if len(value) == 0 {
+			return nil, ErrInvalidRecord
+		}
+
+		msg := &protoIBFT.Message{}
+		if err := proto.Unmarshal(value[1:], msg); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidRecord, err)
+		}
+
+		entries = append(entries, &Entry{
+			Message: msg,
+			Sent:    value[0] == flagSent,
+		})
+	}
+
+	return entries, iter.Error()
+}
+
+func (w *WAL) getLock(height uint64) (*Lock, error) {
+	raw, err := w.db.Get(lockKey(height), nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if len(raw) < 8 {
+		return nil, ErrInvalidRecord
+	}
+
+	return &Lock{
+		Round:        decodeUint64(raw),
+		ProposalHash: raw[8:],
+	}, nil
+}
+
+// messageKey returns the key of the message record, the records are ordered by height
+func messageKey(height uint64, hash []byte) []byte {
+	key := make([]byte, 0, len(messagePrefix)+8+len(hash))
+
+	key = append(key, messagePrefix...)
+	key = append(key, encodeUint64(height)...)
+	key = append(key, hash...)
+
+	return key
+}
+
+func lockKey(height uint64) []byte {
+	return append(append([]byte{}, lockPrefix...), encodeUint64(height)...)
+}
+
+func encodeUint64(n uint64) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, n)
+
+	return buf
+}
+
+func decodeUint64(buf []byte) uint64 {
+	return binary.BigEndian.Uint64(buf)
+}
@@ -0,0 +1,236 @@
+package wal
+
+import (
+	"testing"
+
+	protoIBFT "github.com/0xPolygon/go-ibft/messages/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	testSender1 = []byte{0x1}
+	testSender2 = []byte{0x2}
+	testSender3 = []byte{0x3}
+
+	testProposal     = []byte("proposal")
+	testProposalHash = []byte("proposal hash")
+	testOtherHash    = []byte("other hash")
+)
+
+func newTestWAL(t *testing.T) *WAL {
+	t.Helper()
+
+	w, err := NewMemoryWAL()
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = w.Close()
+	})
+
+	return w
+}
+
+func newPrePrepare(from []byte, height, round uint64, hash []byte) *protoIBFT.Message {
+	return &protoIBFT.Message{
+		View: &protoIBFT.View{Height: height, Round: round},
+		From: from,
+		Type: protoIBFT.MessageType_PREPREPARE,
+		Payload: &protoIBFT.Message_PreprepareData{
+			PreprepareData: &protoIBFT.PrePrepareMessage{
+				Proposal:     testProposal,
+				ProposalHash: hash,
+			},
+		},
+	}
+}
+
+func newPrepare(from []byte, height, round uint64, hash []byte) *protoIBFT.Message {
+	return &protoIBFT.Message{
+		View: &protoIBFT.View{Height: height, Round: round},
+		From: from,
+		Type: protoIBFT.MessageType_PREPARE,
+		Payload: &protoIBFT.Message_PrepareData{
+			PrepareData: &protoIBFT.PrepareMessage{
+				ProposalHash: hash,
+			},
+		},
+	}
+}
+
+// sentMessages returns the sent flags of the entries by sender
+func sentMessages(entries []*Entry) map[byte]bool {
+	sent := make(map[byte]bool, len(entries))
+
+	for _, entry := range entries {
+		sent[entry.Message.From[0]] = entry.Sent
+	}
+
+	return sent
+}
+
+func TestWALAppend(t *testing.T) {
+	t.Parallel()
+
+	w := newTestWAL(t)
+
+	msg1 := newPrepare(testSender1, 1, 0, testProposalHash)
+	msg2 := newPrepare(testSender2, 1, 0, testProposalHash)
+	msg3 := newPrepare(testSender3, 1, 0, testProposalHash)
+
+	assert.ErrorIs(t, w.Append(nil, true), ErrInvalidMessage)
+	assert.ErrorIs(t, w.Append(&protoIBFT.Message{}, true), ErrInvalidMessage)
+
+	// the message received after sending stays sent
+	require.NoError(t, w.Append(msg1, true))
+	require.NoError(t, w.Append(msg1, false))
+
+	// the message sent after receiving becomes sent
+	require.NoError(t, w.Append(msg2, false))
+	require.NoError(t, w.Append(msg2, true))
+
+	require.NoError(t, w.Append(msg3, false))
+	require.NoError(t, w.Append(msg3, false))
+
+	// another height
+	require.NoError(t, w.Append(newPrepare(testSender1, 2, 0, testProposalHash), false))
+
+	entries, err := w.Messages(1)
+	require.NoError(t, err)
+
+	assert.Len(t, entries, 3)
+	assert.Equal(t, map[byte]bool{0x1: true, 0x2: true, 0x3: false}, sentMessages(entries))
+
+	for _, entry := range entries {
+		assert.Equal(t, uint64(1), entry.Message.View.Height)
+	}
+
+	entries, err = w.Messages(3)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestWALLock(t *testing.T) {
+	t.Parallel()
+
+	w := newTestWAL(t)
+
+	lock, err := w.GetLock(1)
+	require.NoError(t, err)
+	assert.Nil(t, lock)
+
+	require.NoError(t, w.SetLock(&protoIBFT.View{Height: 1, Round: 0}, testOtherHash))
+	require.NoError(t, w.SetLock(&protoIBFT.View{Height: 1, Round: 2}, testProposalHash))
+
+	lock, err = w.GetLock(1)
+	require.NoError(t, err)
+	assert.Equal(t, &Lock{Round: 2, ProposalHash: testProposalHash}, lock)
+}
+
+func TestWALPreparedCertificate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		messages         []*protoIBFT.Message
+		lock             *protoIBFT.View
+		expectedProposal []byte
+		expectedPrepares int
+	}{
+		{
+			name: "should return nil if the node is not locked",
+			messages: []*protoIBFT.Message{
+				newPrePrepare(testSender1, 1, 0, testProposalHash),
+			},
+		},
+		{
+			name: "should return nil if the proposal is not recorded",
+			messages: []*protoIBFT.Message{
+				newPrePrepare(testSender1, 1, 0, testOtherHash),
+				newPrepare(testSender2, 1, 1, testProposalHash),
+			},
+			lock: &protoIBFT.View{Height: 1, Round: 1},
+		},
+		{
+			name: "should build the certificate from the messages of the locked round",
+			messages: []*protoIBFT.Message{
+				newPrePrepare(testSender1, 1, 0, testOtherHash),
+				newPrepare(testSender2, 1, 0, testOtherHash),
+				newPrePrepare(testSender2, 1, 1, testProposalHash),
+				newPrepare(testSender1, 1, 1, testProposalHash),
+				newPrepare(testSender3, 1, 1, testProposalHash),
+				newPrepare(testSender2, 1, 1, testOtherHash),
+			},
+			lock:             &protoIBFT.View{Height: 1, Round: 1},
+			expectedProposal: testProposal,
+			expectedPrepares: 2,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			w := newTestWAL(t)
+
+			for _, msg := range test.messages {
+				require.NoError(t, w.Append(msg, false))
+			}
+
+			if test.lock != nil {
+				require.NoError(t, w.SetLock(test.lock, testProposalHash))
+			}
+
+			certificate, proposal, err := w.PreparedCertificate(1)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedProposal, proposal)
+
+			if test.expectedProposal == nil {
+				assert.Nil(t, certificate)
+
+				return
+			}
+
+			assert.True(t, proto.Equal(newPrePrepare(testSender2, 1, 1, testProposalHash), certificate.ProposalMessage))
+			assert.Len(t, certificate.PrepareMessages, test.expectedPrepares)
+
+			for _, msg := range certificate.PrepareMessages {
+				assert.Equal(t, uint64(1), msg.View.Round)
+				assert.Equal(t, testProposalHash, msg.GetPrepareData().ProposalHash)
+			}
+		})
+	}
+}
+
+func TestWALPrune(t *testing.T) {
+	t.Parallel()
+
+	w := newTestWAL(t)
+
+	for height := uint64(1); height <= 3; height++ {
+		require.NoError(t, w.Append(newPrepare(testSender1, height, 0, testProposalHash), true))
+		require.NoError(t, w.SetLock(&protoIBFT.View{Height: height}, testProposalHash))
+	}
+
+	require.NoError(t, w.Prune(2))
+
+	for height := uint64(1); height <= 3; height++ {
+		entries, err := w.Messages(height)
+		require.NoError(t, err)
+
+		lock, err := w.GetLock(height)
+		require.NoError(t, err)
+
+		if height <= 2 {
+			assert.Empty(t, entries)
+			assert.Nil(t, lock)
+		} else {
+			assert.Len(t, entries, 1)
+			assert.NotNil(t, lock)
+		}
+	}
+}
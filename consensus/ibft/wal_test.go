@@ -0,0 +1,33 @@
+package ibft
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsWALHeight(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		height   uint64
+		expected bool
+	}{
+		{"latest height", 10, false},
+		{"past height", 9, false},
+		{"pending height", 11, true},
+		{"last height of the window", 10 + walHeightWindow, true},
+		{"height beyond the window", 11 + walHeightWindow, false},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, isWALHeight(10, test.height))
+		})
+	}
+}
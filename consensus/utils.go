@@ -1,6 +1,8 @@
 package consensus
 
 import (
+	"fmt"
+
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/0xPolygon/polygon-edge/types/buildroot"
 )
@@ -38,3 +40,38 @@ func BuildBlock(params BuildBlockParams) *types.Block {
 		Transactions: txs,
 	}
 }
+
+// KeyConfirmations is the key of the consensus config for the number of blocks
+// required on top of a block to consider the block as finalized
+const KeyConfirmations = "confirmations"
+
+// GetConfirmations returns the number of confirmations in the consensus config, 0 if not set
+func GetConfirmations(config map[string]interface{}) (uint64, error) {
+	raw, ok := config[KeyConfirmations]
+	if !ok {
+		return 0, nil
+	}
+
+	// the value is float64 if it's read from the genesis file
+	switch confirmations := raw.(type) {
+	case uint64:
+		return confirmations, nil
+	case float64:
+		if confirmations < 0 {
+			return 0, fmt.Errorf("%s must not be negative", KeyConfirmations)
+		}
+
+		return uint64(confirmations), nil
+	default:
+		return 0, fmt.Errorf("%s expected int", KeyConfirmations)
+	}
+}
+
+// ConfirmedNumber returns the number of the latest block which has the given confirmations
+func ConfirmedNumber(latest, confirmations uint64) uint64 {
+	if latest < confirmations {
+		return 0
+	}
+
+	return latest - confirmations
+}
@@ -0,0 +1,255 @@
+package chainparams
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/contracts/abis"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/ethgo"
+)
+
+const (
+	methodGet        = "get"
+	methodGetVote    = "getVote"
+	methodSet        = "set"
+	methodKeyCount   = "keyCount"
+	methodKeyAt      = "keyAt"
+	methodStartRound = "startRound"
+
+	methodSetVotingPower = "setVotingPower"
+	methodTally          = "tally"
+
+	// MaxVotedKeys is the max number of the parameters voted in a round,
+	// the same as MAX_KEYS of the contract
+	MaxVotedKeys = 8
+
+	// MaxVotedKeysPerVoter is the max number of the parameters a voter can have voted for
+	// when it opens a new parameter in the round, the same as MAX_KEYS_PER_VOTER of the contract
+	MaxVotedKeysPerVoter = 2
+)
+
+var (
+	// chain parameters contract address
+	AddrChainParamsContract = types.StringToAddress("1002")
+
+	// AddrSystemCaller is the caller of the consensus allowed to set the parameters in the contract
+	AddrSystemCaller = types.StringToAddress("fffffffffffffffffffffffffffffffffffffffe")
+
+	// Gas limit used when calling the chain parameters contract on behalf of the node
+	systemCallGasLimit uint64 = 1000000
+
+	ErrMethodNotFoundInABI = errors.New("method not found in ABI")
+	ErrFailedTypeAssertion = errors.New("failed type assertion")
+	ErrInvalidValue        = errors.New("invalid parameter value")
+)
+
+var (
+	// KeyBlockGasTarget is the key of the block gas target
+	KeyBlockGasTarget = nameKey("blockGasTarget")
+	// KeyMinGasPrice is the key of the minimum gas price of the transactions
+	KeyMinGasPrice = nameKey("minGasPrice")
+
+	// deploymentWhitelistPrefix is the prefix of the keys of the addresses in the deployment whitelist
+	deploymentWhitelistPrefix = []byte("deployment")
+)
+
+// nameKey returns the key of the parameter with the given name
+func nameKey(name string) types.Hash {
+	key := types.Hash{}
+	copy(key[:], name)
+
+	return key
+}
+
+// DeploymentWhitelistKey returns the key of the address in the deployment whitelist,
+// the value of the key is 1 if the address is allowed to deploy contracts
+func DeploymentWhitelistKey(addr types.Address) types.Hash {
+	key := types.Hash{}
+	copy(key[:], deploymentWhitelistPrefix)
+	copy(key[types.HashLength-types.AddressLength:], addr.Bytes())
+
+	return key
+}
+
+// ParseDeploymentWhitelistKey returns the address of the deployment whitelist key,
+// false if the key is not of the deployment whitelist
+func ParseDeploymentWhitelistKey(key types.Hash) (types.Address, bool) {
+	if key != DeploymentWhitelistKey(types.BytesToAddress(key[types.HashLength-types.AddressLength:])) {
+		return types.ZeroAddress, false
+	}
+
+	return types.BytesToAddress(key[types.HashLength-types.AddressLength:]), true
+}
+
+// SystemCallHandler is an interface to call a contract
+// without a transaction, as a part of the state transition of the block
+type SystemCallHandler interface {
+	Call2(
+		caller types.Address,
+		to types.Address,
+		input []byte,
+		value *big.Int,
+		gas uint64,
+	) *runtime.ExecutionResult
+}
+
+// call calls the method of the contract and returns the decoded outputs
+func call(
+	t SystemCallHandler,
+	caller types.Address,
+	methodName string,
+	args ...interface{},
+) (map[string]interface{}, error) {
+	method, ok := abis.ChainParametersABI.Methods[methodName]
+	if !ok {
+		return nil, ErrMethodNotFoundInABI
+	}
+
+	input, err := method.Encode(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res := t.Call2(caller, AddrChainParamsContract, input, big.NewInt(0), systemCallGasLimit)
+	if res.Failed() {
+		return nil, res.Err
+	}
+
+	if len(method.Outputs.TupleElems()) == 0 {
+		return nil, nil
+	}
+
+	decoded, err := method.Outputs.Decode(res.ReturnValue)
+	if err != nil {
+		return nil, err
+	}
+
+	results, ok := decoded.(map[string]interface{})
+	if !ok {
+		return nil, ErrFailedTypeAssertion
+	}
+
+	return results, nil
+}
+
+// decodeValue parses the result of the method returning a pair of a flag and a value
+func decodeValue(results map[string]interface{}, flagName string) (*big.Int, bool, error) {
+	flag, ok := results[flagName].(bool)
+	if !ok {
+		return nil, false, ErrFailedTypeAssertion
+	}
+
+	value, ok := results["value"].(*big.Int)
+	if !ok {
+		return nil, false, ErrFailedTypeAssertion
+	}
+
+	return value, flag, nil
+}
+
+// QueryParameter returns the value of the parameter in the contract,
+// false if the parameter has not been set
+func QueryParameter(t SystemCallHandler, key types.Hash) (*big.Int, bool, error) {
+	results, err := call(t, types.ZeroAddress, methodGet, key)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return decodeValue(results, "exists")
+}
+
+// QueryVote returns the value the voter voted for the parameter, false if the voter has not voted
+func QueryVote(t SystemCallHandler, key types.Hash, voter types.Address) (*big.Int, bool, error) {
+	results, err := call(t, types.ZeroAddress, methodGetVote, key, ethgo.Address(voter))
+	if err != nil {
+		return nil, false, err
+	}
+
+	return decodeValue(results, "voted")
+}
+
+// QueryKeys returns the keys of the parameters voted in the current round of the contract,
+// at most MaxVotedKeys keys are returned
+func QueryKeys(t SystemCallHandler) ([]types.Hash, error) {
+	results, err := call(t, types.ZeroAddress, methodKeyCount)
+	if err != nil {
+		return nil, err
+	}
+
+	count, ok := results["0"].(*big.Int)
+	if !ok {
+		return nil, ErrFailedTypeAssertion
+	}
+
+	if count.Cmp(big.NewInt(MaxVotedKeys)) > 0 {
+		count = big.NewInt(MaxVotedKeys)
+	}
+
+	keys := make([]types.Hash, 0, count.Uint64())
+
+	for idx := uint64(0); idx < count.Uint64(); idx++ {
+		results, err := call(t, types.ZeroAddress, methodKeyAt, new(big.Int).SetUint64(idx))
+		if err != nil {
+			return nil, err
+		}
+
+		key, ok := results["0"].([32]byte)
+		if !ok {
+			return nil, ErrFailedTypeAssertion
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// QueryTally returns the voting power of the voters having voted the value for the parameter
+// in the current round of the contract and the voting power of all the voters
+func QueryTally(t SystemCallHandler, key types.Hash, value *big.Int) (*big.Int, *big.Int, error) {
+	results, err := call(t, types.ZeroAddress, methodTally, key, value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	power, ok := results["power"].(*big.Int)
+	if !ok {
+		return nil, nil, ErrFailedTypeAssertion
+	}
+
+	total, ok := results["total"].(*big.Int)
+	if !ok {
+		return nil, nil, ErrFailedTypeAssertion
+	}
+
+	return power, total, nil
+}
+
+// SetParameter sets the value of the parameter in the contract as the system caller
+func SetParameter(t SystemCallHandler, key types.Hash, value *big.Int) error {
+	_, err := call(t, AddrSystemCaller, methodSet, key, value)
+
+	return err
+}
+
+// StartRound discards the votes of the current round and starts the next round
+// with the given voters in the contract as the system caller
+func StartRound(t SystemCallHandler, voters []types.Address) error {
+	addrs := make([]ethgo.Address, len(voters))
+	for idx, voter := range voters {
+		addrs[idx] = ethgo.Address(voter)
+	}
+
+	_, err := call(t, AddrSystemCaller, methodStartRound, addrs)
+
+	return err
+}
+
+// SetVotingPower sets the voting power of the voter in the current round of the contract as the system caller
+func SetVotingPower(t SystemCallHandler, voter types.Address, power *big.Int) error {
+	_, err := call(t, AddrSystemCaller, methodSetVotingPower, ethgo.Address(voter), power)
+
+	return err
+}
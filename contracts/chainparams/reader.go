@@ -0,0 +1,133 @@
+package chainparams
+
+import (
+	"math/big"
+
+	chainParamsHelper "github.com/0xPolygon/polygon-edge/helper/chainparams"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// Executor is an interface to begin a state transition at a block
+type Executor interface {
+	BeginTxn(types.Hash, *types.Header, types.Address) (*state.Transition, error)
+}
+
+// storage is an interface to read the storage of the accounts in the state
+type storage interface {
+	GetState(addr types.Address, key types.Hash) types.Hash
+}
+
+// Reader reads the chain parameters from the contract in the state of a block.
+// The values are read from the storage of the contract without running the EVM
+type Reader struct {
+	executor Executor
+}
+
+// NewReader is a constructor of Reader
+func NewReader(executor Executor) *Reader {
+	return &Reader{
+		executor: executor,
+	}
+}
+
+// BlockGasTarget returns the block gas target in the state of the given block,
+// false if the parameter has not been set
+func (r *Reader) BlockGasTarget(header *types.Header) (uint64, bool, error) {
+	return r.getUint64(header, KeyBlockGasTarget)
+}
+
+// MinGasPrice returns the minimum gas price of the transactions in the state of the given block,
+// false if the parameter has not been set
+func (r *Reader) MinGasPrice(header *types.Header) (uint64, bool, error) {
+	return r.getUint64(header, KeyMinGasPrice)
+}
+
+// DeploymentWhitelist returns the addresses allowed to deploy contracts
+// by the deployment whitelist in the state of the given block.
+// The whitelist is governed only after an address has been added to it,
+// the returned list is empty otherwise
+func (r *Reader) DeploymentWhitelist(header *types.Header) ([]types.Address, error) {
+	transition, err := r.begin(header)
+	if err != nil {
+		return nil, err
+	}
+
+	return getDeploymentWhitelist(transition.GetTxn()), nil
+}
+
+func (r *Reader) getUint64(header *types.Header, key types.Hash) (uint64, bool, error) {
+	transition, err := r.begin(header)
+	if err != nil {
+		return 0, false, err
+	}
+
+	value := getParameter(transition.GetTxn(), key)
+	if value == nil {
+		return 0, false, nil
+	}
+
+	if !value.IsUint64() {
+		return 0, false, ErrInvalidValue
+	}
+
+	return value.Uint64(), true, nil
+}
+
+func (r *Reader) begin(header *types.Header) (*state.Transition, error) {
+	return r.executor.BeginTxn(header.StateRoot, header, types.ZeroAddress)
+}
+
+// StateReader reads the chain parameters from the storage of the contract in the state being transitioned,
+// the executor checks the transactions of the blocks with it
+type StateReader struct{}
+
+// NewStateReader is a constructor of StateReader
+func NewStateReader() *StateReader {
+	return &StateReader{}
+}
+
+// MinGasPrice returns the minimum gas price of the transactions, nil if the parameter has not been set
+func (r *StateReader) MinGasPrice(txn *state.Txn) *big.Int {
+	return getParameter(txn, KeyMinGasPrice)
+}
+
+// IsDeploymentAllowed returns whether the address is allowed to deploy contracts by the deployment whitelist.
+// The second returned flag is false if the whitelist is not governed
+func (r *StateReader) IsDeploymentAllowed(txn *state.Txn, addr types.Address) (bool, bool) {
+	if txn.GetState(AddrChainParamsContract, chainParamsHelper.DeploymentWhitelistSizeStorageKey()) == types.ZeroHash {
+		return false, false
+	}
+
+	index := txn.GetState(AddrChainParamsContract, chainParamsHelper.DeploymentWhitelistIndexStorageKey(addr))
+
+	return index != types.ZeroHash, true
+}
+
+// getParameter returns the value of the parameter in the storage of the contract, nil if not set
+func getParameter(s storage, key types.Hash) *big.Int {
+	existsKey, valueKey := chainParamsHelper.ParameterStorageKeys(key)
+
+	if s.GetState(AddrChainParamsContract, existsKey) == types.ZeroHash {
+		return nil
+	}
+
+	return s.GetState(AddrChainParamsContract, valueKey).Big()
+}
+
+// getDeploymentWhitelist returns the addresses in the deployment whitelist in the storage of the contract
+func getDeploymentWhitelist(s storage) []types.Address {
+	size := s.GetState(AddrChainParamsContract, chainParamsHelper.DeploymentWhitelistSizeStorageKey()).Big()
+	if !size.IsUint64() {
+		return nil
+	}
+
+	addrs := make([]types.Address, 0, size.Uint64())
+
+	for idx := uint64(0); idx < size.Uint64(); idx++ {
+		value := s.GetState(AddrChainParamsContract, chainParamsHelper.DeploymentWhitelistStorageKey(idx))
+		addrs = append(addrs, types.BytesToAddress(value.Bytes()))
+	}
+
+	return addrs
+}
@@ -0,0 +1,134 @@
+package chainparams
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockExecutor struct {
+	transition *state.Transition
+}
+
+func (m *mockExecutor) BeginTxn(types.Hash, *types.Header, types.Address) (*state.Transition, error) {
+	return m.transition, nil
+}
+
+func TestReaderGetUint64(t *testing.T) {
+	t.Parallel()
+
+	transition := newTestTransition(t)
+	reader := NewReader(&mockExecutor{transition})
+
+	_, ok, err := reader.BlockGasTarget(&types.Header{})
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	for _, validator := range testValidators {
+		vote(t, transition, validator, KeyBlockGasTarget, 1000)
+		vote(t, transition, validator, KeyMinGasPrice, 10)
+	}
+
+	_, err = ApplyVotes(transition, testValidators, nil)
+	require.NoError(t, err)
+
+	target, ok, err := reader.BlockGasTarget(&types.Header{})
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1000), target)
+
+	price, ok, err := reader.MinGasPrice(&types.Header{})
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(10), price)
+}
+
+func TestReaderDeploymentWhitelist(t *testing.T) {
+	t.Parallel()
+
+	var (
+		transition = newTestTransition(t)
+		reader     = NewReader(&mockExecutor{transition})
+		allowed1   = types.StringToAddress("b1")
+		allowed2   = types.StringToAddress("b2")
+	)
+
+	// the whitelist is not governed until an address is added
+	whitelist, err := reader.DeploymentWhitelist(&types.Header{})
+	require.NoError(t, err)
+	assert.Empty(t, whitelist)
+
+	for _, validator := range testValidators {
+		vote(t, transition, validator, DeploymentWhitelistKey(allowed1), 1)
+		vote(t, transition, validator, DeploymentWhitelistKey(allowed2), 1)
+	}
+
+	_, err = ApplyVotes(transition, testValidators, nil)
+	require.NoError(t, err)
+
+	whitelist, err = reader.DeploymentWhitelist(&types.Header{})
+	require.NoError(t, err)
+	assert.Equal(t, []types.Address{allowed1, allowed2}, whitelist)
+
+	// the last address takes the place of the removed one
+	for _, validator := range testValidators {
+		vote(t, transition, validator, DeploymentWhitelistKey(allowed1), 0)
+	}
+
+	_, err = ApplyVotes(transition, testValidators, nil)
+	require.NoError(t, err)
+
+	whitelist, err = reader.DeploymentWhitelist(&types.Header{})
+	require.NoError(t, err)
+	assert.Equal(t, []types.Address{allowed2}, whitelist)
+}
+
+func TestStateReader(t *testing.T) {
+	t.Parallel()
+
+	var (
+		transition = newTestTransition(t)
+		reader     = NewStateReader()
+		allowed    = types.StringToAddress("b1")
+		other      = types.StringToAddress("b2")
+	)
+
+	assert.Nil(t, reader.MinGasPrice(transition.GetTxn()))
+
+	// the whitelist is not governed until an address is added
+	_, governed := reader.IsDeploymentAllowed(transition.GetTxn(), allowed)
+	assert.False(t, governed)
+
+	for _, validator := range testValidators {
+		vote(t, transition, validator, KeyMinGasPrice, 10)
+		vote(t, transition, validator, DeploymentWhitelistKey(allowed), 1)
+	}
+
+	_, err := ApplyVotes(transition, testValidators, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, big.NewInt(10), reader.MinGasPrice(transition.GetTxn()))
+
+	ok, governed := reader.IsDeploymentAllowed(transition.GetTxn(), allowed)
+	assert.True(t, governed)
+	assert.True(t, ok)
+
+	ok, governed = reader.IsDeploymentAllowed(transition.GetTxn(), other)
+	assert.True(t, governed)
+	assert.False(t, ok)
+
+	// removing the last address disables the governed whitelist
+	for _, validator := range testValidators {
+		vote(t, transition, validator, DeploymentWhitelistKey(allowed), 0)
+	}
+
+	_, err = ApplyVotes(transition, testValidators, nil)
+	require.NoError(t, err)
+
+	_, governed = reader.IsDeploymentAllowed(transition.GetTxn(), allowed)
+	assert.False(t, governed)
+}
@@ -0,0 +1,126 @@
+package chainparams
+
+import (
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/validators"
+)
+
+// Change is a change of the parameter applied by the votes of the validators
+type Change struct {
+	Key   types.Hash
+	Value *big.Int
+}
+
+// isValidVote returns whether the validators can vote the value for the parameter
+func isValidVote(key types.Hash, value *big.Int) bool {
+	switch key {
+	case KeyBlockGasTarget:
+		return value.Sign() > 0 && value.IsUint64()
+	case KeyMinGasPrice:
+		return value.IsUint64()
+	}
+
+	if _, ok := ParseDeploymentWhitelistKey(key); ok {
+		return value.Cmp(big.NewInt(0)) == 0 || value.Cmp(big.NewInt(1)) == 0
+	}
+
+	return false
+}
+
+// hasQuorum returns whether the power of the votes is more than 2/3 of the total power
+func hasQuorum(votes, total *big.Int) bool {
+	return new(big.Int).Mul(votes, big.NewInt(3)).Cmp(new(big.Int).Mul(total, big.NewInt(2))) > 0
+}
+
+// ApplyVotes counts the votes of the validators for the parameters voted in the current round
+// and sets the values voted by more than 2/3 of the voting power of the voters in the contract.
+// The votes for the unknown parameters and the invalid values are ignored.
+// The next round is started with the validators as the voters, so that the votes are counted
+// for at most MaxVotedKeys parameters in every round. The votes of the next round are weighted
+// by the given powers, e.g. the stakes in PoS, or one per validator if nil
+func ApplyVotes(
+	t SystemCallHandler,
+	validators []types.Address,
+	powers validators.VotingPowers,
+) ([]*Change, error) {
+	keys, err := QueryKeys(t)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*Change, 0)
+
+	for _, key := range keys {
+		value, err := tallyVotes(t, key, validators)
+		if err != nil {
+			return nil, err
+		}
+
+		if value == nil {
+			continue
+		}
+
+		current, exists, err := QueryParameter(t, key)
+		if err != nil {
+			return nil, err
+		}
+
+		if exists && current.Cmp(value) == 0 {
+			continue
+		}
+
+		if err := SetParameter(t, key, value); err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, &Change{Key: key, Value: value})
+	}
+
+	if err := StartRound(t, validators); err != nil {
+		return nil, err
+	}
+
+	if powers == nil {
+		return changes, nil
+	}
+
+	for _, validator := range validators {
+		if err := SetVotingPower(t, validator, powers.Get(validator)); err != nil {
+			return nil, err
+		}
+	}
+
+	return changes, nil
+}
+
+// tallyVotes returns the valid value of the parameter voted by the validators
+// having the quorum of the voting power in the contract, nil if none
+func tallyVotes(t SystemCallHandler, key types.Hash, validators []types.Address) (*big.Int, error) {
+	tallied := make(map[string]bool)
+
+	for _, validator := range validators {
+		value, voted, err := QueryVote(t, key, validator)
+		if err != nil {
+			return nil, err
+		}
+
+		if !voted || !isValidVote(key, value) || tallied[value.String()] {
+			continue
+		}
+
+		tallied[value.String()] = true
+
+		power, total, err := QueryTally(t, key, value)
+		if err != nil {
+			return nil, err
+		}
+
+		if hasQuorum(power, total) {
+			return value, nil
+		}
+	}
+
+	return nil, nil
+}
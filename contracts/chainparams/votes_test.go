@@ -0,0 +1,422 @@
+package chainparams
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/contracts/abis"
+	chainParamsHelper "github.com/0xPolygon/polygon-edge/helper/chainparams"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/validators"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/ethgo"
+)
+
+var (
+	validator1 = types.StringToAddress("a1")
+	validator2 = types.StringToAddress("a2")
+	validator3 = types.StringToAddress("a3")
+	validator4 = types.StringToAddress("a4")
+
+	testValidators = []types.Address{validator1, validator2, validator3, validator4}
+)
+
+// newTestTransition returns the transition of the state with the contract predeployed with the voters,
+// the test validators are the voters by default
+func newTestTransition(t *testing.T, voters ...types.Address) *state.Transition {
+	t.Helper()
+
+	if len(voters) == 0 {
+		voters = testValidators
+	}
+
+	vals := validators.NewECDSAValidatorSet()
+	for _, voter := range voters {
+		require.NoError(t, vals.Add(validators.NewECDSAValidator(voter)))
+	}
+
+	account, err := chainParamsHelper.PredeployChainParamsSC(vals)
+	require.NoError(t, err)
+
+	ex := state.NewExecutor(&chain.Params{
+		Forks: chain.AllForksEnabled,
+	}, itrie.NewState(itrie.NewMemoryStorage()), hclog.NewNullLogger())
+
+	root := ex.WriteGenesis(map[types.Address]*chain.GenesisAccount{
+		AddrChainParamsContract: account,
+	})
+
+	ex.GetHash = func(*types.Header) state.GetHashByNumber {
+		return func(uint64) types.Hash {
+			return root
+		}
+	}
+
+	transition, err := ex.BeginTxn(root, &types.Header{}, types.ZeroAddress)
+	require.NoError(t, err)
+
+	return transition
+}
+
+// vote votes the value for the parameter on behalf of the voter
+func vote(t *testing.T, transition *state.Transition, voter types.Address, key types.Hash, value uint64) {
+	t.Helper()
+
+	require.NoError(t, tryVote(t, transition, voter, key, value))
+}
+
+// tryVote votes the value for the parameter on behalf of the voter and returns the error of the call
+func tryVote(t *testing.T, transition *state.Transition, voter types.Address, key types.Hash, value uint64) error {
+	t.Helper()
+
+	input, err := abis.ChainParametersABI.Methods["vote"].Encode([]interface{}{key, new(big.Int).SetUint64(value)})
+	require.NoError(t, err)
+
+	return transition.Call2(voter, AddrChainParamsContract, input, big.NewInt(0), systemCallGasLimit).Err
+}
+
+func TestDeploymentWhitelistKey(t *testing.T) {
+	t.Parallel()
+
+	addr := types.StringToAddress("123")
+
+	parsed, ok := ParseDeploymentWhitelistKey(DeploymentWhitelistKey(addr))
+	assert.True(t, ok)
+	assert.Equal(t, addr, parsed)
+
+	_, ok = ParseDeploymentWhitelistKey(KeyBlockGasTarget)
+	assert.False(t, ok)
+}
+
+func TestContract(t *testing.T) {
+	t.Parallel()
+
+	transition := newTestTransition(t)
+
+	// nothing is set nor voted
+	_, ok, err := QueryParameter(transition, KeyBlockGasTarget)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	keys, err := QueryKeys(transition)
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	vote(t, transition, validator1, KeyBlockGasTarget, 10)
+	vote(t, transition, validator1, KeyBlockGasTarget, 20)
+	vote(t, transition, validator2, KeyMinGasPrice, 30)
+
+	keys, err = QueryKeys(transition)
+	require.NoError(t, err)
+	assert.Equal(t, []types.Hash{KeyBlockGasTarget, KeyMinGasPrice}, keys)
+
+	value, ok, err := QueryVote(transition, KeyBlockGasTarget, validator1)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, big.NewInt(20), value)
+
+	_, ok, err = QueryVote(transition, KeyBlockGasTarget, validator2)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// only the system caller can set the parameter
+	_, err = call(transition, validator1, methodSet, KeyBlockGasTarget, big.NewInt(1))
+	assert.Error(t, err)
+
+	require.NoError(t, SetParameter(transition, KeyBlockGasTarget, big.NewInt(40)))
+
+	value, ok, err = QueryParameter(transition, KeyBlockGasTarget)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, big.NewInt(40), value)
+
+	// only the system caller can start the round
+	_, err = call(transition, validator1, methodStartRound, []ethgo.Address{ethgo.Address(validator1)})
+	assert.Error(t, err)
+
+	// the votes of the previous round are discarded
+	require.NoError(t, StartRound(transition, []types.Address{validator2}))
+
+	keys, err = QueryKeys(transition)
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	_, ok, err = QueryVote(transition, KeyBlockGasTarget, validator1)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// only the voters of the round can vote
+	assert.ErrorIs(t, tryVote(t, transition, validator1, KeyBlockGasTarget, 50), runtime.ErrExecutionReverted)
+	assert.NoError(t, tryVote(t, transition, validator2, KeyBlockGasTarget, 50))
+}
+
+func TestContract_VoteRestrictions(t *testing.T) {
+	t.Parallel()
+
+	transition := newTestTransition(t)
+
+	// only the voters can vote
+	assert.ErrorIs(
+		t,
+		tryVote(t, transition, types.StringToAddress("c1"), KeyBlockGasTarget, 10),
+		runtime.ErrExecutionReverted,
+	)
+
+	// only the known parameters can be voted
+	assert.ErrorIs(
+		t,
+		tryVote(t, transition, validator1, nameKey("blockTime"), 10),
+		runtime.ErrExecutionReverted,
+	)
+
+	// the number of the parameters voted in a round is limited
+	for idx := 0; idx < MaxVotedKeys; idx++ {
+		voter := testValidators[idx/MaxVotedKeysPerVoter]
+		vote(t, transition, voter, DeploymentWhitelistKey(types.StringToAddress(fmt.Sprintf("b%d", idx))), 1)
+	}
+
+	assert.ErrorIs(
+		t,
+		tryVote(t, transition, validator1, KeyBlockGasTarget, 10),
+		runtime.ErrExecutionReverted,
+	)
+
+	// the parameters already voted in the round can be voted
+	vote(t, transition, validator2, DeploymentWhitelistKey(types.StringToAddress("b0")), 1)
+
+	keys, err := QueryKeys(transition)
+	require.NoError(t, err)
+	assert.Len(t, keys, MaxVotedKeys)
+}
+
+func TestContract_VotedKeysPerVoter(t *testing.T) {
+	t.Parallel()
+
+	transition := newTestTransition(t)
+
+	keys := make([]types.Hash, MaxVotedKeysPerVoter+1)
+	for idx := range keys {
+		keys[idx] = DeploymentWhitelistKey(types.StringToAddress(fmt.Sprintf("b%d", idx)))
+	}
+
+	// the voter opens the parameters up to the limit
+	for _, key := range keys[:MaxVotedKeysPerVoter] {
+		vote(t, transition, validator1, key, 1)
+	}
+
+	assert.ErrorIs(
+		t,
+		tryVote(t, transition, validator1, keys[MaxVotedKeysPerVoter], 1),
+		runtime.ErrExecutionReverted,
+	)
+
+	// the voter can still vote for the opened parameters
+	vote(t, transition, validator1, keys[0], 0)
+
+	// the other voters can open the parameters
+	vote(t, transition, validator2, keys[MaxVotedKeysPerVoter], 1)
+
+	// the voter can vote for the parameters opened by the others
+	vote(t, transition, validator1, keys[MaxVotedKeysPerVoter], 1)
+
+	// the votes of the previous round are not counted
+	require.NoError(t, StartRound(transition, testValidators))
+
+	for _, key := range keys[:MaxVotedKeysPerVoter] {
+		vote(t, transition, validator1, key, 1)
+	}
+}
+
+func TestContract_Tally(t *testing.T) {
+	t.Parallel()
+
+	transition := newTestTransition(t)
+
+	assertTally := func(value int64, expectedPower, expectedTotal int64) {
+		t.Helper()
+
+		power, total, err := QueryTally(transition, KeyBlockGasTarget, big.NewInt(value))
+		require.NoError(t, err)
+		assert.Equal(t, expectedPower, power.Int64())
+		assert.Equal(t, expectedTotal, total.Int64())
+	}
+
+	// the voting power of the voters is one by default
+	assertTally(100, 0, 4)
+
+	vote(t, transition, validator1, KeyBlockGasTarget, 100)
+	vote(t, transition, validator2, KeyBlockGasTarget, 100)
+	vote(t, transition, validator3, KeyBlockGasTarget, 200)
+
+	assertTally(100, 2, 4)
+	assertTally(200, 1, 4)
+	assertTally(300, 0, 4)
+
+	// only the system caller can set the voting power
+	_, err := call(transition, validator1, methodSetVotingPower, ethgo.Address(validator1), big.NewInt(10))
+	assert.Error(t, err)
+
+	require.NoError(t, SetVotingPower(transition, validator1, big.NewInt(10)))
+	require.NoError(t, SetVotingPower(transition, validator3, big.NewInt(5)))
+
+	assertTally(100, 11, 17)
+	assertTally(200, 5, 17)
+
+	// the votes and the voting powers of the previous round are discarded
+	require.NoError(t, StartRound(transition, []types.Address{validator1, validator2, types.StringToAddress("c1")}))
+
+	assertTally(100, 0, 3)
+}
+
+func TestApplyVotes(t *testing.T) {
+	t.Parallel()
+
+	whitelisted := types.StringToAddress("b1")
+
+	tests := []struct {
+		name            string
+		votes           map[types.Address]map[types.Hash]uint64
+		powers          validators.VotingPowers
+		expectedChanges []*Change
+	}{
+		{
+			name: "should not change the parameter without the quorum",
+			votes: map[types.Address]map[types.Hash]uint64{
+				validator1: {KeyBlockGasTarget: 100},
+				validator2: {KeyBlockGasTarget: 100},
+				validator3: {KeyBlockGasTarget: 200},
+			},
+			expectedChanges: []*Change{},
+		},
+		{
+			name: "should change the parameters having the quorum",
+			votes: map[types.Address]map[types.Hash]uint64{
+				validator1: {KeyBlockGasTarget: 100, KeyMinGasPrice: 5},
+				validator2: {KeyBlockGasTarget: 100, KeyMinGasPrice: 5},
+				validator3: {KeyBlockGasTarget: 100},
+				validator4: {KeyMinGasPrice: 5},
+			},
+			expectedChanges: []*Change{
+				{Key: KeyBlockGasTarget, Value: big.NewInt(100)},
+				{Key: KeyMinGasPrice, Value: big.NewInt(5)},
+			},
+		},
+		{
+			name: "should ignore the invalid values",
+			votes: map[types.Address]map[types.Hash]uint64{
+				validator1: {KeyBlockGasTarget: 0, DeploymentWhitelistKey(whitelisted): 2},
+				validator2: {KeyBlockGasTarget: 0, DeploymentWhitelistKey(whitelisted): 2},
+				validator3: {KeyBlockGasTarget: 0, DeploymentWhitelistKey(whitelisted): 2},
+			},
+			expectedChanges: []*Change{},
+		},
+		{
+			name: "should add the address to the deployment whitelist",
+			votes: map[types.Address]map[types.Hash]uint64{
+				validator1: {DeploymentWhitelistKey(whitelisted): 1},
+				validator2: {DeploymentWhitelistKey(whitelisted): 1},
+				validator3: {DeploymentWhitelistKey(whitelisted): 1},
+			},
+			expectedChanges: []*Change{
+				{Key: DeploymentWhitelistKey(whitelisted), Value: big.NewInt(1)},
+			},
+		},
+		{
+			name: "should change the parameter voted by more than 2/3 of the voting power",
+			votes: map[types.Address]map[types.Hash]uint64{
+				validator1: {KeyBlockGasTarget: 100},
+			},
+			powers: validators.VotingPowers{
+				validator1: big.NewInt(10),
+				validator2: big.NewInt(1),
+				validator3: big.NewInt(1),
+				validator4: big.NewInt(1),
+			},
+			expectedChanges: []*Change{
+				{Key: KeyBlockGasTarget, Value: big.NewInt(100)},
+			},
+		},
+		{
+			name: "should not change the parameter voted by the validators without 2/3 of the voting power",
+			votes: map[types.Address]map[types.Hash]uint64{
+				validator2: {KeyBlockGasTarget: 100},
+				validator3: {KeyBlockGasTarget: 100},
+				validator4: {KeyBlockGasTarget: 100},
+			},
+			powers: validators.VotingPowers{
+				validator1: big.NewInt(10),
+				validator2: big.NewInt(1),
+				validator3: big.NewInt(1),
+				validator4: big.NewInt(1),
+			},
+			expectedChanges: []*Change{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			transition := newTestTransition(t)
+
+			// the voting powers are set at the start of the round
+			for voter, power := range test.powers {
+				require.NoError(t, SetVotingPower(transition, voter, power))
+			}
+
+			// vote in the order of the validators so that the keys are registered deterministically
+			for _, voter := range testValidators {
+				for _, key := range []types.Hash{
+					KeyBlockGasTarget, KeyMinGasPrice, DeploymentWhitelistKey(whitelisted),
+				} {
+					if value, ok := test.votes[voter][key]; ok {
+						vote(t, transition, voter, key, value)
+					}
+				}
+			}
+
+			changes, err := ApplyVotes(transition, testValidators, test.powers)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedChanges, changes)
+
+			for _, change := range changes {
+				value, ok, err := QueryParameter(transition, change.Key)
+				require.NoError(t, err)
+				assert.True(t, ok)
+				assert.Equal(t, change.Value, value)
+			}
+
+			// the votes are discarded in the next round
+			keys, err := QueryKeys(transition)
+			require.NoError(t, err)
+			assert.Empty(t, keys)
+
+			// the votes of the next round are weighted by the given powers
+			expectedTotal := int64(0)
+			for _, validator := range testValidators {
+				if test.powers != nil {
+					expectedTotal += test.powers.Get(validator).Int64()
+				} else {
+					expectedTotal++
+				}
+			}
+
+			_, total, err := QueryTally(transition, KeyBlockGasTarget, big.NewInt(0))
+			require.NoError(t, err)
+			assert.Equal(t, expectedTotal, total.Int64())
+
+			changes, err = ApplyVotes(transition, testValidators, test.powers)
+			require.NoError(t, err)
+			assert.Empty(t, changes)
+		})
+	}
+}
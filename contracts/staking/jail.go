@@ -0,0 +1,87 @@
+package staking
+
+import (
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/contracts/abis"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/ethgo"
+)
+
+const (
+	methodJail = "jail"
+)
+
+var (
+	// AddrSystemCaller is the caller of the consensus allowed to jail the validators in the contract
+	AddrSystemCaller = types.StringToAddress("fffffffffffffffffffffffffffffffffffffffe")
+
+	// Gas limit used when calling the staking contract on behalf of the consensus
+	systemCallGasLimit uint64 = 1000000
+)
+
+// SystemCallHandler is an interface to call a contract
+// without a transaction, as a part of the state transition of the block
+type SystemCallHandler interface {
+	Call2(
+		caller types.Address,
+		to types.Address,
+		input []byte,
+		value *big.Int,
+		gas uint64,
+	) *runtime.ExecutionResult
+}
+
+// JailValidator removes the validator from the validator set by unstaking its whole stake
+// with the jail method of the contract, which only accepts the system caller.
+// The stake is returned to the validator.
+// The result of the call is returned as the call may be reverted by the contract,
+// e.g. the validator has no stake or the validator set has reached the minimum size
+func JailValidator(t SystemCallHandler, validator types.Address) (*runtime.ExecutionResult, error) {
+	method, ok := abis.StakingABI.Methods[methodJail]
+	if !ok {
+		return nil, ErrMethodNotFoundInABI
+	}
+
+	input, err := method.Encode([]interface{}{ethgo.Address(validator)})
+	if err != nil {
+		return nil, err
+	}
+
+	return t.Call2(
+		AddrSystemCaller,
+		AddrStakingContract,
+		input,
+		big.NewInt(0),
+		systemCallGasLimit,
+	), nil
+}
+
+// SystemQueryAccountStake returns the amount staked by the account in the contract
+// by the call as the system caller, which doesn't change the nonce of any account
+func SystemQueryAccountStake(t SystemCallHandler, account types.Address) (*big.Int, error) {
+	method, ok := abis.StakingABI.Methods[methodAccountStake]
+	if !ok {
+		return nil, ErrMethodNotFoundInABI
+	}
+
+	input, err := method.Encode([]interface{}{ethgo.Address(account)})
+	if err != nil {
+		return nil, err
+	}
+
+	res := t.Call2(
+		AddrSystemCaller,
+		AddrStakingContract,
+		input,
+		big.NewInt(0),
+		systemCallGasLimit,
+	)
+
+	if res.Failed() {
+		return nil, res.Err
+	}
+
+	return decodeAccountStake(method, res.ReturnValue)
+}
@@ -0,0 +1,137 @@
+package staking
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/contracts/abis"
+	stakingHelper "github.com/0xPolygon/polygon-edge/helper/staking"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/validators"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/ethgo"
+)
+
+type SystemCallMock struct {
+	Call2Func func(types.Address, types.Address, []byte, *big.Int, uint64) *runtime.ExecutionResult
+}
+
+func (m *SystemCallMock) Call2(
+	caller types.Address,
+	to types.Address,
+	input []byte,
+	value *big.Int,
+	gas uint64,
+) *runtime.ExecutionResult {
+	return m.Call2Func(caller, to, input, value, gas)
+}
+
+func TestJailValidator(t *testing.T) {
+	t.Parallel()
+
+	method := abis.StakingABI.Methods[methodJail]
+	assert.NotNil(t, method)
+
+	expectedInput, err := method.Encode([]interface{}{ethgo.Address(addr1)})
+	assert.NoError(t, err)
+
+	expectedRes := &runtime.ExecutionResult{
+		Err: runtime.ErrExecutionReverted,
+	}
+
+	res, err := JailValidator(&SystemCallMock{
+		Call2Func: func(caller, to types.Address, input []byte, value *big.Int, gas uint64) *runtime.ExecutionResult {
+			assert.Equal(t, AddrSystemCaller, caller)
+			assert.Equal(t, AddrStakingContract, to)
+			assert.Equal(t, expectedInput, input)
+			assert.Equal(t, big.NewInt(0), value)
+			assert.Equal(t, systemCallGasLimit, gas)
+
+			return expectedRes
+		},
+	}, addr1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedRes, res)
+}
+
+func TestJailValidator_StakingContract(t *testing.T) {
+	t.Parallel()
+
+	var (
+		validator1 = types.StringToAddress("1001a")
+		validator2 = types.StringToAddress("1001b")
+	)
+
+	newTransition := func(t *testing.T) *state.Transition {
+		t.Helper()
+
+		ex := state.NewExecutor(&chain.Params{
+			Forks: chain.AllForksEnabled,
+		}, itrie.NewState(itrie.NewMemoryStorage()), hclog.NewNullLogger())
+
+		rootHash := ex.WriteGenesis(nil)
+
+		ex.GetHash = func(h *types.Header) state.GetHashByNumber {
+			return func(i uint64) types.Hash {
+				return rootHash
+			}
+		}
+
+		transition, err := ex.BeginTxn(rootHash, &types.Header{GasLimit: 10000000}, types.ZeroAddress)
+		require.NoError(t, err)
+
+		account, err := stakingHelper.PredeployStakingSC(
+			validators.NewECDSAValidatorSet(
+				validators.NewECDSAValidator(validator1),
+				validators.NewECDSAValidator(validator2),
+			),
+			stakingHelper.PredeployParams{
+				MinValidatorCount: 1,
+				MaxValidatorCount: 10,
+				Jailing:           true,
+			},
+		)
+		require.NoError(t, err)
+		require.NoError(t, transition.SetAccountDirectly(AddrStakingContract, account))
+
+		return transition
+	}
+
+	t.Run("should jail the validator as the system caller", func(t *testing.T) {
+		t.Parallel()
+
+		transition := newTransition(t)
+		stake := new(big.Int).Set(transition.GetBalance(AddrStakingContract))
+
+		res, err := JailValidator(transition, validator1)
+		require.NoError(t, err)
+		require.False(t, res.Failed(), res.Err)
+
+		validators, err := QueryValidators(transition, validator2)
+		require.NoError(t, err)
+
+		assert.Equal(t, []types.Address{validator2}, validators)
+		assert.Equal(t, new(big.Int).Div(stake, big.NewInt(2)), transition.GetBalance(validator1))
+	})
+
+	t.Run("should revert when the validator calls jail", func(t *testing.T) {
+		t.Parallel()
+
+		transition := newTransition(t)
+		method := abis.StakingABI.Methods[methodJail]
+
+		input, err := method.Encode([]interface{}{ethgo.Address(validator1)})
+		require.NoError(t, err)
+
+		res := transition.Call2(validator1, AddrStakingContract, input, big.NewInt(0), systemCallGasLimit)
+		assert.ErrorIs(t, res.Err, runtime.ErrExecutionReverted)
+		assert.Equal(t, big.NewInt(0), transition.GetBalance(validator1))
+	})
+}
@@ -16,6 +16,7 @@ import (
 const (
 	methodValidators             = "validators"
 	methodValidatorBLSPublicKeys = "validatorBLSPublicKeys"
+	methodAccountStake           = "accountStake"
 )
 
 var (
@@ -209,3 +210,56 @@ func QueryBLSPublicKeys(t TxQueryHandler, from types.Address) ([][]byte, error)
 
 	return decodeBLSPublicKeys(method, res.ReturnValue)
 }
+
+// decodeAccountStake parses contract call result and returns the stake amount
+func decodeAccountStake(
+	method *abi.Method,
+	returnValue []byte,
+) (*big.Int, error) {
+	decodedResults, err := method.Outputs.Decode(returnValue)
+	if err != nil {
+		return nil, err
+	}
+
+	results, ok := decodedResults.(map[string]interface{})
+	if !ok {
+		return nil, ErrFailedTypeAssertion
+	}
+
+	stake, ok := results["0"].(*big.Int)
+	if !ok {
+		return nil, ErrFailedTypeAssertion
+	}
+
+	return stake, nil
+}
+
+// QueryAccountStake is a helper function to get the amount staked by the account from contract
+func QueryAccountStake(t TxQueryHandler, from types.Address, account types.Address) (*big.Int, error) {
+	method, ok := abis.StakingABI.Methods[methodAccountStake]
+	if !ok {
+		return nil, ErrMethodNotFoundInABI
+	}
+
+	input, err := method.Encode([]interface{}{ethgo.Address(account)})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := t.Apply(createCallViewTx(
+		from,
+		AddrStakingContract,
+		input,
+		t.GetNonce(from),
+	))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Failed() {
+		return nil, res.Err
+	}
+
+	return decodeAccountStake(method, res.ReturnValue)
+}
@@ -43,6 +43,8 @@ const (
 
 var (
 	errInvalidSignature = errors.New("invalid signature")
+
+	ErrSignatureKeyMismatch = errors.New("signature was not made by the given public key")
 )
 
 func trimLeftZeros(b []byte) []byte {
@@ -189,6 +191,36 @@ func Sign(priv *ecdsa.PrivateKey, hash []byte) ([]byte, error) {
 	return append(sig, term)[1:], nil
 }
 
+// ToRecoverableSignature converts the R and S values of the hash signature made by the given public key
+// into the compact format produced by Sign. It is meant for external signers (HSMs, remote signers...)
+// which don't return the recovery id: S is normalized to the lower half of the curve order
+// and V is found by recovering the public key
+func ToRecoverableSignature(r, s *big.Int, hash []byte, pub *ecdsa.PublicKey) ([]byte, error) {
+	n := S256.Params().N
+
+	if s.Cmp(new(big.Int).Rsh(n, 1)) > 0 {
+		s = new(big.Int).Sub(n, s)
+	}
+
+	for v := byte(0); v < 2; v++ {
+		sig, err := EncodeSignature(r, s, v)
+		if err != nil {
+			return nil, err
+		}
+
+		recovered, err := RecoverPubkey(sig, hash)
+		if err != nil {
+			continue
+		}
+
+		if recovered.X.Cmp(pub.X) == 0 && recovered.Y.Cmp(pub.Y) == 0 {
+			return sig, nil
+		}
+	}
+
+	return nil, ErrSignatureKeyMismatch
+}
+
 // SignByBLS signs the given data by BLS
 func SignByBLS(prv *bls_sig.SecretKey, msg []byte) ([]byte, error) {
 	signature, err := bls_sig.NewSigPop().Sign(prv, msg)
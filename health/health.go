@@ -0,0 +1,185 @@
+package health
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// DefaultMinPeers is the default minimum number of peers of the ready node
+	DefaultMinPeers uint64 = 1
+
+	// DefaultMaxBlockAge is the default maximum age of the head block of the ready node,
+	// extended to the max interval between the blocks if the empty blocks are skipped
+	DefaultMaxBlockAge = time.Minute
+
+	// txPoolSaturationMark is the percentage of the used txpool slots above which
+	// the pool is considered saturated, it matches the high pressure mark of the txpool
+	txPoolSaturationMark = 80
+)
+
+// names of the readiness checks
+const (
+	PeersCheck   = "peers"
+	SyncingCheck = "syncing"
+	HeadCheck    = "head_block"
+	TxPoolCheck  = "txpool"
+)
+
+// Store provides the node data the readiness checks are based on
+type Store interface {
+	// GetPeers returns the number of the connected peers
+	GetPeers() int
+
+	// GetSyncProgression retrieves the current sync progression, if any
+	GetSyncProgression() *progress.Progression
+
+	// Header returns the head block header
+	Header() *types.Header
+
+	// GetCapacity returns the current and max capacity of the txpool in slots
+	GetCapacity() (uint64, uint64)
+
+	// GetMaxBlockInterval returns the max interval between the block at the given height and the next one,
+	// zero if the empty blocks are not skipped
+	GetMaxBlockInterval(height uint64) time.Duration
+}
+
+// Config defines the thresholds of the readiness checks
+type Config struct {
+	// MinPeers is the minimum number of the connected peers
+	MinPeers uint64
+
+	// MaxBlockAge is the maximum age of the head block, derived from DefaultMaxBlockAge
+	// and the max interval between the blocks if zero
+	MaxBlockAge time.Duration
+}
+
+// Check is the result of a single readiness check
+type Check struct {
+	Name    string `json:"name"`
+	Passed  bool   `json:"passed"`
+	Message string `json:"message"`
+}
+
+// Status is the readiness of the node with the results of all the checks,
+// the node is ready if all the checks have passed
+type Status struct {
+	Ready  bool     `json:"ready"`
+	Checks []*Check `json:"checks"`
+}
+
+// Checker checks whether the node is ready to serve the requests
+type Checker struct {
+	store  Store
+	config *Config
+
+	// now returns the current time, replaced in the tests
+	now func() time.Time
+}
+
+// NewChecker creates the readiness checker
+func NewChecker(store Store, config *Config) *Checker {
+	return &Checker{
+		store:  store,
+		config: config,
+		now:    time.Now,
+	}
+}
+
+// Readiness runs all the readiness checks
+func (c *Checker) Readiness() *Status {
+	status := &Status{
+		Ready: true,
+		Checks: []*Check{
+			c.checkPeers(),
+			c.checkSyncing(),
+			c.checkHead(),
+			c.checkTxPool(),
+		},
+	}
+
+	for _, check := range status.Checks {
+		status.Ready = status.Ready && check.Passed
+	}
+
+	return status
+}
+
+// checkPeers checks the node is connected to enough peers
+func (c *Checker) checkPeers() *Check {
+	peers := uint64(c.store.GetPeers())
+
+	return &Check{
+		Name:    PeersCheck,
+		Passed:  peers >= c.config.MinPeers,
+		Message: fmt.Sprintf("%d peers connected, %d required", peers, c.config.MinPeers),
+	}
+}
+
+// checkSyncing checks the node is not syncing nor restoring the chain
+func (c *Checker) checkSyncing() *Check {
+	syncProgression := c.store.GetSyncProgression()
+	if syncProgression == nil {
+		return &Check{
+			Name:    SyncingCheck,
+			Passed:  true,
+			Message: "not syncing",
+		}
+	}
+
+	return &Check{
+		Name:   SyncingCheck,
+		Passed: false,
+		Message: fmt.Sprintf("%s in progress, block %d of %d",
+			syncProgression.SyncType,
+			syncProgression.CurrentBlock,
+			syncProgression.HighestBlock,
+		),
+	}
+}
+
+// checkHead checks the head block is recent enough
+func (c *Checker) checkHead() *Check {
+	header := c.store.Header()
+	age := c.now().Sub(time.Unix(int64(header.Timestamp), 0)).Truncate(time.Second)
+	maxAge := c.maxBlockAge(header.Number)
+
+	return &Check{
+		Name:   HeadCheck,
+		Passed: age <= maxAge,
+		Message: fmt.Sprintf("block %d is %s old, max age %s",
+			header.Number,
+			age,
+			maxAge,
+		),
+	}
+}
+
+// maxBlockAge returns the max age of the head block at the given height, the configured one if set.
+// Otherwise the head may be as old as the max interval between the blocks when the empty blocks are skipped
+func (c *Checker) maxBlockAge(height uint64) time.Duration {
+	if c.config.MaxBlockAge != 0 {
+		return c.config.MaxBlockAge
+	}
+
+	if interval := c.store.GetMaxBlockInterval(height); interval > DefaultMaxBlockAge {
+		return interval
+	}
+
+	return DefaultMaxBlockAge
+}
+
+// checkTxPool checks the txpool is not saturated
+func (c *Checker) checkTxPool() *Check {
+	current, max := c.store.GetCapacity()
+
+	return &Check{
+		Name:    TxPoolCheck,
+		Passed:  current*100 <= txPoolSaturationMark*max,
+		Message: fmt.Sprintf("%d of %d slots used", current, max),
+	}
+}
@@ -0,0 +1,218 @@
+package health
+
+import (
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockStore struct {
+	peers           int
+	syncProgression *progress.Progression
+	header          *types.Header
+	slots           uint64
+	maxSlots        uint64
+	blockInterval   time.Duration
+}
+
+func (m *mockStore) GetPeers() int {
+	return m.peers
+}
+
+func (m *mockStore) GetSyncProgression() *progress.Progression {
+	return m.syncProgression
+}
+
+func (m *mockStore) Header() *types.Header {
+	return m.header
+}
+
+func (m *mockStore) GetCapacity() (uint64, uint64) {
+	return m.slots, m.maxSlots
+}
+
+func (m *mockStore) GetMaxBlockInterval(uint64) time.Duration {
+	return m.blockInterval
+}
+
+func TestChecker_Readiness(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+
+	// newStore returns the store of the ready node
+	newStore := func() *mockStore {
+		return &mockStore{
+			peers:    2,
+			header:   &types.Header{Number: 10, Timestamp: 990},
+			slots:    80,
+			maxSlots: 100,
+		}
+	}
+
+	testTable := []struct {
+		name   string
+		modify func(*mockStore)
+		failed []string
+	}{
+		{
+			"all the checks pass",
+			func(*mockStore) {},
+			nil,
+		},
+		{
+			"not enough peers",
+			func(m *mockStore) {
+				m.peers = 1
+			},
+			[]string{PeersCheck},
+		},
+		{
+			"node is syncing",
+			func(m *mockStore) {
+				m.syncProgression = &progress.Progression{
+					SyncType:     progress.ChainSyncBulk,
+					CurrentBlock: 5,
+					HighestBlock: 20,
+				}
+			},
+			[]string{SyncingCheck},
+		},
+		{
+			"head block is too old",
+			func(m *mockStore) {
+				m.header = &types.Header{Number: 10, Timestamp: 900}
+			},
+			[]string{HeadCheck},
+		},
+		{
+			"txpool is saturated",
+			func(m *mockStore) {
+				m.slots = 81
+			},
+			[]string{TxPoolCheck},
+		},
+		{
+			"lagging node fails several checks",
+			func(m *mockStore) {
+				m.peers = 0
+				m.header = &types.Header{Number: 10, Timestamp: 0}
+			},
+			[]string{PeersCheck, HeadCheck},
+		},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := newStore()
+			testCase.modify(store)
+
+			checker := NewChecker(store, &Config{
+				MinPeers:    2,
+				MaxBlockAge: 30 * time.Second,
+			})
+			checker.now = func() time.Time {
+				return now
+			}
+
+			status := checker.Readiness()
+
+			assert.Equal(t, len(testCase.failed) == 0, status.Ready)
+			assert.Len(t, status.Checks, 4)
+
+			failed := []string(nil)
+
+			for _, check := range status.Checks {
+				if !check.Passed {
+					failed = append(failed, check.Name)
+				}
+			}
+
+			assert.Equal(t, testCase.failed, failed)
+		})
+	}
+}
+
+func TestChecker_HeadMaxAge(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+
+	testTable := []struct {
+		name          string
+		maxBlockAge   time.Duration
+		blockInterval time.Duration
+		age           uint64
+		passed        bool
+	}{
+		{
+			"default max age without the empty blocks skipped",
+			0,
+			0,
+			60,
+			true,
+		},
+		{
+			"head older than the default max age",
+			0,
+			0,
+			61,
+			false,
+		},
+		{
+			"idle head within the max empty block interval",
+			0,
+			5*time.Minute + 2*time.Second,
+			300,
+			true,
+		},
+		{
+			"idle head older than the max empty block interval",
+			0,
+			5*time.Minute + 2*time.Second,
+			303,
+			false,
+		},
+		{
+			"default max age for the interval shorter than it",
+			0,
+			10 * time.Second,
+			30,
+			true,
+		},
+		{
+			"configured max age overrides the interval",
+			30 * time.Second,
+			5 * time.Minute,
+			31,
+			false,
+		},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			checker := NewChecker(&mockStore{
+				header:        &types.Header{Number: 10, Timestamp: 1000 - testCase.age},
+				blockInterval: testCase.blockInterval,
+			}, &Config{
+				MaxBlockAge: testCase.maxBlockAge,
+			})
+			checker.now = func() time.Time {
+				return now
+			}
+
+			assert.Equal(t, testCase.passed, checker.checkHead().Passed)
+		})
+	}
+}
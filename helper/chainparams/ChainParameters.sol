@@ -0,0 +1,217 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.7;
+
+// ChainParameters is the system contract predeployed at 0x0000000000000000000000000000000000001002.
+// It keeps the chain parameters governed by the validators.
+//
+// The voters of a round vote for the value of a known parameter with vote(key, value).
+// At the end of every epoch the consensus counts the votes of the validator set,
+// writes the values having the quorum with set(key, value) and starts the next round
+// with the validators as the voters with startRound(voters), both as the system caller.
+// The number of the parameters voted in a round is limited by MAX_KEYS
+// so that the votes counted by the consensus are bounded, and a voter can't open a parameter
+// after voting for MAX_KEYS_PER_VOTER parameters so that a few voters can't take all the keys.
+// The votes are weighted by the voting powers of the voters, one per voter unless the consensus
+// sets them with setVotingPower(voter, power), e.g. to the stakes of the validators in PoS.
+//
+// The runtime code in chainparams.go is compiled from this source with solc 0.8.21,
+// the optimizer enabled with 200 runs and the london EVM version.
+// The storage is read by the nodes directly, the layout must not be changed.
+contract ChainParameters {
+    struct Value {
+        bool exists;
+        uint256 value;
+    }
+
+    struct Vote {
+        uint256 round;
+        uint256 value;
+    }
+
+    // SYSTEM_CALLER is the caller of the consensus
+    address private constant SYSTEM_CALLER = 0xffffFFFfFFffffffffffffffFfFFFfffFFFfFFfE;
+
+    // MAX_KEYS is the max number of the parameters voted in a round
+    uint256 public constant MAX_KEYS = 8;
+    // MAX_KEYS_PER_VOTER is the max number of the parameters a voter can have voted for when opening a parameter
+    uint256 public constant MAX_KEYS_PER_VOTER = 2;
+
+    // Keys of the parameters, the names are left aligned
+    bytes32 private constant BLOCK_GAS_TARGET = "blockGasTarget";
+    bytes32 private constant MIN_GAS_PRICE = "minGasPrice";
+    // The key of the address in the deployment whitelist is the prefix followed by the right aligned address
+    bytes12 private constant DEPLOYMENT_WHITELIST_PREFIX = "deployment";
+
+    // Properties
+    bytes32[] private _keys; // slot 0, the keys voted in the current round
+    mapping(bytes32 => uint256) private _keyRounds; // slot 1, the round the key was voted in
+    mapping(bytes32 => Value) private _values; // slot 2
+    mapping(bytes32 => mapping(address => Vote)) private _votes; // slot 3
+    mapping(address => bool) private _isVoter; // slot 4
+    address[] private _voters; // slot 5
+    address[] private _deploymentWhitelist; // slot 6
+    mapping(address => uint256) private _deploymentWhitelistIndexes; // slot 7, the index in the whitelist + 1
+    uint256 private _round; // slot 8, the number of the rounds finished
+    mapping(address => uint256) private _votingPowers; // slot 9
+
+    // Events
+    event Voted(bytes32 indexed key, address indexed voter, uint256 value);
+    event ParameterSet(bytes32 indexed key, uint256 value);
+    event RoundStarted(uint256 indexed round);
+
+    // Modifiers
+    modifier onlySystem() {
+        require(msg.sender == SYSTEM_CALLER, "Only system can call function");
+        _;
+    }
+
+    modifier onlyVoter() {
+        require(_isVoter[msg.sender], "Only voter can call function");
+        _;
+    }
+
+    // View functions
+    function get(bytes32 key) external view returns (bool exists, uint256 value) {
+        Value storage v = _values[key];
+
+        return (v.exists, v.value);
+    }
+
+    function getVote(bytes32 key, address voter) external view returns (bool voted, uint256 value) {
+        Vote storage v = _votes[key][voter];
+
+        if (v.round != _currentRound()) {
+            return (false, 0);
+        }
+
+        return (true, v.value);
+    }
+
+    function keyCount() external view returns (uint256) {
+        return _keys.length;
+    }
+
+    function keyAt(uint256 index) external view returns (bytes32) {
+        require(index < _keys.length, "index out of range");
+
+        return _keys[index];
+    }
+
+    function tally(bytes32 key, uint256 value) external view returns (uint256 power, uint256 total) {
+        uint256 round = _currentRound();
+
+        for (uint256 i = 0; i < _voters.length; i++) {
+            address voter = _voters[i];
+            uint256 votingPower = _votingPowers[voter];
+            Vote storage v = _votes[key][voter];
+
+            total += votingPower;
+
+            if (v.round == round && v.value == value) {
+                power += votingPower;
+            }
+        }
+    }
+
+    function voters() external view returns (address[] memory) {
+        return _voters;
+    }
+
+    function deploymentWhitelist() external view returns (address[] memory) {
+        return _deploymentWhitelist;
+    }
+
+    // Public functions
+    function vote(bytes32 key, uint256 value) external onlyVoter {
+        require(_isKnownKey(key), "Unknown parameter");
+
+        uint256 round = _currentRound();
+
+        if (_keyRounds[key] != round) {
+            require(_keys.length < MAX_KEYS, "Too many parameters voted in the round");
+            require(_votedKeys(msg.sender, round) < MAX_KEYS_PER_VOTER, "Too many parameters voted by the voter");
+
+            _keyRounds[key] = round;
+            _keys.push(key);
+        }
+
+        _votes[key][msg.sender] = Vote(round, value);
+
+        emit Voted(key, msg.sender, value);
+    }
+
+    function set(bytes32 key, uint256 value) external onlySystem {
+        require(_isKnownKey(key), "Unknown parameter");
+
+        _values[key] = Value(true, value);
+
+        if (_isDeploymentWhitelistKey(key)) {
+            _updateDeploymentWhitelist(address(uint160(uint256(key))), value == 1);
+        }
+
+        emit ParameterSet(key, value);
+    }
+
+    function setVotingPower(address voter, uint256 power) external onlySystem {
+        _votingPowers[voter] = power;
+    }
+
+    function startRound(address[] calldata newVoters) external onlySystem {
+        for (uint256 i = 0; i < _voters.length; i++) {
+            _isVoter[_voters[i]] = false;
+        }
+
+        delete _voters;
+        delete _keys;
+
+        for (uint256 i = 0; i < newVoters.length; i++) {
+            if (!_isVoter[newVoters[i]]) {
+                _isVoter[newVoters[i]] = true;
+                _voters.push(newVoters[i]);
+                _votingPowers[newVoters[i]] = 1;
+            }
+        }
+
+        _round++;
+
+        emit RoundStarted(_round);
+    }
+
+    // Private functions
+    function _currentRound() private view returns (uint256) {
+        return _round + 1;
+    }
+
+    function _votedKeys(address voter, uint256 round) private view returns (uint256 count) {
+        for (uint256 i = 0; i < _keys.length; i++) {
+            if (_votes[_keys[i]][voter].round == round) {
+                count++;
+            }
+        }
+    }
+
+    function _isKnownKey(bytes32 key) private pure returns (bool) {
+        return key == BLOCK_GAS_TARGET || key == MIN_GAS_PRICE || _isDeploymentWhitelistKey(key);
+    }
+
+    function _isDeploymentWhitelistKey(bytes32 key) private pure returns (bool) {
+        return bytes12(key) == DEPLOYMENT_WHITELIST_PREFIX;
+    }
+
+    function _updateDeploymentWhitelist(address addr, bool allowed) private {
+        uint256 index = _deploymentWhitelistIndexes[addr];
+
+        if (allowed && index == 0) {
+            _deploymentWhitelist.push(addr);
+            _deploymentWhitelistIndexes[addr] = _deploymentWhitelist.length;
+        } else if (!allowed && index != 0) {
+            // exchange element to remove and last element
+            address lastAddr = _deploymentWhitelist[_deploymentWhitelist.length - 1];
+            _deploymentWhitelist[index - 1] = lastAddr;
+            _deploymentWhitelistIndexes[lastAddr] = index;
+
+            _deploymentWhitelistIndexes[addr] = 0;
+            _deploymentWhitelist.pop();
+        }
+    }
+}
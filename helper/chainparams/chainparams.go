@@ -0,0 +1,115 @@
+package chainparams
+
+import (
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/helper/keccak"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/validators"
+)
+
+const (
+	// ChainParamsSCBytecode is the runtime code of ChainParameters.sol
+	//nolint: lll
+	ChainParamsSCBytecode = "0x608060405234801561001057600080fd5b50600436106100bf5760003560e01c80639ef1204c116100665780639ef1204c14610163578063b3e7c2bd14610176578063b5e68df214610189578063c470db1c1461019c578063fac750e0146101a457600080fd5b8063273f4940146100c4578063274b91a9146101ac578063350580ea146100d9578063409343f3146101e35780634223b5c2146100f757806367ca5dd0146101bf578063822dbd10146101185780638eaa6ac014610120575b600080fd5b6100d76100d2366004610cb0565b6101eb565b005b6100e161031e565b6040516100ee9190610cd2565b60405180910390f35b61010a610105366004610d1f565b610380565b6040519081526020016100ee565b6100e16103ec565b61014c61012e366004610d1f565b6000908152600260205260409020805460019091015460ff90911691565b6040805192151583526020830191909152016100ee565b6100d7610171366004610cb0565b61044c565b61014c610184366004610d54565b6106eb565b6100d7610197366004610da9565b61073f565b61010a600881565b60005461010a565b6100d76101ba366004610d80565b610990565b6101d26101cd366004610cb0565b6109fa565b604051908160200152908152604090f35b61010a600281565b336002600160a01b03146102465760405162461bcd60e51b815260206004820152601d60248201527f4f6e6c792073797374656d2063616e2063616c6c2066756e6374696f6e00000060448201526064015b60405180910390fd5b61024f82610a92565b61028f5760405162461bcd60e51b81526020600482015260116024820152702ab735b737bbb7103830b930b6b2ba32b960791b604482015260640161023d565b60408051808201825260018082526020808301858152600087815260029092529390209151825460ff191690151517825591519101556102ce82610ad5565b156102e0576102e08260018314610af1565b817ff93fd9b81abd6664e36978db24904a722ea8d841c0cc3563aec5e28ce6165a418260405161031291815260200190565b60405180910390a25050565b6060600580548060200260200160405190810160405280929190818152602001828054801561037657602002820191906000526020600020905b81546001600160a01b03168152600190910190602001808311610358575b5050505050905090565b6000805482106103c75760405162461bcd60e51b8152602060048201526012602482015271696e646578206f7574206f662072616e676560701b604482015260640161023d565b600082815481106103da576103da610e1e565b90600052602060002001549050919050565b60606006805480602002602001604051908101604052809291908181526020018280548015610376576020028201919060005260206000209081546001600160a01b03168152600190910190602001808311610358575050505050905090565b3360009081526004602052604090205460ff166104ab5760405162461bcd60e51b815260206004820152601c60248201527f4f6e6c7920766f7465722063616e2063616c6c2066756e6374696f6e00000000604482015260640161023d565b6104b482610a92565b6104f45760405162461bcd60e51b81526020600482015260116024820152702ab735b737bbb7103830b930b6b2ba32b960791b604482015260640161023d565b60006104fe610c60565b6000848152600160205260409020549091508114610674576000546008116105775760405162461bcd60e51b815260206004820152602660248201527f546f6f206d616e7920706172616d657465727320766f74656420696e20746865604482015265081c9bdd5b9960d21b606482015260840161023d565b600060005b6000548110156105d257807f290decd9548b62a8d60345a988386fc84ba6bc95484008f6362f93160ef3e5630154600052600360205260406000206020523360005260406000205483149091019060010161057c565b506002116106315760405162461bcd60e51b815260206004820152602660248201527f546f6f206d616e7920706172616d657465727320766f74656420627920746865604482015265103b37ba32b960d11b606482015260840161023d565b60008381526001602081905260408220839055815490810182559080527f290decd9548b62a8d60345a988386fc84ba6bc95484008f6362f93160ef3e563018390555b60408051808201825282815260208082018581526000878152600383528481203380835293528490209251835551600190920191909155905184907fe4abc5380fa6939d1dc23b5e90b3a8a0e328f0f1a82a5f42bfb795bf9c717505906106de9086815260200190565b60405180910390a3505050565b60008281526003602090815260408083206001600160a01b038516845290915281208190610717610c60565b81541461072b576000809250925050610738565b6001816001015492509250505b9250929050565b336002600160a01b03146107955760405162461bcd60e51b815260206004820152601d60248201527f4f6e6c792073797374656d2063616e2063616c6c2066756e6374696f6e000000604482015260640161023d565b60005b60055481101561080157600060046000600584815481106107bb576107bb610e1e565b6000918252602080832091909101546001600160a01b031683528201929092526040019020805460ff1916911515919091179055806107f981610e4a565b915050610798565b5061080e60056000610c76565b610819600080610c76565b60005b8181101561094a576004600084848481811061083a5761083a610e1e565b905060200201602081019061084f9190610e63565b6001600160a01b0316815260208101919091526040016000205460ff166109385760016004600085858581811061088857610888610e1e565b905060200201602081019061089d9190610e63565b6001600160a01b031681526020810191909152604001600020805460ff191691151591909117905560058383838181106108d9576108d9610e1e565b90506020020160208101906108ee9190610e63565b81546001810183556000928352602090922090910180546001600160a01b0319166001600160a01b0390921691909117905560018160051b84013560005260096020526040600020555b8061094281610e4a565b91505061081c565b506008805490600061095b83610e4a565b90915550506008546040517f33a701182892fd888ed152ca2ac23771a32e814469b7cd255965471e1af3a65990600090a25050565b336002600160a01b03146109e65760405162461bcd60e51b815260206004820152601d60248201527f4f6e6c792073797374656d2063616e2063616c6c2066756e6374696f6e000000604482015260640161023d565b816000526009602052806040600020555050565b60006000610a06610c60565b60005b600554811015610a8757807f036b6384b5eca791c62761152d0c79bb0604c104a5fb6f4eb0703f3154bb3db001546001600160a01b0316806000526009602052604060002054808501945087600052600360205260406000206020529060005260406000208054841490600101548714160284019350600101610a09565b505092509190509091565b60006d189b1bd8dad1d85cd5185c99d95d60921b821480610ac057506a6d696e476173507269636560a81b82145b80610acf5750610acf82610ad5565b92915050565b6001600160a01b0319166919195c1b1bde5b595b9d60b21b1490565b6001600160a01b038216600090815260076020526040902054818015610b15575080155b15610b7957600680546001810182557ff652222313e28459528d920b65115c16c04f3efc82aaedc97be59f3f377c0d3f0180546001600160a01b0319166001600160a01b038616908117909155905460009182526007602052604090912055505050565b81158015610b8657508015155b15610c5b576006805460009190610b9f90600190610e85565b81548110610baf57610baf610e1e565b6000918252602090912001546001600160a01b03169050806006610bd4600185610e85565b81548110610be457610be4610e1e565b600091825260208083209190910180546001600160a01b0319166001600160a01b0394851617905583831682526007905260408082208590559186168152908120556006805480610c3757610c37610e98565b600082815260209020810160001990810180546001600160a01b0319169055019055505b505050565b60006008546001610c719190610eae565b905090565b5080546000825590600052602060002090810190610c949190610c97565b50565b5b80821115610cac5760008155600101610c98565b5090565b60008060408385031215610cc357600080fd5b50508035926020909101359150565b6020808252825182820181905260009190848201906040850190845b81811015610d135783516001600160a01b031683529284019291840191600101610cee565b50909695505050505050565b600060208284031215610d3157600080fd5b5035919050565b80356001600160a01b0381168114610d4f57600080fd5b919050565b60008060408385031215610d6757600080fd5b82359150610d7760208401610d38565b90509250929050565b60008060408385031215610d9357600080fd5b5050610d9e81610d38565b926020909101359150565b60008060208385031215610dbc57600080fd5b823567ffffffffffffffff80821115610dd457600080fd5b818501915085601f830112610de857600080fd5b813581811115610df757600080fd5b8660208260051b8501011115610e0c57600080fd5b60209290920196919550909350505050565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052601160045260246000fd5b600060018201610e5c57610e5c610e34565b5060010190565b600060208284031215610e7557600080fd5b610e7e82610d38565b9392505050565b81810381811115610acf57610acf610e34565b634e487b7160e01b600052603160045260246000fd5b80820180821115610acf57610acf610e3456fea2646970667358221220cc990e197118b8523bc268be14ff093dfcfa00c57aa5d3dbb2af5a57fe0e49c564736f6c63430008150033"
+)
+
+// Slot definitions for SC storage, the storage is read by the nodes directly
+var (
+	valuesSlot                     = int64(2) // Slot 2
+	isVoterSlot                    = int64(4) // Slot 4
+	votersSlot                     = int64(5) // Slot 5
+	deploymentWhitelistSlot        = int64(6) // Slot 6
+	deploymentWhitelistIndexesSlot = int64(7) // Slot 7
+	votingPowersSlot               = int64(9) // Slot 9
+)
+
+// getMapping returns the key for the SC storage mapping (key => something)
+//
+// More information:
+// https://docs.soliditylang.org/en/latest/internals/layout_in_storage.html
+func getMapping(key []byte, slot int64) types.Hash {
+	return types.BytesToHash(keccak.Keccak256(nil, append(
+		common.PadLeftOrTrim(key, 32),
+		common.PadLeftOrTrim(big.NewInt(slot).Bytes(), 32)...,
+	)))
+}
+
+// getArrayElement returns the key for the element of the SC storage dynamic array,
+// which is keccak(slot) + index
+func getArrayElement(slot int64, index uint64) types.Hash {
+	base := new(big.Int).SetBytes(keccak.Keccak256(nil, common.PadLeftOrTrim(big.NewInt(slot).Bytes(), 32)))
+
+	return types.BytesToHash(base.Add(base, new(big.Int).SetUint64(index)).Bytes())
+}
+
+// slotKey returns the key of the SC storage slot
+func slotKey(slot int64) types.Hash {
+	return types.BytesToHash(big.NewInt(slot).Bytes())
+}
+
+// ParameterStorageKeys returns the storage keys of the flag whether the parameter has been set
+// and of the value of the parameter
+func ParameterStorageKeys(key types.Hash) (types.Hash, types.Hash) {
+	existsKey := getMapping(key.Bytes(), valuesSlot)
+	valueKey := types.BytesToHash(new(big.Int).Add(existsKey.Big(), big.NewInt(1)).Bytes())
+
+	return existsKey, valueKey
+}
+
+// DeploymentWhitelistSizeStorageKey returns the storage key of the number of the addresses in the deployment whitelist
+func DeploymentWhitelistSizeStorageKey() types.Hash {
+	return slotKey(deploymentWhitelistSlot)
+}
+
+// DeploymentWhitelistStorageKey returns the storage key of the address at the index in the deployment whitelist
+func DeploymentWhitelistStorageKey(index uint64) types.Hash {
+	return getArrayElement(deploymentWhitelistSlot, index)
+}
+
+// DeploymentWhitelistIndexStorageKey returns the storage key of the index of the address in the deployment whitelist,
+// the value is the index + 1 and zero if the address is not in the whitelist
+func DeploymentWhitelistIndexStorageKey(addr types.Address) types.Hash {
+	return getMapping(addr.Bytes(), deploymentWhitelistIndexesSlot)
+}
+
+// PredeployChainParamsSC is a helper method for setting up the chain parameters smart contract account,
+// using the passed in validators as the voters of the first round.
+// No parameter is set in the contract, the values in the genesis are used until the validators vote
+func PredeployChainParamsSC(vals validators.Validators) (*chain.GenesisAccount, error) {
+	code, err := hex.DecodeHex(ChainParamsSCBytecode)
+	if err != nil {
+		return nil, err
+	}
+
+	storageMap := make(map[types.Hash]types.Hash)
+	numVoters := 0
+
+	if vals != nil {
+		numVoters = vals.Len()
+
+		for idx := 0; idx < vals.Len(); idx++ {
+			addr := vals.At(uint64(idx)).Addr()
+
+			// Set the value for the voters array
+			storageMap[getArrayElement(votersSlot, uint64(idx))] = types.BytesToHash(addr.Bytes())
+
+			// Set the value for the address -> is voter mapping
+			storageMap[getMapping(addr.Bytes(), isVoterSlot)] = types.BytesToHash(big.NewInt(1).Bytes())
+
+			// Set the value for the address -> voting power mapping, one per voter until set by the consensus
+			storageMap[getMapping(addr.Bytes(), votingPowersSlot)] = types.BytesToHash(big.NewInt(1).Bytes())
+		}
+	}
+
+	// Set the value for the size of the voters array
+	storageMap[slotKey(votersSlot)] = types.BytesToHash(big.NewInt(int64(numVoters)).Bytes())
+
+	return &chain.GenesisAccount{
+		Code:    code,
+		Storage: storageMap,
+	}, nil
+}
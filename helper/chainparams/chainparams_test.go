@@ -0,0 +1,13 @@
+package chainparams
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/helper/tests"
+)
+
+func TestChainParamsSCBytecode(t *testing.T) {
+	t.Parallel()
+
+	tests.AssertContractBytecode(t, "ChainParameters.sol", "ChainParameters", ChainParamsSCBytecode)
+}
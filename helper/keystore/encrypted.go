@@ -0,0 +1,242 @@
+package keystore
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/0xPolygon/polygon-edge/helper/keccak"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// StandardScryptN is the N parameter of Scrypt encryption algorithm, using 256MB
+	// memory and taking approximately 1s CPU time on a modern processor.
+	StandardScryptN = 1 << 18
+
+	// StandardScryptP is the P parameter of Scrypt encryption algorithm, using 256MB
+	// memory and taking approximately 1s CPU time on a modern processor.
+	StandardScryptP = 1
+
+	// LightScryptN is the N parameter of Scrypt encryption algorithm, using 4MB
+	// memory and taking approximately 100ms CPU time on a modern processor.
+	LightScryptN = 1 << 12
+
+	// LightScryptP is the P parameter of Scrypt encryption algorithm, using 4MB
+	// memory and taking approximately 100ms CPU time on a modern processor.
+	LightScryptP = 6
+)
+
+const (
+	keyStoreVersion = 3
+
+	scryptR     = 8
+	scryptDKLen = 32
+
+	kdfScrypt = "scrypt"
+	kdfPBKDF2 = "pbkdf2"
+
+	cipherAES128CTR = "aes-128-ctr"
+)
+
+var (
+	ErrDecrypt            = errors.New("could not decrypt key with given password")
+	ErrInvalidKeyStore    = errors.New("invalid keystore file")
+	ErrUnsupportedVersion = errors.New("unsupported keystore version")
+)
+
+// encryptedKeyJSONV3 is the geth-compatible (Web3 Secret Storage Definition V3)
+// representation of an encrypted private key
+type encryptedKeyJSONV3 struct {
+	Address string     `json:"address,omitempty"`
+	Crypto  cryptoJSON `json:"crypto"`
+	ID      string     `json:"id"`
+	Version int        `json:"version"`
+}
+
+type cryptoJSON struct {
+	Cipher       string                 `json:"cipher"`
+	CipherText   string                 `json:"ciphertext"`
+	CipherParams cipherParamsJSON       `json:"cipherparams"`
+	KDF          string                 `json:"kdf"`
+	KDFParams    map[string]interface{} `json:"kdfparams"`
+	MAC          string                 `json:"mac"`
+}
+
+type cipherParamsJSON struct {
+	IV string `json:"iv"`
+}
+
+// IsEncryptedKey checks if the given key content is a JSON keystore
+// rather than a plain hex-encoded key
+func IsEncryptedKey(content []byte) bool {
+	content = bytes.TrimSpace(content)
+
+	return len(content) > 0 && content[0] == '{'
+}
+
+// EncryptKey encrypts the raw key using the password into the V3 keystore JSON format.
+// The address is optional and is only included in the output if it's not empty
+func EncryptKey(key []byte, address string, password string, scryptN, scryptP int) ([]byte, error) {
+	salt := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, fmt.Errorf("unable to generate salt, %w", err)
+	}
+
+	derivedKey, err := scrypt.Key([]byte(password), salt, scryptN, scryptR, scryptP, scryptDKLen)
+	if err != nil {
+		return nil, err
+	}
+
+	iv := make([]byte, aes.BlockSize)
+	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+		return nil, fmt.Errorf("unable to generate iv, %w", err)
+	}
+
+	cipherText, err := aesCTRXOR(derivedKey[:16], key, iv)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&encryptedKeyJSONV3{
+		Address: address,
+		Crypto: cryptoJSON{
+			Cipher:     cipherAES128CTR,
+			CipherText: hex.EncodeToString(cipherText),
+			CipherParams: cipherParamsJSON{
+				IV: hex.EncodeToString(iv),
+			},
+			KDF: kdfScrypt,
+			KDFParams: map[string]interface{}{
+				"n":     scryptN,
+				"r":     scryptR,
+				"p":     scryptP,
+				"dklen": scryptDKLen,
+				"salt":  hex.EncodeToString(salt),
+			},
+			MAC: hex.EncodeToString(calculateMAC(derivedKey, cipherText)),
+		},
+		ID:      id.String(),
+		Version: keyStoreVersion,
+	})
+}
+
+// DecryptKey decrypts the V3 keystore JSON using the password and returns the raw key
+func DecryptKey(keyJSON []byte, password string) ([]byte, error) {
+	encryptedKey := &encryptedKeyJSONV3{}
+	if err := json.Unmarshal(keyJSON, encryptedKey); err != nil {
+		return nil, fmt.Errorf("%w, %v", ErrInvalidKeyStore, err)
+	}
+
+	if encryptedKey.Version != keyStoreVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, encryptedKey.Version)
+	}
+
+	if encryptedKey.Crypto.Cipher != cipherAES128CTR {
+		return nil, fmt.Errorf("%w: unsupported cipher %s", ErrInvalidKeyStore, encryptedKey.Crypto.Cipher)
+	}
+
+	mac, err := hex.DecodeString(encryptedKey.Crypto.MAC)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid mac, %v", ErrInvalidKeyStore, err)
+	}
+
+	iv, err := hex.DecodeString(encryptedKey.Crypto.CipherParams.IV)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid iv, %v", ErrInvalidKeyStore, err)
+	}
+
+	cipherText, err := hex.DecodeString(encryptedKey.Crypto.CipherText)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid ciphertext, %v", ErrInvalidKeyStore, err)
+	}
+
+	derivedKey, err := deriveKey(&encryptedKey.Crypto, password)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(calculateMAC(derivedKey, cipherText), mac) {
+		return nil, ErrDecrypt
+	}
+
+	return aesCTRXOR(derivedKey[:16], cipherText, iv)
+}
+
+// deriveKey derives the decryption key from the password using the keystore KDF
+func deriveKey(cryptoJSON *cryptoJSON, password string) ([]byte, error) {
+	salt, err := hex.DecodeString(getKDFParamString(cryptoJSON.KDFParams, "salt"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid salt, %v", ErrInvalidKeyStore, err)
+	}
+
+	dkLen := getKDFParamInt(cryptoJSON.KDFParams, "dklen")
+
+	switch cryptoJSON.KDF {
+	case kdfScrypt:
+		n := getKDFParamInt(cryptoJSON.KDFParams, "n")
+		r := getKDFParamInt(cryptoJSON.KDFParams, "r")
+		p := getKDFParamInt(cryptoJSON.KDFParams, "p")
+
+		return scrypt.Key([]byte(password), salt, n, r, p, dkLen)
+	case kdfPBKDF2:
+		if prf := getKDFParamString(cryptoJSON.KDFParams, "prf"); prf != "hmac-sha256" {
+			return nil, fmt.Errorf("%w: unsupported PBKDF2 PRF %s", ErrInvalidKeyStore, prf)
+		}
+
+		c := getKDFParamInt(cryptoJSON.KDFParams, "c")
+
+		return pbkdf2.Key([]byte(password), salt, c, dkLen, sha256.New), nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported KDF %s", ErrInvalidKeyStore, cryptoJSON.KDF)
+	}
+}
+
+func getKDFParamInt(params map[string]interface{}, name string) int {
+	// JSON numbers are always unmarshalled into float64
+	value, _ := params[name].(float64)
+
+	return int(value)
+}
+
+func getKDFParamString(params map[string]interface{}, name string) string {
+	value, _ := params[name].(string)
+
+	return value
+}
+
+// calculateMAC returns Keccak256(DK[16:32] ++ ciphertext)
+func calculateMAC(derivedKey, cipherText []byte) []byte {
+	hash := keccak.NewKeccak256()
+
+	_, _ = hash.Write(derivedKey[16:32])
+	_, _ = hash.Write(cipherText)
+
+	return hash.Sum(nil)
+}
+
+func aesCTRXOR(key, input, iv []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]byte, len(input))
+	cipher.NewCTR(block, iv).XORKeyStream(output, input)
+
+	return output, nil
+}
@@ -0,0 +1,133 @@
+package keystore
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testVectorPassword = "testpassword"
+	testVectorKey      = "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d"
+)
+
+// gethKeyJSON is the scrypt test vector from the Web3 Secret Storage Definition
+const gethKeyJSON = `{
+	"crypto": {
+		"cipher": "aes-128-ctr",
+		"cipherparams": {"iv": "83dbcc02d8ccb40e466191a123791e0e"},
+		"ciphertext": "d172bf743a674da9cdad04534d56926ef8358534d458fffccd4e6ad2fbde479c",
+		"kdf": "scrypt",
+		"kdfparams": {
+			"dklen": 32,
+			"n": 262144,
+			"p": 8,
+			"r": 1,
+			"salt": "ab0c7876052600dd703518d6fc3fe8984592145b591fc8fb5c6d43190334ba19"
+		},
+		"mac": "2103ac29920d71da29f15d75b4a16dbe95cfd7ff8faea1056c33131d846e3097"
+	},
+	"id": "3198bc9c-6672-5ab3-d995-4942343ae5b6",
+	"version": 3
+}`
+
+// gethPBKDF2KeyJSON is the PBKDF2 test vector from the Web3 Secret Storage Definition
+const gethPBKDF2KeyJSON = `{
+	"crypto": {
+		"cipher": "aes-128-ctr",
+		"cipherparams": {"iv": "6087dab2f9fdbbfaddc31a909735c1e6"},
+		"ciphertext": "5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46",
+		"kdf": "pbkdf2",
+		"kdfparams": {
+			"c": 262144,
+			"dklen": 32,
+			"prf": "hmac-sha256",
+			"salt": "ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"
+		},
+		"mac": "517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"
+	},
+	"id": "3198bc9c-6672-5ab3-d995-4942343ae5b6",
+	"version": 3
+}`
+
+func TestEncryptDecryptKey(t *testing.T) {
+	t.Parallel()
+
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+
+	keyJSON, err := EncryptKey(key, "", "password", LightScryptN, LightScryptP)
+	require.NoError(t, err)
+
+	assert.True(t, IsEncryptedKey(keyJSON))
+
+	decrypted, err := DecryptKey(keyJSON, "password")
+	require.NoError(t, err)
+	assert.Equal(t, key, decrypted)
+
+	_, err = DecryptKey(keyJSON, "wrong password")
+	assert.ErrorIs(t, err, ErrDecrypt)
+}
+
+func TestEncryptKey_Format(t *testing.T) {
+	t.Parallel()
+
+	keyJSON, err := EncryptKey([]byte{1, 2, 3}, "00aa", "password", LightScryptN, LightScryptP)
+	require.NoError(t, err)
+
+	var encrypted map[string]interface{}
+
+	require.NoError(t, json.Unmarshal(keyJSON, &encrypted))
+
+	assert.Equal(t, "00aa", encrypted["address"])
+	assert.Equal(t, float64(3), encrypted["version"])
+
+	cryptoSection, ok := encrypted["crypto"].(map[string]interface{})
+	require.True(t, ok)
+
+	assert.Equal(t, "aes-128-ctr", cryptoSection["cipher"])
+	assert.Equal(t, "scrypt", cryptoSection["kdf"])
+}
+
+func TestDecryptKey_TestVectors(t *testing.T) {
+	t.Parallel()
+
+	for name, keyJSON := range map[string]string{
+		"scrypt": gethKeyJSON,
+		"pbkdf2": gethPBKDF2KeyJSON,
+	} {
+		keyJSON := keyJSON
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			key, err := DecryptKey([]byte(keyJSON), testVectorPassword)
+			require.NoError(t, err)
+
+			assert.Equal(t, testVectorKey, hex.EncodeToString(key))
+		})
+	}
+}
+
+func TestDecryptKey_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := DecryptKey([]byte("not a keystore"), "foo")
+	assert.ErrorIs(t, err, ErrInvalidKeyStore)
+
+	_, err = DecryptKey([]byte(`{"version": 1}`), "foo")
+	assert.ErrorIs(t, err, ErrUnsupportedVersion)
+}
+
+func TestIsEncryptedKey(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, IsEncryptedKey([]byte(gethKeyJSON)))
+	assert.False(t, IsEncryptedKey([]byte(testVectorKey)))
+	assert.False(t, IsEncryptedKey(nil))
+}
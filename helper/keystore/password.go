@@ -0,0 +1,104 @@
+package keystore
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// PasswordEnvVar is the environment variable the keystore password is read from
+// if no password file is specified
+const PasswordEnvVar = "EDGE_KEYSTORE_PASSWORD"
+
+var (
+	ErrEmptyPassword      = errors.New("keystore password is empty")
+	ErrPasswordMismatch   = errors.New("keystore passwords do not match")
+	ErrPasswordNotDefined = errors.New(
+		"keystore password is required, but no password file, " +
+			PasswordEnvVar + " variable or interactive terminal is available",
+	)
+)
+
+// ReadPassword returns the keystore password, looking it up in the following order:
+// the first line of the password file (if specified), the PasswordEnvVar environment variable,
+// and finally an interactive prompt if the standard input is a terminal.
+// If confirm is set, the interactive prompt asks for the password twice
+func ReadPassword(passwordFile string, confirm bool) (string, error) {
+	if passwordFile != "" {
+		return readPasswordFile(passwordFile)
+	}
+
+	if password, ok := os.LookupEnv(PasswordEnvVar); ok {
+		if password == "" {
+			return "", ErrEmptyPassword
+		}
+
+		return password, nil
+	}
+
+	return promptPassword(confirm)
+}
+
+// readPasswordFile reads the password from the first line of the given file
+func readPasswordFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read password file (%s), %w", path, err)
+	}
+
+	password := strings.TrimRight(strings.SplitN(string(content), "\n", 2)[0], "\r")
+	if password == "" {
+		return "", ErrEmptyPassword
+	}
+
+	return password, nil
+}
+
+// promptPassword reads the password from the terminal without echoing it
+func promptPassword(confirm bool) (string, error) {
+	stdin := int(os.Stdin.Fd())
+	if !term.IsTerminal(stdin) {
+		return "", ErrPasswordNotDefined
+	}
+
+	password, err := readTerminalPassword(stdin, "Keystore password: ")
+	if err != nil {
+		return "", err
+	}
+
+	if !confirm {
+		return password, nil
+	}
+
+	repeated, err := readTerminalPassword(stdin, "Repeat keystore password: ")
+	if err != nil {
+		return "", err
+	}
+
+	if password != repeated {
+		return "", ErrPasswordMismatch
+	}
+
+	return password, nil
+}
+
+func readTerminalPassword(fd int, prompt string) (string, error) {
+	fmt.Fprint(os.Stderr, prompt)
+
+	password, err := term.ReadPassword(fd)
+
+	fmt.Fprintln(os.Stderr)
+
+	if err != nil {
+		return "", fmt.Errorf("unable to read password, %w", err)
+	}
+
+	if len(password) == 0 {
+		return "", ErrEmptyPassword
+	}
+
+	return string(password), nil
+}
@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.7;
+
+// Staking is the PoS staking contract predeployed at 0x0000000000000000000000000000000000001001.
+// It's based on the contract of https://github.com/0xPolygon/staking-contracts and keeps its storage layout,
+// the consensus additionally jails the offline validators with jail(address) as the system caller.
+//
+// StakingSCJailBytecode in staking.go is compiled from this source with solc 0.8.21,
+// the optimizer enabled with 200 runs and the london EVM version (make contracts).
+contract Staking {
+    // Parameters
+    uint128 public constant VALIDATOR_THRESHOLD = 1 ether;
+
+    // SYSTEM_CALLER is the caller of the consensus
+    address private constant SYSTEM_CALLER = 0xffffFFFfFFffffffffffffffFfFFFfffFFFfFFfE;
+
+    // Properties
+    address[] public _validators;
+    mapping(address => bool) public _addressToIsValidator;
+    mapping(address => uint256) public _addressToStakedAmount;
+    mapping(address => uint256) public _addressToValidatorIndex;
+    uint256 public _stakedAmount;
+    uint256 public _minimumNumValidators;
+    uint256 public _maximumNumValidators;
+    mapping(address => bytes) public _addressToBLSPublicKey;
+
+    // Events
+    event Staked(address indexed account, uint256 amount);
+    event Unstaked(address indexed account, uint256 amount);
+
+    // Modifiers
+    modifier onlyEOA() {
+        require(msg.sender.code.length == 0, "Only EOA can call function");
+        _;
+    }
+
+    modifier onlyStaker() {
+        require(_addressToStakedAmount[msg.sender] > 0, "Only staker can call function");
+        _;
+    }
+
+    modifier onlySystem() {
+        require(msg.sender == SYSTEM_CALLER, "Only system can call function");
+        _;
+    }
+
+    constructor(uint256 minNumValidators, uint256 maxNumValidators) {
+        require(
+            minNumValidators <= maxNumValidators,
+            "Min validators num can not be greater than max num of validators"
+        );
+
+        _minimumNumValidators = minNumValidators;
+        _maximumNumValidators = maxNumValidators;
+    }
+
+    // View functions
+    function stakedAmount() public view returns (uint256) {
+        return _stakedAmount;
+    }
+
+    function validators() public view returns (address[] memory) {
+        return _validators;
+    }
+
+    function validatorBLSPublicKeys() public view returns (bytes[] memory) {
+        bytes[] memory keys = new bytes[](_validators.length);
+
+        for (uint256 i = 0; i < _validators.length; i++) {
+            keys[i] = _addressToBLSPublicKey[_validators[i]];
+        }
+
+        return keys;
+    }
+
+    function isValidator(address addr) public view returns (bool) {
+        return _addressToIsValidator[addr];
+    }
+
+    function accountStake(address addr) public view returns (uint256) {
+        return _addressToStakedAmount[addr];
+    }
+
+    function minimumNumValidators() public view returns (uint256) {
+        return _minimumNumValidators;
+    }
+
+    function maximumNumValidators() public view returns (uint256) {
+        return _maximumNumValidators;
+    }
+
+    // Public functions
+    receive() external payable onlyEOA {
+        _stake();
+    }
+
+    function stake() public payable onlyEOA {
+        _stake();
+    }
+
+    function unstake() public onlyEOA onlyStaker {
+        _unstake(msg.sender);
+    }
+
+    function registerBLSPublicKey(bytes memory blsPubKey) public {
+        _addressToBLSPublicKey[msg.sender] = blsPubKey;
+    }
+
+    // jail unstakes the whole stake of the validator missing too many committed seals,
+    // the stake is returned to the validator
+    function jail(address validator) public onlySystem {
+        require(_addressToStakedAmount[validator] > 0, "Only staker can be jailed");
+
+        _unstake(validator);
+    }
+
+    // Private functions
+    function _stake() private {
+        _stakedAmount += msg.value;
+        _addressToStakedAmount[msg.sender] += msg.value;
+
+        if (_canBecomeValidator(msg.sender)) {
+            _appendToValidatorSet(msg.sender);
+        }
+
+        emit Staked(msg.sender, msg.value);
+    }
+
+    function _unstake(address staker) private {
+        uint256 amount = _addressToStakedAmount[staker];
+
+        _addressToStakedAmount[staker] = 0;
+        _stakedAmount -= amount;
+
+        if (_isValidator(staker)) {
+            _deleteFromValidators(staker);
+        }
+
+        payable(staker).transfer(amount);
+        emit Unstaked(staker, amount);
+    }
+
+    function _deleteFromValidators(address staker) private {
+        require(
+            _validators.length > _minimumNumValidators,
+            "Validators can't be less than the minimum required validator num"
+        );
+
+        require(_addressToValidatorIndex[staker] < _validators.length, "index out of range");
+
+        // index of removed address
+        uint256 index = _addressToValidatorIndex[staker];
+        uint256 lastIndex = _validators.length - 1;
+
+        if (index != lastIndex) {
+            // exchange element to remove and last element
+            address lastAddr = _validators[lastIndex];
+            _validators[index] = lastAddr;
+            _addressToValidatorIndex[lastAddr] = index;
+        }
+
+        _addressToIsValidator[staker] = false;
+        _addressToValidatorIndex[staker] = 0;
+        _validators.pop();
+    }
+
+    function _appendToValidatorSet(address newValidator) private {
+        require(_validators.length < _maximumNumValidators, "Validator set has reached full capacity");
+
+        _addressToIsValidator[newValidator] = true;
+        _addressToValidatorIndex[newValidator] = _validators.length;
+        _validators.push(newValidator);
+    }
+
+    function _isValidator(address account) private view returns (bool) {
+        return _addressToIsValidator[account];
+    }
+
+    function _canBecomeValidator(address account) private view returns (bool) {
+        return !_isValidator(account) && _addressToStakedAmount[account] >= VALIDATOR_THRESHOLD;
+    }
+}
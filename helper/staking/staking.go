@@ -132,6 +132,8 @@ func setBytesToStorage(
 type PredeployParams struct {
 	MinValidatorCount uint64
 	MaxValidatorCount uint64
+	// Jailing deploys the staking contract with the jail method
+	Jailing bool
 }
 
 // StorageIndexes is a wrapper for different storage indexes that
@@ -158,10 +160,26 @@ var (
 
 const (
 	DefaultStakedBalance = "0x8AC7230489E80000" // 10 ETH
+	// StakingSCBytecode is the runtime code of the staking contract
+	// retrieved from https://github.com/0xPolygon/staking-contracts
 	//nolint: lll
 	StakingSCBytecode = "0x6080604052600436106101185760003560e01c80637a6eea37116100a0578063d94c111b11610064578063d94c111b1461040a578063e387a7ed14610433578063e804fbf61461045e578063f90ecacc14610489578063facd743b146104c657610186565b80637a6eea37146103215780637dceceb81461034c578063af6da36e14610389578063c795c077146103b4578063ca1e7819146103df57610186565b8063373d6132116100e7578063373d6132146102595780633a4b66f1146102845780633c561f041461028e57806351a9ab32146102b9578063714ff425146102f657610186565b806302b751991461018b578063065ae171146101c85780632367f6b5146102055780632def66201461024257610186565b366101865761013c3373ffffffffffffffffffffffffffffffffffffffff16610503565b1561017c576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016101739061178a565b60405180910390fd5b610184610516565b005b600080fd5b34801561019757600080fd5b506101b260048036038101906101ad9190611380565b6105ed565b6040516101bf91906117e5565b60405180910390f35b3480156101d457600080fd5b506101ef60048036038101906101ea9190611380565b610605565b6040516101fc91906116ed565b60405180910390f35b34801561021157600080fd5b5061022c60048036038101906102279190611380565b610625565b60405161023991906117e5565b60405180910390f35b34801561024e57600080fd5b5061025761066e565b005b34801561026557600080fd5b5061026e610759565b60405161027b91906117e5565b60405180910390f35b61028c610763565b005b34801561029a57600080fd5b506102a36107cc565b6040516102b091906116cb565b60405180910390f35b3480156102c557600080fd5b506102e060048036038101906102db9190611380565b610972565b6040516102ed9190611708565b60405180910390f35b34801561030257600080fd5b5061030b610a12565b60405161031891906117e5565b60405180910390f35b34801561032d57600080fd5b50610336610a1c565b60405161034391906117ca565b60405180910390f35b34801561035857600080fd5b50610373600480360381019061036e9190611380565b610a28565b60405161038091906117e5565b60405180910390f35b34801561039557600080fd5b5061039e610a40565b6040516103ab91906117e5565b60405180910390f35b3480156103c057600080fd5b506103c9610a46565b6040516103d691906117e5565b60405180910390f35b3480156103eb57600080fd5b506103f4610a4c565b60405161040191906116a9565b60405180910390f35b34801561041657600080fd5b50610431600480360381019061042c91906113ad565b610ada565b005b34801561043f57600080fd5b50610448610b31565b60405161045591906117e5565b60405180910390f35b34801561046a57600080fd5b50610473610b37565b60405161048091906117e5565b60405180910390f35b34801561049557600080fd5b506104b060048036038101906104ab91906113f6565b610b41565b6040516104bd919061168e565b60405180910390f35b3480156104d257600080fd5b506104ed60048036038101906104e89190611380565b610b80565b6040516104fa91906116ed565b60405180910390f35b600080823b905060008111915050919050565b34600460008282546105289190611906565b9250508190555034600260003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020600082825461057e9190611906565b9250508190555061058e33610bd6565b1561059d5761059c33610c4e565b5b3373ffffffffffffffffffffffffffffffffffffffff167f9e71bc8eea02a63969f509818f2dafb9254532904319f9dbda79b67bd34a5f3d346040516105e391906117e5565b60405180910390a2565b60036020528060005260406000206000915090505481565b60016020528060005260406000206000915054906101000a900460ff1681565b6000600260008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020549050919050565b61068d3373ffffffffffffffffffffffffffffffffffffffff16610503565b156106cd576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016106c49061178a565b60405180910390fd5b6000600260003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020541161074f576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016107469061172a565b60405180910390fd5b610757610d9d565b565b6000600454905090565b6107823373ffffffffffffffffffffffffffffffffffffffff16610503565b156107c2576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016107b99061178a565b60405180910390fd5b6107ca610516565b565b60606000808054905067ffffffffffffffff8111156107ee576107ed611b9e565b5b60405190808252806020026020018201604052801561082157816020015b606081526020019060019003908161080c5790505b50905060005b60008054905081101561096a576007600080838154811061084b5761084a611b6f565b5b9060005260206000200160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002080546108bb90611a36565b80601f01602080910402602001604051908101604052809291908181526020018280546108e790611a36565b80156109345780601f1061090957610100808354040283529160200191610934565b820191906000526020600020905b81548152906001019060200180831161091757829003601f168201915b505050505082828151811061094c5761094b611b6f565b5b6020026020010181905250808061096290611a99565b915050610827565b508091505090565b6007602052806000526040600020600091509050805461099190611a36565b80601f01602080910402602001604051908101604052809291908181526020018280546109bd90611a36565b8015610a0a5780601f106109df57610100808354040283529160200191610a0a565b820191906000526020600020905b8154815290600101906020018083116109ed57829003601f168201915b505050505081565b6000600554905090565b670de0b6b3a764000081565b60026020528060005260406000206000915090505481565b60065481565b60055481565b60606000805480602002602001604051908101604052809291908181526020018280548015610ad057602002820191906000526020600020905b8160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019060010190808311610a86575b5050505050905090565b80600760003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000209080519060200190610b2d929190611243565b5050565b60045481565b6000600654905090565b60008181548110610b5157600080fd5b906000526020600020016000915054906101000a900473ffffffffffffffffffffffffffffffffffffffff1681565b6000600160008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060009054906101000a900460ff169050919050565b6000610be182610eef565b158015610c475750670de0b6b3a76400006fffffffffffffffffffffffffffffffff16600260008473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000205410155b9050919050565b60065460008054905010610c97576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610c8e9061174a565b60405180910390fd5b60018060008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060006101000a81548160ff021916908315150217905550600080549050600360008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020819055506000819080600181540180825580915050600190039060005260206000200160009091909190916101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff16021790555050565b6000600260003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000205490506000600260003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020819055508060046000828254610e38919061195c565b92505081905550610e4833610eef565b15610e5757610e5633610f45565b5b3373ffffffffffffffffffffffffffffffffffffffff166108fc829081150290604051600060405180830381858888f19350505050158015610e9d573d6000803e3d6000fd5b503373ffffffffffffffffffffffffffffffffffffffff167f0f5bb82176feb1b5e747e28471aa92156a04d9f3ab9f45f28e2d704232b93f7582604051610ee491906117e5565b60405180910390a250565b6000600160008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060009054906101000a900460ff169050919050565b60055460008054905011610f8e576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610f85906117aa565b60405180910390fd5b600080549050600360008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000205410611014576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161100b9061176a565b60405180910390fd5b6000600360008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000205490506000600160008054905061106c919061195c565b905080821461115a57600080828154811061108a57611089611b6f565b5b9060005260206000200160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff16905080600084815481106110cc576110cb611b6f565b5b9060005260206000200160006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff16021790555082600360008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002081905550505b6000600160008573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060006101000a81548160ff0219169083151502179055506000600360008573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002081905550600080548061120957611208611b40565b5b6001900381819060005260206000200160006101000a81549073ffffffffffffffffffffffffffffffffffffffff02191690559055505050565b82805461124f90611a36565b90600052602060002090601f01602090048101928261127157600085556112b8565b82601f1061128a57805160ff19168380011785556112b8565b828001600101855582156112b8579182015b828111156112b757825182559160200191906001019061129c565b5b5090506112c591906112c9565b5090565b5b808211156112e25760008160009055506001016112ca565b5090565b60006112f96112f484611825565b611800565b90508281526020810184848401111561131557611314611bd2565b5b6113208482856119f4565b509392505050565b60008135905061133781611d0b565b92915050565b600082601f83011261135257611351611bcd565b5b81356113628482602086016112e6565b91505092915050565b60008135905061137a81611d22565b92915050565b60006020828403121561139657611395611bdc565b5b60006113a484828501611328565b91505092915050565b6000602082840312156113c3576113c2611bdc565b5b600082013567ffffffffffffffff8111156113e1576113e0611bd7565b5b6113ed8482850161133d565b91505092915050565b60006020828403121561140c5761140b611bdc565b5b600061141a8482850161136b565b91505092915050565b600061142f838361144f565b60208301905092915050565b6000611447838361154f565b905092915050565b61145881611990565b82525050565b61146781611990565b82525050565b600061147882611876565b61148281856118b1565b935061148d83611856565b8060005b838110156114be5781516114a58882611423565b97506114b083611897565b925050600181019050611491565b5085935050505092915050565b60006114d682611881565b6114e081856118c2565b9350836020820285016114f285611866565b8060005b8581101561152e578484038952815161150f858261143b565b945061151a836118a4565b925060208a019950506001810190506114f6565b50829750879550505050505092915050565b611549816119a2565b82525050565b600061155a8261188c565b61156481856118d3565b9350611574818560208601611a03565b61157d81611be1565b840191505092915050565b60006115938261188c565b61159d81856118e4565b93506115ad818560208601611a03565b6115b681611be1565b840191505092915050565b60006115ce601d836118f5565b91506115d982611bf2565b602082019050919050565b60006115f16027836118f5565b91506115fc82611c1b565b604082019050919050565b60006116146012836118f5565b915061161f82611c6a565b602082019050919050565b6000611637601a836118f5565b915061164282611c93565b602082019050919050565b600061165a6040836118f5565b915061166582611cbc565b604082019050919050565b611679816119ae565b82525050565b611688816119ea565b82525050565b60006020820190506116a3600083018461145e565b92915050565b600060208201905081810360008301526116c3818461146d565b905092915050565b600060208201905081810360008301526116e581846114cb565b905092915050565b60006020820190506117026000830184611540565b92915050565b600060208201905081810360008301526117228184611588565b905092915050565b60006020820190508181036000830152611743816115c1565b9050919050565b60006020820190508181036000830152611763816115e4565b9050919050565b6000602082019050818103600083015261178381611607565b9050919050565b600060208201905081810360008301526117a38161162a565b9050919050565b600060208201905081810360008301526117c38161164d565b9050919050565b60006020820190506117df6000830184611670565b92915050565b60006020820190506117fa600083018461167f565b92915050565b600061180a61181b565b90506118168282611a68565b919050565b6000604051905090565b600067ffffffffffffffff8211156118405761183f611b9e565b5b61184982611be1565b9050602081019050919050565b6000819050602082019050919050565b6000819050602082019050919050565b600081519050919050565b600081519050919050565b600081519050919050565b6000602082019050919050565b6000602082019050919050565b600082825260208201905092915050565b600082825260208201905092915050565b600082825260208201905092915050565b600082825260208201905092915050565b600082825260208201905092915050565b6000611911826119ea565b915061191c836119ea565b9250827fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0382111561195157611950611ae2565b5b828201905092915050565b6000611967826119ea565b9150611972836119ea565b92508282101561198557611984611ae2565b5b828203905092915050565b600061199b826119ca565b9050919050565b60008115159050919050565b60006fffffffffffffffffffffffffffffffff82169050919050565b600073ffffffffffffffffffffffffffffffffffffffff82169050919050565b6000819050919050565b82818337600083830152505050565b60005b83811015611a21578082015181840152602081019050611a06565b83811115611a30576000848401525b50505050565b60006002820490506001821680611a4e57607f821691505b60208210811415611a6257611a61611b11565b5b50919050565b611a7182611be1565b810181811067ffffffffffffffff82111715611a9057611a8f611b9e565b5b80604052505050565b6000611aa4826119ea565b91507fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff821415611ad757611ad6611ae2565b5b600182019050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603160045260246000fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b600080fd5b600080fd5b600080fd5b600080fd5b6000601f19601f8301169050919050565b7f4f6e6c79207374616b65722063616e2063616c6c2066756e6374696f6e000000600082015250565b7f56616c696461746f72207365742068617320726561636865642066756c6c206360008201527f6170616369747900000000000000000000000000000000000000000000000000602082015250565b7f696e646578206f7574206f662072616e67650000000000000000000000000000600082015250565b7f4f6e6c7920454f412063616e2063616c6c2066756e6374696f6e000000000000600082015250565b7f56616c696461746f72732063616e2774206265206c657373207468616e20746860008201527f65206d696e696d756d2072657175697265642076616c696461746f72206e756d602082015250565b611d1481611990565b8114611d1f57600080fd5b50565b611d2b816119ea565b8114611d3657600080fd5b5056fea26469706673582212201556e5927c99f1e21e8ae2bbc55b0b507bc60d9732fc9a5e25a0708b409c8c8064736f6c63430008070033"
+	// StakingSCJailBytecode is the runtime code of Staking.sol, the staking contract with the jail method.
+	// It's deployed by the forks jailing the offline validators, see make contracts
+	//nolint: lll
+	StakingSCJailBytecode = "0x6080604052600436106101235760003560e01c80637dceceb8116100a0578063d94c111b11610064578063d94c111b14610383578063e387a7ed146103a3578063e804fbf6146103b9578063f90ecacc146103ce578063facd743b1461040657600080fd5b80637dceceb8146102e85780639bcbea5214610315578063af6da36e14610335578063c795c0771461034b578063ca1e78191461036157600080fd5b80633a4b66f1116100e75780633a4b66f11461023f5780633c561f041461024757806351a9ab3214610269578063714ff425146102965780637a6eea37146102ab57600080fd5b806302b751991461015f578063065ae1711461019f5780632367f6b5146101df5780632def662014610215578063373d61321461022a57600080fd5b3661015a57333b156101505760405162461bcd60e51b815260040161014790610cc3565b60405180910390fd5b61015861043f565b005b600080fd5b34801561016b57600080fd5b5061018c61017a366004610cfa565b60036020526000908152604090205481565b6040519081526020015b60405180910390f35b3480156101ab57600080fd5b506101cf6101ba366004610cfa565b60016020526000908152604090205460ff1681565b6040519015158152602001610196565b3480156101eb57600080fd5b5061018c6101fa366004610cfa565b6001600160a01b031660009081526002602052604090205490565b34801561022157600080fd5b506101586104c9565b34801561023657600080fd5b5060045461018c565b61015861054f565b34801561025357600080fd5b5061025c610576565b6040516101969190610d70565b34801561027557600080fd5b50610289610284366004610cfa565b6106d2565b6040516101969190610dd2565b3480156102a257600080fd5b5060055461018c565b3480156102b757600080fd5b506102c7670de0b6b3a764000081565b6040516fffffffffffffffffffffffffffffffff9091168152602001610196565b3480156102f457600080fd5b5061018c610303366004610cfa565b60026020526000908152604090205481565b34801561032157600080fd5b50610158610330366004610cfa565b61076c565b34801561034157600080fd5b5061018c60065481565b34801561035757600080fd5b5061018c60055481565b34801561036d57600080fd5b50610376610833565b6040516101969190610de5565b34801561038f57600080fd5b5061015861039e366004610e48565b610895565b3480156103af57600080fd5b5061018c60045481565b3480156103c557600080fd5b5060065461018c565b3480156103da57600080fd5b506103ee6103e9366004610ef9565b6108b2565b6040516001600160a01b039091168152602001610196565b34801561041257600080fd5b506101cf610421366004610cfa565b6001600160a01b031660009081526001602052604090205460ff1690565b34600460008282546104519190610f28565b90915550503360009081526002602052604081208054349290610475908490610f28565b909155506104849050336108dc565b15610492576104923361092b565b60405134815233907f9e71bc8eea02a63969f509818f2dafb9254532904319f9dbda79b67bd34a5f3d9060200160405180910390a2565b333b156104e85760405162461bcd60e51b815260040161014790610cc3565b336000908152600260205260409020546105445760405162461bcd60e51b815260206004820152601d60248201527f4f6e6c79207374616b65722063616e2063616c6c2066756e6374696f6e0000006044820152606401610147565b61054d336109fb565b565b333b1561056e5760405162461bcd60e51b815260040161014790610cc3565b61054d61043f565b600080546060919067ffffffffffffffff81111561059657610596610e32565b6040519080825280602002602001820160405280156105c957816020015b60608152602001906001900390816105b45790505b50905060005b6000548110156106cc57600760008083815481106105ef576105ef610f3b565b60009182526020808320909101546001600160a01b031683528201929092526040019020805461061e90610f51565b80601f016020809104026020016040519081016040528092919081815260200182805461064a90610f51565b80156106975780601f1061066c57610100808354040283529160200191610697565b820191906000526020600020905b81548152906001019060200180831161067a57829003601f168201915b50505050508282815181106106ae576106ae610f3b565b602002602001018190525080806106c490610f85565b9150506105cf565b50919050565b600760205260009081526040902080546106eb90610f51565b80601f016020809104026020016040519081016040528092919081815260200182805461071790610f51565b80156107645780601f1061073957610100808354040283529160200191610764565b820191906000526020600020905b81548152906001019060200180831161074757829003601f168201915b505050505081565b336002600160a01b03146107c25760405162461bcd60e51b815260206004820152601d60248201527f4f6e6c792073797374656d2063616e2063616c6c2066756e6374696f6e0000006044820152606401610147565b6001600160a01b0381166000908152600260205260409020546108275760405162461bcd60e51b815260206004820152601960248201527f4f6e6c79207374616b65722063616e206265206a61696c6564000000000000006044820152606401610147565b610830816109fb565b50565b6060600080548060200260200160405190810160405280929190818152602001828054801561088b57602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831161086d575b5050505050905090565b3360009081526007602052604090206108ae8282610fed565b5050565b600081815481106108c257600080fd5b6000918252602090912001546001600160a01b0316905081565b6001600160a01b03811660009081526001602052604081205460ff1615801561092557506001600160a01b038216600090815260026020526040902054670de0b6b3a764000011155b92915050565b6006546000541061098e5760405162461bcd60e51b815260206004820152602760248201527f56616c696461746f72207365742068617320726561636865642066756c6c20636044820152666170616369747960c81b6064820152608401610147565b6001600160a01b03166000818152600160208181526040808420805460ff19168417905583546003909252832081905590810182559080527f290decd9548b62a8d60345a988386fc84ba6bc95484008f6362f93160ef3e5630180546001600160a01b0319169091179055565b6001600160a01b03811660009081526002602052604081208054908290556004805491928392610a2c9084906110ad565b90915550506001600160a01b03821660009081526001602052604090205460ff1615610a5b57610a5b82610ad9565b6040516001600160a01b0383169082156108fc029083906000818181858888f19350505050158015610a91573d6000803e3d6000fd5b50816001600160a01b03167f0f5bb82176feb1b5e747e28471aa92156a04d9f3ab9f45f28e2d704232b93f7582604051610acd91815260200190565b60405180910390a25050565b60055460005411610b54576040805162461bcd60e51b81526020600482015260248101919091527f56616c696461746f72732063616e2774206265206c657373207468616e20746860448201527f65206d696e696d756d2072657175697265642076616c696461746f72206e756d6064820152608401610147565b600080546001600160a01b0383168252600360205260409091205410610bb15760405162461bcd60e51b8152602060048201526012602482015271696e646578206f7574206f662072616e676560701b6044820152606401610147565b6001600160a01b0381166000908152600360205260408120548154909190610bdb906001906110ad565b9050808214610c60576000808281548110610bf857610bf8610f3b565b600091825260208220015481546001600160a01b03909116925082919085908110610c2557610c25610f3b565b600091825260208083209190910180546001600160a01b0319166001600160a01b039485161790559290911681526003909152604090208290555b6001600160a01b0383166000908152600160209081526040808320805460ff1916905560039091528120819055805480610c9c57610c9c6110c0565b600082815260209020810160001990810180546001600160a01b0319169055019055505050565b6020808252601a908201527f4f6e6c7920454f412063616e2063616c6c2066756e6374696f6e000000000000604082015260600190565b600060208284031215610d0c57600080fd5b81356001600160a01b0381168114610d2357600080fd5b9392505050565b6000815180845260005b81811015610d5057602081850181015186830182015201610d34565b506000602082860101526020601f19601f83011685010191505092915050565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b82811015610dc557603f19888603018452610db3858351610d2a565b94509285019290850190600101610d97565b5092979650505050505050565b602081526000610d236020830184610d2a565b6020808252825182820181905260009190848201906040850190845b81811015610e265783516001600160a01b031683529284019291840191600101610e01565b50909695505050505050565b634e487b7160e01b600052604160045260246000fd5b600060208284031215610e5a57600080fd5b813567ffffffffffffffff80821115610e7257600080fd5b818401915084601f830112610e8657600080fd5b813581811115610e9857610e98610e32565b604051601f8201601f19908116603f01168101908382118183101715610ec057610ec0610e32565b81604052828152876020848701011115610ed957600080fd5b826020860160208301376000928101602001929092525095945050505050565b600060208284031215610f0b57600080fd5b5035919050565b634e487b7160e01b600052601160045260246000fd5b8082018082111561092557610925610f12565b634e487b7160e01b600052603260045260246000fd5b600181811c90821680610f6557607f821691505b6020821081036106cc57634e487b7160e01b600052602260045260246000fd5b600060018201610f9757610f97610f12565b5060010190565b601f821115610fe857600081815260208120601f850160051c81016020861015610fc55750805b601f850160051c820191505b81811015610fe457828155600101610fd1565b5050505b505050565b815167ffffffffffffffff81111561100757611007610e32565b61101b816110158454610f51565b84610f9e565b602080601f83116001811461105057600084156110385750858301515b600019600386901b1c1916600185901b178555610fe4565b600085815260208120601f198616915b8281101561107f57888601518255948401946001909101908401611060565b508582101561109d5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b8181038181111561092557610925610f12565b634e487b7160e01b600052603160045260246000fdfea2646970667358221220974b32316522497df9aa59b7c6675fa4f2d92774176071669e47eafcbe89c71364736f6c63430008150033"
 )
 
+// GetStakingSCBytecode returns the runtime code of the staking contract,
+// the contract with the jail method if the jailing is enabled
+func GetStakingSCBytecode(jailing bool) string {
+	if jailing {
+		return StakingSCJailBytecode
+	}
+
+	return StakingSCBytecode
+}
+
 // PredeployStakingSC is a helper method for setting up the staking smart contract account,
 // using the passed in validators as pre-staked validators
 func PredeployStakingSC(
@@ -169,8 +187,7 @@ func PredeployStakingSC(
 	params PredeployParams,
 ) (*chain.GenesisAccount, error) {
 	// Set the code for the staking smart contract
-	// Code retrieved from https://github.com/0xPolygon/staking-contracts
-	scHex, _ := hex.DecodeHex(StakingSCBytecode)
+	scHex, _ := hex.DecodeHex(GetStakingSCBytecode(params.Jailing))
 	stakingAccount := &chain.GenesisAccount{
 		Code: scHex,
 	}
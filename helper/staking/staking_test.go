@@ -0,0 +1,13 @@
+package staking
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/helper/tests"
+)
+
+func TestStakingSCJailBytecode(t *testing.T) {
+	t.Parallel()
+
+	tests.AssertContractBytecode(t, "Staking.sol", "Staking", StakingSCJailBytecode)
+}
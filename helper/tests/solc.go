@@ -0,0 +1,78 @@
+package tests
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// SolcVersion is the version of solc the predeployed contracts are compiled with, see make contracts
+	SolcVersion = "0.8.21"
+)
+
+var (
+	// solcArgs are the compiler settings of the predeployed contracts, the same as in the Makefile
+	solcArgs = []string{"--optimize", "--optimize-runs", "200", "--evm-version", "london"}
+)
+
+// AssertContractBytecode compiles the contract in the source file by the pinned solc
+// and checks its runtime code matches the hex encoded expected code.
+// The metadata appended by the compiler is not compared, as it depends on the path of the source.
+// The test is skipped if the pinned solc is not installed, the solc binary can be set by SOLC
+func AssertContractBytecode(t *testing.T, source, contract, expected string) {
+	t.Helper()
+
+	solc := os.Getenv("SOLC")
+	if solc == "" {
+		solc = "solc"
+	}
+
+	version, err := exec.Command(solc, "--version").Output()
+	if err != nil || !strings.Contains(string(version), "Version: "+SolcVersion) {
+		t.Skipf("solc %s is not installed", SolcVersion)
+	}
+
+	args := append(append([]string{}, solcArgs...), "--combined-json", "bin-runtime", source)
+
+	output, err := exec.Command(solc, args...).Output()
+	require.NoError(t, err)
+
+	var result struct {
+		Contracts map[string]struct {
+			BinRuntime string `json:"bin-runtime"`
+		} `json:"contracts"`
+	}
+
+	require.NoError(t, json.Unmarshal(output, &result))
+
+	compiled, ok := result.Contracts[source+":"+contract]
+	require.True(t, ok, "contract %s not found in %s", contract, source)
+
+	assert.Equal(
+		t,
+		stripMetadata(t, strings.TrimPrefix(expected, "0x")),
+		stripMetadata(t, compiled.BinRuntime),
+	)
+}
+
+// stripMetadata removes the CBOR encoded metadata from the end of the runtime code,
+// its length is in the last 2 bytes
+func stripMetadata(t *testing.T, code string) string {
+	t.Helper()
+
+	raw, err := hex.DecodeString(code)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(raw), 2)
+
+	length := int(raw[len(raw)-2])<<8 | int(raw[len(raw)-1])
+	require.LessOrEqual(t, length+2, len(raw))
+
+	return hex.EncodeToString(raw[:len(raw)-length-2])
+}
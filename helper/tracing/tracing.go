@@ -0,0 +1,125 @@
+// Package tracing holds the helpers for the OpenTelemetry instrumentation of the client.
+// The spans are recorded by the global tracer provider, which is a no-op
+// unless an exporter has been configured on the server
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// instrumentationName is the prefix of the names of the tracers
+	instrumentationName = "github.com/0xPolygon/polygon-edge/"
+
+	// serviceName is the name of the service reported to the collector
+	serviceName = "polygon-edge"
+)
+
+const (
+	// TxHashKey is the key of the transaction hash attribute,
+	// the spans of one transaction can be found by its value
+	TxHashKey = attribute.Key("tx.hash")
+
+	// TxHashesKey is the key of the attribute with the hashes of the transactions in the block
+	TxHashesKey = attribute.Key("tx.hashes")
+
+	// BlockNumberKey is the key of the block number attribute
+	BlockNumberKey = attribute.Key("block.number")
+
+	// BlockHashKey is the key of the block hash attribute
+	BlockHashKey = attribute.Key("block.hash")
+)
+
+// Tracer returns the tracer of the given package of the client
+func Tracer(pkg string) trace.Tracer {
+	return otel.Tracer(instrumentationName + pkg)
+}
+
+// TxHash returns the attribute of the transaction hash
+func TxHash(hash types.Hash) attribute.KeyValue {
+	return TxHashKey.String(hash.String())
+}
+
+// TxHashes returns the attribute of the hashes of the given transactions
+func TxHashes(txs []*types.Transaction) attribute.KeyValue {
+	hashes := make([]string, len(txs))
+	for i, tx := range txs {
+		hashes[i] = tx.Hash.String()
+	}
+
+	return TxHashesKey.StringSlice(hashes)
+}
+
+// Block returns the attributes of the block header
+func Block(header *types.Header) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		BlockNumberKey.Int64(int64(header.Number)),
+		BlockHashKey.String(header.Hash.String()),
+	}
+}
+
+// RecordError records the error, if any, and marks the span as failed
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// EndSpan records the error, if any, and ends the span
+func EndSpan(span trace.Span, err error) {
+	RecordError(span, err)
+	span.End()
+}
+
+// Config holds the config details of the OTLP exporter
+type Config struct {
+	// Endpoint is the address of the OTLP gRPC collector (host:port)
+	Endpoint string
+
+	// Insecure disables the TLS of the connection to the collector
+	Insecure bool
+}
+
+// NewProvider creates the tracer provider exporting the spans to the OTLP collector
+// and sets it as the global provider. The provider needs to be shut down
+// to flush the remaining spans
+func NewProvider(ctx context.Context, config *Config) (*sdktrace.TracerProvider, error) {
+	opts := []otlptracegrpc.Option{
+		otlptracegrpc.WithEndpoint(config.Endpoint),
+	}
+
+	if config.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the OTLP exporter: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceNameKey.String(serviceName),
+		)),
+	)
+
+	otel.SetTracerProvider(provider)
+
+	return provider, nil
+}
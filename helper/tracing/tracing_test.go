@@ -0,0 +1,74 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestEndSpan(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name   string
+		err    error
+		status codes.Code
+		events int
+	}{
+		{
+			"ends the span without the error",
+			nil,
+			codes.Unset,
+			0,
+		},
+		{
+			"records the error of the span",
+			errors.New("failed"),
+			codes.Error,
+			1,
+		},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			recorder := tracetest.NewSpanRecorder()
+			tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+			_, span := tracer.Start(context.Background(), "span")
+			EndSpan(span, testCase.err)
+
+			spans := recorder.Ended()
+			assert.Len(t, spans, 1)
+			assert.Equal(t, testCase.status, spans[0].Status().Code)
+			assert.Len(t, spans[0].Events(), testCase.events)
+		})
+	}
+}
+
+func TestTxHashes(t *testing.T) {
+	t.Parallel()
+
+	txs := []*types.Transaction{
+		{Hash: types.StringToHash("1")},
+		{Hash: types.StringToHash("2")},
+	}
+
+	assert.Equal(t,
+		attribute.StringSlice("tx.hashes", []string{
+			types.StringToHash("1").String(),
+			types.StringToHash("2").String(),
+		}),
+		TxHashes(txs),
+	)
+}
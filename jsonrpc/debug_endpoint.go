@@ -1,6 +1,7 @@
 package jsonrpc
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
@@ -13,7 +14,14 @@ import (
 
 type debugTraceStore interface {
 	// add new method to handle tracer
-	ApplyMessage(parentHeader *types.Header, header *types.Header, txn *types.Transaction, tracer runtime.TraceConfig) (*runtime.ExecutionResult, error)
+	ApplyMessage(
+		parentHeader *types.Header,
+		header *types.Header,
+		txn *types.Transaction,
+		tracer runtime.TraceConfig,
+		stateOverride types.StateOverride,
+		blockOverrides *types.BlockOverrides,
+	) (*runtime.ExecutionResult, error)
 	ApplyBlockTxn(parentHeader *types.Header, block *types.Block, hash types.Hash, tracer runtime.TraceConfig) (*runtime.ExecutionResult, error)
 }
 
@@ -31,9 +39,35 @@ type Debug struct {
 // TraceConfig holds extra parameters to trace functions.
 type TraceConfig struct {
 	*logger.Config
-	Tracer  *string
-	Timeout *string
-	Reexec  *uint64
+	Tracer       *string
+	TracerConfig json.RawMessage
+	Timeout      *string
+	Reexec       *uint64
+}
+
+// TraceCallConfig holds extra parameters to trace a call.
+type TraceCallConfig struct {
+	TraceConfig
+	StateOverrides *stateOverride
+	BlockOverrides *blockOverrides
+}
+
+// stateOverride returns the state overrides of the call, nil if there are none
+func (c *TraceCallConfig) stateOverride() types.StateOverride {
+	if c == nil {
+		return nil
+	}
+
+	return c.StateOverrides.toStateOverride()
+}
+
+// blockOverrides returns the block overrides of the call, nil if there are none
+func (c *TraceCallConfig) blockOverrides() *types.BlockOverrides {
+	if c == nil {
+		return nil
+	}
+
+	return c.BlockOverrides.toBlockOverrides()
 }
 
 func (d *Debug) getBlockHeader(number BlockNumber) (*types.Header, error) {
@@ -52,6 +86,9 @@ func (d *Debug) getBlockHeader(number BlockNumber) (*types.Header, error) {
 	case PendingBlockNumber:
 		return nil, fmt.Errorf("fetching the pending header is not supported")
 
+	case FinalizedBlockNumber, SafeBlockNumber:
+		return GetBlockHeader(number, d.store)
+
 	default:
 		// Convert the block number from hex to uint64
 		header, ok := d.store.GetHeaderByNumber(uint64(number))
@@ -105,7 +142,7 @@ func (d *Debug) getNextNonce(address types.Address, number BlockNumber) (uint64,
 
 	acc, err := d.store.GetAccount(header.StateRoot, address)
 
-	if errors.As(err, &ErrStateNotFound) {
+	if errors.Is(err, ErrStateNotFound) {
 		// If the account doesn't exist / isn't initialized,
 		// return a nonce value of 0
 		return 0, nil
@@ -224,7 +261,7 @@ func (d *Debug) TraceTransaction(hash types.Hash, config *TraceConfig) (interfac
 	}
 	tracer = logger.NewStructLogger(config.Config)
 	if config.Tracer != nil {
-		tracer, err = tracers.New(*config.Tracer, txctx)
+		tracer, err = tracers.New(*config.Tracer, txctx, config.TracerConfig)
 		if err != nil {
 			return nil, err
 		}
@@ -246,7 +283,7 @@ func (d *Debug) TraceTransaction(hash types.Hash, config *TraceConfig) (interfac
 // created during the execution of EVM if the given transaction was added on
 // top of the provided block and returns them as a JSON object.
 // You can provide -2 as a block number to trace on top of the pending block.
-func (d *Debug) TraceCall(args *txnArgs, blockNrOrHash BlockNumberOrHash, config *TraceConfig) (interface{}, error) {
+func (d *Debug) TraceCall(args *txnArgs, blockNrOrHash BlockNumberOrHash, config *TraceCallConfig) (interface{}, error) {
 	var (
 		header *types.Header
 		err    error
@@ -275,10 +312,11 @@ func (d *Debug) TraceCall(args *txnArgs, blockNrOrHash BlockNumberOrHash, config
 	var traceConfig *TraceConfig
 	if config != nil {
 		traceConfig = &TraceConfig{
-			Config:  config.Config,
-			Tracer:  config.Tracer,
-			Timeout: config.Timeout,
-			Reexec:  config.Reexec,
+			Config:       config.Config,
+			Tracer:       config.Tracer,
+			TracerConfig: config.TracerConfig,
+			Timeout:      config.Timeout,
+			Reexec:       config.Reexec,
 		}
 	}
 	if traceConfig == nil {
@@ -288,7 +326,7 @@ func (d *Debug) TraceCall(args *txnArgs, blockNrOrHash BlockNumberOrHash, config
 	var tracer tracers.Tracer
 	tracer = logger.NewStructLogger(traceConfig.Config)
 	if traceConfig.Tracer != nil {
-		tracer, err = tracers.New(*traceConfig.Tracer, new(tracers.Context))
+		tracer, err = tracers.New(*traceConfig.Tracer, new(tracers.Context), traceConfig.TracerConfig)
 		if err != nil {
 			return nil, err
 		}
@@ -296,7 +334,14 @@ func (d *Debug) TraceCall(args *txnArgs, blockNrOrHash BlockNumberOrHash, config
 	// The return value of the execution is saved in the transition (returnValue field)
 	txn := transaction.Copy()
 	txn.Gas = transaction.Gas
-	_, err = d.store.ApplyMessage(header, header, txn, runtime.TraceConfig{Debug: true, Tracer: tracer, NoBaseFee: true})
+	_, err = d.store.ApplyMessage(
+		header,
+		header,
+		txn,
+		runtime.TraceConfig{Debug: true, Tracer: tracer, NoBaseFee: true},
+		config.stateOverride(),
+		config.blockOverrides(),
+	)
 
 	if err != nil {
 		return nil, err
@@ -4,25 +4,34 @@ import (
 	"encoding/json"
 	"math/big"
 	"testing"
-	"time"
 
-	"github.com/0xPolygon/polygon-edge/helper/hex"
-	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/tracers/logger"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/stretchr/testify/assert"
 )
 
 type debugEndpointMockStore struct {
-	headerFn            func() *types.Header
-	getHeaderByNumberFn func(uint64) (*types.Header, bool)
-	readTxLookupFn      func(types.Hash) (types.Hash, bool)
-	getBlockByHashFn    func(types.Hash, bool) (*types.Block, bool)
-	getBlockByNumberFn  func(uint64, bool) (*types.Block, bool)
-	traceBlockFn        func(*types.Block, tracer.Tracer) ([]interface{}, error)
-	traceTxnFn          func(*types.Block, types.Hash, tracer.Tracer) (interface{}, error)
-	traceCallFn         func(*types.Transaction, *types.Header, tracer.Tracer) (interface{}, error)
-	getNonceFn          func(types.Address) uint64
-	getAccountFn        func(types.Hash, types.Address) (*Account, error)
+	ethStore
+
+	headerFn             func() *types.Header
+	getHeaderByNumberFn  func(uint64) (*types.Header, bool)
+	readTxLookupFn       func(types.Hash) (types.Hash, bool)
+	getBlockByHashFn     func(types.Hash, bool) (*types.Block, bool)
+	getBlockByNumberFn   func(uint64, bool) (*types.Block, bool)
+	getNonceFn           func(types.Address) uint64
+	getAccountFn         func(types.Hash, types.Address) (*Account, error)
+	getFinalizedNumberFn func(uint64) uint64
+	getSafeNumberFn      func(uint64) uint64
+	applyMessageFn       func(
+		*types.Header,
+		*types.Header,
+		*types.Transaction,
+		runtime.TraceConfig,
+		types.StateOverride,
+		*types.BlockOverrides,
+	) (*runtime.ExecutionResult, error)
+	applyBlockTxnFn func(*types.Header, *types.Block, types.Hash, runtime.TraceConfig) (*runtime.ExecutionResult, error)
 }
 
 func (s *debugEndpointMockStore) Header() *types.Header {
@@ -45,28 +54,50 @@ func (s *debugEndpointMockStore) GetBlockByNumber(num uint64, full bool) (*types
 	return s.getBlockByNumberFn(num, full)
 }
 
-func (s *debugEndpointMockStore) TraceBlock(block *types.Block, tracer tracer.Tracer) ([]interface{}, error) {
-	return s.traceBlockFn(block, tracer)
+func (s *debugEndpointMockStore) GetNonce(acc types.Address) uint64 {
+	return s.getNonceFn(acc)
 }
 
-func (s *debugEndpointMockStore) TraceTxn(block *types.Block, targetTx types.Hash, tracer tracer.Tracer) (interface{}, error) {
-	return s.traceTxnFn(block, targetTx, tracer)
+func (s *debugEndpointMockStore) GetAccount(root types.Hash, addr types.Address) (*Account, error) {
+	return s.getAccountFn(root, addr)
 }
 
-func (s *debugEndpointMockStore) TraceCall(tx *types.Transaction, parent *types.Header, tracer tracer.Tracer) (interface{}, error) {
-	return s.traceCallFn(tx, parent, tracer)
+func (s *debugEndpointMockStore) GetFinalizedNumber(latest uint64) uint64 {
+	return s.getFinalizedNumberFn(latest)
 }
 
-func (s *debugEndpointMockStore) GetNonce(acc types.Address) uint64 {
-	return s.getNonceFn(acc)
+func (s *debugEndpointMockStore) GetSafeNumber(latest uint64) uint64 {
+	return s.getSafeNumberFn(latest)
 }
 
-func (s *debugEndpointMockStore) GetAccount(root types.Hash, addr types.Address) (*Account, error) {
-	return s.getAccountFn(root, addr)
+func (s *debugEndpointMockStore) ApplyMessage(
+	parentHeader *types.Header,
+	header *types.Header,
+	txn *types.Transaction,
+	tracer runtime.TraceConfig,
+	stateOverride types.StateOverride,
+	blockOverrides *types.BlockOverrides,
+) (*runtime.ExecutionResult, error) {
+	return s.applyMessageFn(parentHeader, header, txn, tracer, stateOverride, blockOverrides)
+}
+
+func (s *debugEndpointMockStore) ApplyBlockTxn(
+	parentHeader *types.Header,
+	block *types.Block,
+	hash types.Hash,
+	tracer runtime.TraceConfig,
+) (*runtime.ExecutionResult, error) {
+	return s.applyBlockTxnFn(parentHeader, block, hash, tracer)
 }
 
+// emptyTraceResult is the result of the struct logger not having traced any execution
+var emptyTraceResult = json.RawMessage(`{"gas":0,"failed":false,"returnValue":"","structLogs":[]}`)
+
 func TestDebugTraceConfigDecode(t *testing.T) {
+	t.Parallel()
+
 	timeout15s := "15s"
+	tracer := "callTracer"
 
 	tests := []struct {
 		input    string
@@ -74,353 +105,79 @@ func TestDebugTraceConfigDecode(t *testing.T) {
 	}{
 		{
 			// default
-			input: `{}`,
-			expected: TraceConfig{
-				EnableMemory:     false,
-				DisableStack:     false,
-				DisableStorage:   false,
-				EnableReturnData: false,
-			},
+			input:    `{}`,
+			expected: TraceConfig{},
 		},
 		{
 			input: `{
 				"enableMemory": true
 			}`,
 			expected: TraceConfig{
-				EnableMemory:     true,
-				DisableStack:     false,
-				DisableStorage:   false,
-				EnableReturnData: false,
-			},
-		},
-		{
-			input: `{
-				"disableStack": true
-			}`,
-			expected: TraceConfig{
-				EnableMemory:     false,
-				DisableStack:     true,
-				DisableStorage:   false,
-				EnableReturnData: false,
-			},
-		},
-		{
-			input: `{
-				"disableStorage": true
-			}`,
-			expected: TraceConfig{
-				EnableMemory:     false,
-				DisableStack:     false,
-				DisableStorage:   true,
-				EnableReturnData: false,
+				Config: &logger.Config{
+					EnableMemory: true,
+				},
 			},
 		},
 		{
 			input: `{
+				"disableStack": true,
+				"disableStorage": true,
 				"enableReturnData": true
 			}`,
 			expected: TraceConfig{
-				EnableMemory:     false,
-				DisableStack:     false,
-				DisableStorage:   false,
-				EnableReturnData: true,
+				Config: &logger.Config{
+					DisableStack:     true,
+					DisableStorage:   true,
+					EnableReturnData: true,
+				},
 			},
 		},
 		{
 			input: `{
+				"tracer": "callTracer",
+				"tracerConfig": {"onlyTopCall": true},
 				"timeout": "15s"
 			}`,
 			expected: TraceConfig{
-				EnableMemory:     false,
-				DisableStack:     false,
-				DisableStorage:   false,
-				EnableReturnData: false,
-				Timeout:          &timeout15s,
-			},
-		},
-		{
-			input: `{
-				"enableMemory": true,
-				"disableStack": true,
-				"disableStorage": true,
-				"enableReturnData": true,
-				"timeout": "15s"
-			}`,
-			expected: TraceConfig{
-				EnableMemory:     true,
-				DisableStack:     true,
-				DisableStorage:   true,
-				EnableReturnData: true,
-				Timeout:          &timeout15s,
+				Tracer:       &tracer,
+				TracerConfig: json.RawMessage(`{"onlyTopCall": true}`),
+				Timeout:      &timeout15s,
 			},
 		},
 	}
 
 	for _, test := range tests {
-		t.Run(test.input, func(t *testing.T) {
-			result := TraceConfig{}
-
-			assert.NoError(
-				t,
-				json.Unmarshal(
-					[]byte(test.input),
-					&result,
-				),
-			)
-
-			assert.Equal(
-				t,
-				test.expected,
-				result,
-			)
-		})
-	}
-}
-
-func TestTraceBlockByNumber(t *testing.T) {
-	t.Parallel()
-
-	tests := []struct {
-		name        string
-		blockNumber BlockNumber
-		config      *TraceConfig
-		store       *debugEndpointMockStore
-		result      interface{}
-		err         bool
-	}{
-		{
-			name:        "should trace the latest block",
-			blockNumber: LatestBlockNumber,
-			config:      &TraceConfig{},
-			store: &debugEndpointMockStore{
-				headerFn: func() *types.Header {
-					return testLatestHeader
-				},
-				getBlockByNumberFn: func(num uint64, full bool) (*types.Block, bool) {
-					assert.Equal(t, testLatestHeader.Number, num)
-					assert.True(t, full)
-
-					return testLatestBlock, true
-				},
-				traceBlockFn: func(block *types.Block, tracer tracer.Tracer) ([]interface{}, error) {
-					assert.Equal(t, testLatestBlock, block)
-
-					return testTraceResults, nil
-				},
-			},
-			result: testTraceResults,
-			err:    false,
-		},
-		{
-			name:        "should trace the block at the given height",
-			blockNumber: 10,
-			config:      &TraceConfig{},
-			store: &debugEndpointMockStore{
-				getBlockByNumberFn: func(num uint64, full bool) (*types.Block, bool) {
-					assert.Equal(t, testHeader10.Number, num)
-					assert.True(t, full)
-
-					return testBlock10, true
-				},
-				traceBlockFn: func(block *types.Block, tracer tracer.Tracer) ([]interface{}, error) {
-					assert.Equal(t, testBlock10, block)
-
-					return testTraceResults, nil
-				},
-			},
-			result: testTraceResults,
-			err:    false,
-		},
-		{
-			name:        "should return errTraceGenesisBlock for genesis block",
-			blockNumber: 0,
-			config:      &TraceConfig{},
-			store: &debugEndpointMockStore{
-				getBlockByNumberFn: func(num uint64, full bool) (*types.Block, bool) {
-					assert.Equal(t, testGenesisHeader.Number, num)
-					assert.True(t, full)
-
-					return testGenesisBlock, true
-				},
-			},
-			result: nil,
-			err:    true,
-		},
-		{
-			name:        "should return errBlockNotFound",
-			blockNumber: 11,
-			config:      &TraceConfig{},
-			store: &debugEndpointMockStore{
-				getBlockByNumberFn: func(num uint64, full bool) (*types.Block, bool) {
-					assert.Equal(t, uint64(11), num)
-					assert.True(t, full)
-
-					return nil, false
-				},
-			},
-			result: nil,
-			err:    true,
-		},
-	}
-
-	for _, test := range tests {
-		test := test
-
-		t.Run(test.name, func(t *testing.T) {
-			t.Parallel()
-
-			endpoint := &Debug{test.store}
-
-			res, err := endpoint.TraceBlockByNumber(test.blockNumber, test.config)
-
-			assert.Equal(t, test.result, res)
-
-			if test.err {
-				assert.Error(t, err)
-			} else {
-				assert.NoError(t, err)
-			}
-		})
-	}
-}
-
-func TestTraceBlockByHash(t *testing.T) {
-	t.Parallel()
-
-	tests := []struct {
-		name      string
-		blockHash types.Hash
-		config    *TraceConfig
-		store     *debugEndpointMockStore
-		result    interface{}
-		err       bool
-	}{
-		{
-			name:      "should trace the latest block",
-			blockHash: testHeader10.Hash,
-			config:    &TraceConfig{},
-			store: &debugEndpointMockStore{
-				getBlockByHashFn: func(hash types.Hash, full bool) (*types.Block, bool) {
-					assert.Equal(t, testHeader10.Hash, hash)
-					assert.True(t, full)
-
-					return testBlock10, true
-				},
-				traceBlockFn: func(block *types.Block, tracer tracer.Tracer) ([]interface{}, error) {
-					assert.Equal(t, testBlock10, block)
-
-					return testTraceResults, nil
-				},
-			},
-			result: testTraceResults,
-			err:    false,
-		},
-		{
-			name:      "should return errBlockNotFound",
-			blockHash: testHash11,
-			config:    &TraceConfig{},
-			store: &debugEndpointMockStore{
-				getBlockByHashFn: func(hash types.Hash, full bool) (*types.Block, bool) {
-					assert.Equal(t, testHash11, hash)
-					assert.True(t, full)
-
-					return nil, false
-				},
-			},
-			result: nil,
-			err:    true,
-		},
-	}
-
-	for _, test := range tests {
-		test := test
-
-		t.Run(test.name, func(t *testing.T) {
-			t.Parallel()
-
-			endpoint := &Debug{test.store}
-
-			res, err := endpoint.TraceBlockByHash(test.blockHash, test.config)
-
-			assert.Equal(t, test.result, res)
-
-			if test.err {
-				assert.Error(t, err)
-			} else {
-				assert.NoError(t, err)
-			}
-		})
-	}
-}
-
-func TestTraceBlock(t *testing.T) {
-	t.Parallel()
-
-	blockBytes := testLatestBlock.MarshalRLP()
-	blockHex := hex.EncodeToHex(blockBytes)
-
-	tests := []struct {
-		name   string
-		input  string
-		config *TraceConfig
-		store  *debugEndpointMockStore
-		result interface{}
-		err    bool
-	}{
-		{
-			name:   "should trace the given block",
-			input:  blockHex,
-			config: &TraceConfig{},
-			store: &debugEndpointMockStore{
-				traceBlockFn: func(block *types.Block, tracer tracer.Tracer) ([]interface{}, error) {
-					assert.Equal(t, testLatestBlock, block)
-
-					return testTraceResults, nil
-				},
-			},
-			result: testTraceResults,
-			err:    false,
-		},
-		{
-			name:   "should return error in case of invalid block",
-			input:  "hoge",
-			config: &TraceConfig{},
-			store:  &debugEndpointMockStore{},
-			result: nil,
-			err:    true,
-		},
-	}
-
-	for _, test := range tests {
-		test := test
-
-		t.Run(test.name, func(t *testing.T) {
-			t.Parallel()
-
-			endpoint := &Debug{test.store}
-
-			res, err := endpoint.TraceBlock(test.input, test.config)
-
-			assert.Equal(t, test.result, res)
-
-			if test.err {
-				assert.Error(t, err)
-			} else {
-				assert.NoError(t, err)
-			}
-		})
+		result := TraceConfig{}
+
+		assert.NoError(
+			t,
+			json.Unmarshal(
+				[]byte(test.input),
+				&result,
+			),
+		)
+
+		assert.Equal(
+			t,
+			test.expected,
+			result,
+		)
 	}
 }
 
 func TestTraceTransaction(t *testing.T) {
 	t.Parallel()
 
-	blockWithTx := &types.Block{
-		Header: testBlock10.Header,
-		Transactions: []*types.Transaction{
-			testTx1,
-		},
-	}
+	var (
+		parentHeader = &types.Header{Number: 9, GasLimit: 1000}
+		blockWithTx  = &types.Block{
+			Header: &types.Header{Number: 10},
+			Transactions: []*types.Transaction{
+				testTx1,
+			},
+		}
+		unknownTracer = "unknownTracer"
+	)
 
 	tests := []struct {
 		name   string
@@ -433,27 +190,40 @@ func TestTraceTransaction(t *testing.T) {
 		{
 			name:   "should trace the given transaction",
 			txHash: testTxHash1,
-			config: &TraceConfig{},
+			config: nil,
 			store: &debugEndpointMockStore{
 				readTxLookupFn: func(hash types.Hash) (types.Hash, bool) {
 					assert.Equal(t, testTxHash1, hash)
 
-					return testBlock10.Hash(), true
+					return blockWithTx.Hash(), true
 				},
 				getBlockByHashFn: func(hash types.Hash, full bool) (*types.Block, bool) {
-					assert.Equal(t, testBlock10.Hash(), hash)
+					assert.Equal(t, blockWithTx.Hash(), hash)
 					assert.True(t, full)
 
 					return blockWithTx, true
 				},
-				traceTxnFn: func(block *types.Block, txHash types.Hash, tracer tracer.Tracer) (interface{}, error) {
+				getBlockByNumberFn: func(num uint64, full bool) (*types.Block, bool) {
+					assert.Equal(t, uint64(9), num)
+
+					return &types.Block{Header: parentHeader.Copy()}, true
+				},
+				applyBlockTxnFn: func(
+					parent *types.Header,
+					block *types.Block,
+					hash types.Hash,
+					config runtime.TraceConfig,
+				) (*runtime.ExecutionResult, error) {
+					assert.Equal(t, parentHeader.Number, parent.Number)
 					assert.Equal(t, blockWithTx, block)
-					assert.Equal(t, testTxHash1, txHash)
+					assert.Equal(t, testTxHash1, hash)
+					assert.True(t, config.Debug)
+					assert.NotNil(t, config.Tracer)
 
-					return testTraceResult, nil
+					return &runtime.ExecutionResult{}, nil
 				},
 			},
-			result: testTraceResult,
+			result: emptyTraceResult,
 			err:    false,
 		},
 		{
@@ -471,65 +241,38 @@ func TestTraceTransaction(t *testing.T) {
 			err:    true,
 		},
 		{
-			name:   "should return error if block not found",
-			txHash: testTxHash1,
-			config: &TraceConfig{},
-			store: &debugEndpointMockStore{
-				readTxLookupFn: func(hash types.Hash) (types.Hash, bool) {
-					assert.Equal(t, testTxHash1, hash)
-
-					return testBlock10.Hash(), true
-				},
-				getBlockByHashFn: func(hash types.Hash, full bool) (*types.Block, bool) {
-					assert.Equal(t, testBlock10.Hash(), hash)
-					assert.True(t, full)
-
-					return nil, false
-				},
-			},
-			result: nil,
-			err:    true,
-		},
-		{
-			name:   "should return error if the tx is not including the block",
+			name:   "should return error if the tx is not included in the block",
 			txHash: testTxHash1,
 			config: &TraceConfig{},
 			store: &debugEndpointMockStore{
 				readTxLookupFn: func(hash types.Hash) (types.Hash, bool) {
-					assert.Equal(t, testTxHash1, hash)
-
 					return testBlock10.Hash(), true
 				},
 				getBlockByHashFn: func(hash types.Hash, full bool) (*types.Block, bool) {
-					assert.Equal(t, testBlock10.Hash(), hash)
-					assert.True(t, full)
-
 					return testBlock10, true
 				},
+				getBlockByNumberFn: func(num uint64, full bool) (*types.Block, bool) {
+					return &types.Block{Header: parentHeader.Copy()}, true
+				},
 			},
 			result: nil,
 			err:    true,
 		},
 		{
-			name:   "should return error if the block is genesis",
+			name:   "should return error if the tracer is not found",
 			txHash: testTxHash1,
-			config: &TraceConfig{},
+			config: &TraceConfig{
+				Tracer: &unknownTracer,
+			},
 			store: &debugEndpointMockStore{
 				readTxLookupFn: func(hash types.Hash) (types.Hash, bool) {
-					assert.Equal(t, testTxHash1, hash)
-
-					return testBlock10.Hash(), true
+					return blockWithTx.Hash(), true
 				},
 				getBlockByHashFn: func(hash types.Hash, full bool) (*types.Block, bool) {
-					assert.Equal(t, testBlock10.Hash(), hash)
-					assert.True(t, full)
-
-					return &types.Block{
-						Header: testGenesisHeader,
-						Transactions: []*types.Transaction{
-							testTx1,
-						},
-					}, true
+					return blockWithTx, true
+				},
+				getBlockByNumberFn: func(num uint64, full bool) (*types.Block, bool) {
+					return &types.Block{Header: parentHeader.Copy()}, true
 				},
 			},
 			result: nil,
@@ -547,7 +290,11 @@ func TestTraceTransaction(t *testing.T) {
 
 			res, err := endpoint.TraceTransaction(test.txHash, test.config)
 
-			assert.Equal(t, test.result, res)
+			if test.result == nil {
+				assert.Nil(t, res)
+			} else {
+				assert.JSONEq(t, string(test.result.(json.RawMessage)), string(res.(json.RawMessage)))
+			}
 
 			if test.err {
 				assert.Error(t, err)
@@ -570,6 +317,8 @@ func TestTraceCall(t *testing.T) {
 		data     = argBytes([]byte("data"))
 		input    = argBytes([]byte("input"))
 		nonce    = argUint64(1)
+		balance  = argBig(*big.NewInt(100))
+		number   = argUint64(20)
 
 		blockNumber = BlockNumber(testBlock10.Number())
 
@@ -600,7 +349,7 @@ func TestTraceCall(t *testing.T) {
 		name   string
 		arg    *txnArgs
 		filter BlockNumberOrHash
-		config *TraceConfig
+		config *TraceCallConfig
 		store  *debugEndpointMockStore
 		result interface{}
 		err    bool
@@ -611,21 +360,67 @@ func TestTraceCall(t *testing.T) {
 			filter: BlockNumberOrHash{
 				BlockNumber: &blockNumber,
 			},
-			config: &TraceConfig{},
+			config: nil,
 			store: &debugEndpointMockStore{
 				getHeaderByNumberFn: func(num uint64) (*types.Header, bool) {
 					assert.Equal(t, testBlock10.Number(), num)
 
 					return testHeader10, true
 				},
-				traceCallFn: func(tx *types.Transaction, header *types.Header, tracer tracer.Tracer) (interface{}, error) {
-					assert.Equal(t, decodedTx, tx)
+				applyMessageFn: func(
+					parent *types.Header,
+					header *types.Header,
+					tx *types.Transaction,
+					config runtime.TraceConfig,
+					stateOverride types.StateOverride,
+					blockOverrides *types.BlockOverrides,
+				) (*runtime.ExecutionResult, error) {
+					assert.Equal(t, testHeader10, parent)
 					assert.Equal(t, testHeader10, header)
+					assert.Equal(t, decodedTx, tx)
+					assert.True(t, config.Debug)
+					assert.Nil(t, stateOverride)
+					assert.Nil(t, blockOverrides)
 
-					return testTraceResult, nil
+					return &runtime.ExecutionResult{}, nil
+				},
+			},
+			result: emptyTraceResult,
+			err:    false,
+		},
+		{
+			name: "should pass the overrides of the call",
+			arg:  txArg,
+			filter: BlockNumberOrHash{
+				BlockNumber: &blockNumber,
+			},
+			config: &TraceCallConfig{
+				StateOverrides: &stateOverride{
+					from: overrideAccount{Balance: &balance},
+				},
+				BlockOverrides: &blockOverrides{
+					Number: &number,
+				},
+			},
+			store: &debugEndpointMockStore{
+				getHeaderByNumberFn: func(num uint64) (*types.Header, bool) {
+					return testHeader10, true
+				},
+				applyMessageFn: func(
+					parent *types.Header,
+					header *types.Header,
+					tx *types.Transaction,
+					config runtime.TraceConfig,
+					stateOverride types.StateOverride,
+					blockOverrides *types.BlockOverrides,
+				) (*runtime.ExecutionResult, error) {
+					assert.Equal(t, big.NewInt(100), stateOverride[from].Balance)
+					assert.Equal(t, uint64(number), *blockOverrides.Number)
+
+					return &runtime.ExecutionResult{}, nil
 				},
 			},
-			result: testTraceResult,
+			result: emptyTraceResult,
 			err:    false,
 		},
 		{
@@ -634,7 +429,7 @@ func TestTraceCall(t *testing.T) {
 			filter: BlockNumberOrHash{
 				BlockHash: &testHeader10.Hash,
 			},
-			config: &TraceConfig{},
+			config: &TraceCallConfig{},
 			store: &debugEndpointMockStore{
 				getBlockByHashFn: func(hash types.Hash, full bool) (*types.Block, bool) {
 					assert.Equal(t, testHeader10.Hash, hash)
@@ -656,7 +451,7 @@ func TestTraceCall(t *testing.T) {
 				Nonce:    &nonce,
 			},
 			filter: BlockNumberOrHash{},
-			config: &TraceConfig{},
+			config: &TraceCallConfig{},
 			store: &debugEndpointMockStore{
 				headerFn: func() *types.Header {
 					return testLatestHeader
@@ -677,7 +472,11 @@ func TestTraceCall(t *testing.T) {
 
 			res, err := endpoint.TraceCall(test.arg, test.filter, test.config)
 
-			assert.Equal(t, test.result, res)
+			if test.result == nil {
+				assert.Nil(t, res)
+			} else {
+				assert.JSONEq(t, string(test.result.(json.RawMessage)), string(res.(json.RawMessage)))
+			}
 
 			if test.err {
 				assert.Error(t, err)
@@ -687,83 +486,3 @@ func TestTraceCall(t *testing.T) {
 		})
 	}
 }
-
-func Test_newTracer(t *testing.T) {
-	t.Parallel()
-
-	t.Run("should create tracer", func(t *testing.T) {
-		t.Parallel()
-
-		tracer, cancel, err := newTracer(&TraceConfig{
-			EnableMemory:     true,
-			EnableReturnData: true,
-			DisableStack:     false,
-			DisableStorage:   false,
-		})
-
-		t.Cleanup(func() {
-			cancel()
-		})
-
-		assert.NotNil(t, tracer)
-		assert.NoError(t, err)
-	})
-
-	t.Run("should return error if arg is nil", func(t *testing.T) {
-		t.Parallel()
-
-		tracer, cancel, err := newTracer(nil)
-
-		assert.Nil(t, tracer)
-		assert.Nil(t, cancel)
-		assert.ErrorIs(t, ErrNoConfig, err)
-	})
-
-	t.Run("GetResult should return errExecutionTimeout if timeout happens", func(t *testing.T) {
-		t.Parallel()
-
-		timeout := "0s"
-		tracer, cancel, err := newTracer(&TraceConfig{
-			EnableMemory:     true,
-			EnableReturnData: true,
-			DisableStack:     false,
-			DisableStorage:   false,
-			Timeout:          &timeout,
-		})
-
-		t.Cleanup(func() {
-			cancel()
-		})
-
-		assert.NoError(t, err)
-
-		// wait until timeout
-		time.Sleep(100 * time.Millisecond)
-
-		res, err := tracer.GetResult()
-		assert.Nil(t, res)
-		assert.Equal(t, ErrExecutionTimeout, err)
-	})
-
-	t.Run("GetResult should not return if cancel is called beforre timeout", func(t *testing.T) {
-		t.Parallel()
-
-		timeout := "5s"
-		tracer, cancel, err := newTracer(&TraceConfig{
-			EnableMemory:     true,
-			EnableReturnData: true,
-			DisableStack:     false,
-			DisableStorage:   false,
-			Timeout:          &timeout,
-		})
-
-		assert.NoError(t, err)
-
-		cancel()
-
-		res, err := tracer.GetResult()
-
-		assert.NotNil(t, res)
-		assert.NoError(t, err)
-	})
-}
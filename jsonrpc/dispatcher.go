@@ -2,17 +2,33 @@ package jsonrpc
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
 	"reflect"
 	"strings"
+	"time"
 	"unicode"
 
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/armon/go-metrics"
 	"github.com/hashicorp/go-hclog"
+	"go.opentelemetry.io/otel/attribute"
 )
 
+const (
+	jsonRPCMetrics = "jsonrpc"
+
+	// sendRawTransactionMethod is the method submitting the transactions,
+	// its result is the hash of the submitted transaction
+	sendRawTransactionMethod = "eth_sendRawTransaction"
+)
+
+var otelTracer = tracing.Tracer("jsonrpc")
+
 type serviceData struct {
 	sv      reflect.Value
 	funcMap map[string]*funcData
@@ -35,6 +51,7 @@ type endpoints struct {
 	Net    *Net
 	TxPool *TxPool
 	Debug  *Debug
+	IBFT   *IBFT
 }
 
 // Dispatcher handles all json rpc requests by delegating
@@ -99,12 +116,16 @@ func (d *Dispatcher) registerEndpoints(store JSONRPCStore) {
 	d.endpoints.Debug = &Debug{
 		store,
 	}
+	d.endpoints.IBFT = &IBFT{
+		store,
+	}
 
 	d.registerService("eth", d.endpoints.Eth)
 	d.registerService("net", d.endpoints.Net)
 	d.registerService("web3", d.endpoints.Web3)
 	d.registerService("txpool", d.endpoints.TxPool)
 	d.registerService("debug", d.endpoints.Debug)
+	d.registerService("ibft", d.endpoints.IBFT)
 }
 
 func (d *Dispatcher) getFnHandler(req Request) (*serviceData, *funcData, Error) {
@@ -327,9 +348,34 @@ func (d *Dispatcher) handleReq(req Request) ([]byte, Error) {
 
 	service, fd, ferr := d.getFnHandler(req)
 	if ferr != nil {
+		metrics.IncrCounter([]string{jsonRPCMetrics, "unknown_method_requests"}, 1)
+
 		return nil, ferr
 	}
 
+	_, span := otelTracer.Start(context.Background(), "Dispatcher.handleReq")
+	span.SetAttributes(attribute.String("rpc.method", req.Method))
+
+	start := time.Now()
+	data, err := d.callFunc(service, fd, req)
+
+	updateRequestMetrics(req.Method, start, err)
+
+	if err == nil && req.Method == sendRawTransactionMethod {
+		// the hash links the request to the spans of the transaction
+		var hash types.Hash
+		if unmarshalErr := json.Unmarshal(data, &hash); unmarshalErr == nil {
+			span.SetAttributes(tracing.TxHash(hash))
+		}
+	}
+
+	tracing.EndSpan(span, err)
+
+	return data, err
+}
+
+// callFunc calls the function of the service with the params of the request
+func (d *Dispatcher) callFunc(service *serviceData, fd *funcData, req Request) ([]byte, Error) {
 	inArgs := make([]reflect.Value, fd.inNum)
 	inArgs[0] = service.sv
 
@@ -371,6 +417,18 @@ func (d *Dispatcher) handleReq(req Request) ([]byte, Error) {
 	return data, nil
 }
 
+// updateRequestMetrics updates the metrics of the handled request of the method
+func updateRequestMetrics(method string, start time.Time, err Error) {
+	labels := []metrics.Label{{Name: "method", Value: method}}
+
+	metrics.MeasureSinceWithLabels([]string{jsonRPCMetrics, "request_time"}, start, labels)
+	metrics.IncrCounterWithLabels([]string{jsonRPCMetrics, "requests"}, 1, labels)
+
+	if err != nil {
+		metrics.IncrCounterWithLabels([]string{jsonRPCMetrics, "errors"}, 1, labels)
+	}
+}
+
 func (d *Dispatcher) logInternalError(method string, err error) {
 	d.logger.Error("failed to dispatch", "method", method, "err", err)
 }
@@ -304,7 +304,7 @@ func TestEth_Call(t *testing.T) {
 			Nonce:    argUintPtr(0),
 		}
 
-		res, err := eth.Call(contractCall, BlockNumberOrHash{})
+		res, err := eth.Call(contractCall, BlockNumberOrHash{}, nil, nil)
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), store.ethCallError.Error())
@@ -328,11 +328,65 @@ func TestEth_Call(t *testing.T) {
 			Nonce:    argUintPtr(0),
 		}
 
-		res, err := eth.Call(contractCall, BlockNumberOrHash{})
+		res, err := eth.Call(contractCall, BlockNumberOrHash{}, nil, nil)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
 	})
+
+	t.Run("executes the transaction with the state and the block overridden", func(t *testing.T) {
+		t.Parallel()
+
+		store := newMockBlockStore()
+		store.add(newTestBlock(100, hash1))
+		eth := newTestEthEndpoint(store)
+		contractCall := &txnArgs{
+			From:  &addr0,
+			To:    &addr1,
+			Gas:   argUintPtr(100000),
+			Nonce: argUintPtr(0),
+		}
+
+		storage := map[types.Hash]types.Hash{hash1: hash2}
+
+		res, err := eth.Call(
+			contractCall,
+			BlockNumberOrHash{},
+			&stateOverride{
+				addr1: overrideAccount{
+					Nonce:     argUintPtr(2),
+					Code:      argBytesPtr([]byte{0x1}),
+					Balance:   argBigPtr(big.NewInt(10)),
+					StateDiff: &storage,
+				},
+			},
+			&blockOverrides{
+				Number:   argUintPtr(200),
+				Time:     argUintPtr(300),
+				Coinbase: &addr2,
+			},
+		)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+
+		nonce := uint64(2)
+		assert.Equal(t, types.StateOverride{
+			addr1: types.OverrideAccount{
+				Nonce:     &nonce,
+				Code:      []byte{0x1},
+				Balance:   big.NewInt(10),
+				StateDiff: storage,
+			},
+		}, store.stateOverride)
+
+		number, timestamp := uint64(200), uint64(300)
+		assert.Equal(t, &types.BlockOverrides{
+			Number:    &number,
+			Timestamp: &timestamp,
+			Coinbase:  &addr2,
+		}, store.blockOverrides)
+	})
 }
 
 type testStore interface {
@@ -348,6 +402,10 @@ type mockBlockStore struct {
 	isSyncing       bool
 	averageGasPrice int64
 	ethCallError    error
+
+	// the overrides of the last execution
+	stateOverride  types.StateOverride
+	blockOverrides *types.BlockOverrides
 }
 
 func newMockBlockStore() *mockBlockStore {
@@ -517,7 +575,15 @@ func (m *mockBlockStore) GetAvgGasPrice() *big.Int {
 	return big.NewInt(m.averageGasPrice)
 }
 
-func (m *mockBlockStore) ApplyTxn(header *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error) {
+func (m *mockBlockStore) ApplyTxn(
+	header *types.Header,
+	txn *types.Transaction,
+	stateOverride types.StateOverride,
+	blockOverrides *types.BlockOverrides,
+) (*runtime.ExecutionResult, error) {
+	m.stateOverride = stateOverride
+	m.blockOverrides = blockOverrides
+
 	return &runtime.ExecutionResult{Err: m.ethCallError}, nil
 }
 
@@ -9,10 +9,13 @@ import (
 	"github.com/umbracle/fastrlp"
 
 	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/helper/progress"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/precompiled"
+	"github.com/0xPolygon/polygon-edge/tracers/logger"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
@@ -46,6 +49,12 @@ type ethBlockchainStore interface {
 	// GetHeaderByNumber gets a header using the provided number
 	GetHeaderByNumber(uint64) (*types.Header, bool)
 
+	// GetFinalizedNumber returns the number of the latest finalized block known by the consensus
+	GetFinalizedNumber(latest uint64) uint64
+
+	// GetSafeNumber returns the number of the latest safe block known by the consensus
+	GetSafeNumber(latest uint64) uint64
+
 	// GetBlockByHash gets a block using the provided hash
 	GetBlockByHash(hash types.Hash, full bool) (*types.Block, bool)
 
@@ -61,8 +70,30 @@ type ethBlockchainStore interface {
 	// GetAvgGasPrice returns the average gas price
 	GetAvgGasPrice() *big.Int
 
-	// ApplyTxn applies a transaction object to the blockchain
-	ApplyTxn(header *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error)
+	// ApplyTxn applies a transaction object to the blockchain,
+	// the state and the block are overridden before the execution if the overrides are given
+	ApplyTxn(
+		header *types.Header,
+		txn *types.Transaction,
+		stateOverride types.StateOverride,
+		blockOverrides *types.BlockOverrides,
+	) (*runtime.ExecutionResult, error)
+
+	// ApplyMessage applies a transaction object to the state of the parent header with the tracer,
+	// the state and the block are overridden before the execution if the overrides are given
+	ApplyMessage(
+		parentHeader *types.Header,
+		header *types.Header,
+		txn *types.Transaction,
+		tracer runtime.TraceConfig,
+		stateOverride types.StateOverride,
+		blockOverrides *types.BlockOverrides,
+	) (*runtime.ExecutionResult, error)
+
+	// SimulateCalls executes the bundles of the calls in order on top of the state of the header,
+	// every call sees the state changes of the previous ones.
+	// The gas used by all the calls is limited by the gas limit
+	SimulateCalls(header *types.Header, bundles []*state.CallBundle, gasLimit uint64) error
 
 	// GetSyncProgression retrieves the current sync progression, if any
 	GetSyncProgression() *progress.Progression
@@ -388,7 +419,12 @@ func (e *Eth) GasPrice() (interface{}, error) {
 }
 
 // Call executes a smart contract call using the transaction object data
-func (e *Eth) Call(arg *txnArgs, filter BlockNumberOrHash) (interface{}, error) {
+func (e *Eth) Call(
+	arg *txnArgs,
+	filter BlockNumberOrHash,
+	stateOverride *stateOverride,
+	blockOverrides *blockOverrides,
+) (interface{}, error) {
 	header, err := GetHeaderFromBlockNumberOrHash(filter, e.store)
 	if err != nil {
 		return nil, err
@@ -404,7 +440,12 @@ func (e *Eth) Call(arg *txnArgs, filter BlockNumberOrHash) (interface{}, error)
 	}
 
 	// The return value of the execution is saved in the transition (returnValue field)
-	result, err := e.store.ApplyTxn(header, transaction)
+	result, err := e.store.ApplyTxn(
+		header,
+		transaction,
+		stateOverride.toStateOverride(),
+		blockOverrides.toBlockOverrides(),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -421,13 +462,100 @@ func (e *Eth) Call(arg *txnArgs, filter BlockNumberOrHash) (interface{}, error)
 	return argBytesPtr(result.ReturnValue), nil
 }
 
-// EstimateGas estimates the gas needed to execute a transaction
-func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error) {
+// accessListResult is the access list of a transaction and the gas used with it
+type accessListResult struct {
+	AccessList types.AccessList `json:"accessList"`
+	GasUsed    argUint64        `json:"gasUsed"`
+	Error      string           `json:"error,omitempty"`
+}
+
+// CreateAccessList creates the access list of the accounts and the storage slots the transaction touches.
+// The transaction is executed with the access list tracer until the list doesn't change anymore
+func (e *Eth) CreateAccessList(arg *txnArgs, filter BlockNumberOrHash) (interface{}, error) {
+	header, err := GetHeaderFromBlockNumberOrHash(filter, e.store)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err := DecodeTxn(arg, e.store)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the caller didn't supply the gas limit in the message, then we set it to maximum possible => block gas limit
+	if transaction.Gas == 0 {
+		transaction.Gas = header.GasLimit
+	}
+
+	// the sender and the recipient are accessed anyway and not included in the list
+	to := crypto.CreateAddress(transaction.From, transaction.Nonce)
+	if transaction.To != nil {
+		to = *transaction.To
+	}
+
+	var accessList types.AccessList
+	if arg.AccessList != nil {
+		accessList = *arg.AccessList
+	}
+
+	precompiles := precompiled.NewPrecompiled().Addresses()
+	prevTracer := logger.NewAccessListTracer(accessList, transaction.From, to, precompiles)
+
+	for {
+		accessList = prevTracer.AccessList()
+		tracer := logger.NewAccessListTracer(accessList, transaction.From, to, precompiles)
+
+		result, err := e.store.ApplyMessage(
+			header,
+			header,
+			transaction.Copy(),
+			runtime.TraceConfig{Debug: true, Tracer: tracer, NoBaseFee: true},
+			nil,
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if !tracer.Equal(prevTracer) {
+			prevTracer = tracer
+
+			continue
+		}
+
+		// the executor doesn't charge the access list of EIP-2930 nor the cold accesses of EIP-2929,
+		// so the gas used is the same with and without the list
+		res := &accessListResult{
+			AccessList: accessList,
+			GasUsed:    argUint64(result.GasUsed),
+		}
+
+		if result.Reverted() {
+			res.Error = constructErrorFromRevert(result).Error()
+		} else if result.Failed() {
+			res.Error = result.Err.Error()
+		}
+
+		return res, nil
+	}
+}
+
+// EstimateGas estimates the gas needed to execute a transaction,
+// the block is overridden before the estimation like in eth_call if the overrides are given
+func (e *Eth) EstimateGas(
+	arg *txnArgs,
+	rawNum *BlockNumber,
+	stateOverride *stateOverride,
+	blockOverrides *blockOverrides,
+) (interface{}, error) {
 	transaction, err := DecodeTxn(arg, e.store)
 	if err != nil {
 		return nil, err
 	}
 
+	override := stateOverride.toStateOverride()
+	blockOverride := blockOverrides.toBlockOverrides()
+
 	number := LatestBlockNumber
 	if rawNum != nil {
 		number = *rawNum
@@ -439,7 +567,10 @@ func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error
 		return nil, err
 	}
 
-	forksInTime := e.store.GetForksInTime(uint64(number))
+	// the gas limit and the forks are the ones of the overridden block
+	header = blockOverride.Apply(header)
+
+	forksInTime := e.store.GetForksInTime(header.Number)
 
 	var standardGas uint64
 	if transaction.IsContractCreation() && forksInTime.Homestead {
@@ -484,6 +615,11 @@ func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error
 			accountBalance = acc.Balance
 		}
 
+		// The balance is overridden for the estimation
+		if account, ok := override[transaction.From]; ok && account.Balance != nil {
+			accountBalance = account.Balance
+		}
+
 		availableBalance = new(big.Int).Set(accountBalance)
 
 		if transaction.Value != nil {
@@ -539,7 +675,7 @@ func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error
 		txn := transaction.Copy()
 		txn.Gas = gas
 
-		result, applyErr := e.store.ApplyTxn(header, txn)
+		result, applyErr := e.store.ApplyTxn(header, txn, override, blockOverride)
 
 		if applyErr != nil {
 			// Check the application error.
@@ -0,0 +1,332 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/tracers"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// maxSimulatedBlocks is the limit of the blocks simulated in a request
+	maxSimulatedBlocks = 256
+
+	// maxSimulatedCalls is the limit of the calls simulated in a request
+	maxSimulatedCalls = 1000
+
+	// maxSimulatedGas is the limit of the gas used by all the calls simulated in a request
+	maxSimulatedGas uint64 = 50000000
+
+	// error codes of the failed simulated calls
+	simulatedCallRevertedCode = 3
+	simulatedCallFailedCode   = -32015
+)
+
+var (
+	ErrNoSimulatedBlocks      = errors.New("no blocks to simulate")
+	ErrTooManySimulatedBlocks = fmt.Errorf("too many blocks to simulate, the limit is %d", maxSimulatedBlocks)
+	ErrTooManySimulatedCalls  = fmt.Errorf("too many calls to simulate, the limit is %d", maxSimulatedCalls)
+)
+
+// simulateOpts is the options of eth_simulateV1
+type simulateOpts struct {
+	BlockStateCalls []*simulateBlock `json:"blockStateCalls"`
+
+	// Tracer is the name of the tracer each call is traced by, the calls are not traced if empty
+	Tracer       *string         `json:"tracer"`
+	TracerConfig json.RawMessage `json:"tracerConfig"`
+}
+
+// simulateBlock is the calls executed in a simulated block
+type simulateBlock struct {
+	BlockOverrides *blockOverrides `json:"blockOverrides"`
+	StateOverrides *stateOverride  `json:"stateOverrides"`
+	Calls          []*txnArgs      `json:"calls"`
+}
+
+// simulatedBlockResult is the result of a simulated block
+type simulatedBlockResult struct {
+	Number    argUint64              `json:"number"`
+	Timestamp argUint64              `json:"timestamp"`
+	GasLimit  argUint64              `json:"gasLimit"`
+	GasUsed   argUint64              `json:"gasUsed"`
+	Miner     types.Address          `json:"miner"`
+	Calls     []*simulatedCallResult `json:"calls"`
+}
+
+// simulatedCallResult is the result of a simulated call
+type simulatedCallResult struct {
+	ReturnData argBytes            `json:"returnData"`
+	Logs       []*Log              `json:"logs"`
+	GasUsed    argUint64           `json:"gasUsed"`
+	Status     argUint64           `json:"status"`
+	Error      *simulatedCallError `json:"error,omitempty"`
+	Trace      interface{}         `json:"trace,omitempty"`
+}
+
+// simulatedCallError is the error a simulated call failed with
+type simulatedCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// callManyBundle is the transactions executed in a simulated block by eth_callMany
+type callManyBundle struct {
+	Transactions  []*txnArgs      `json:"transactions"`
+	BlockOverride *blockOverrides `json:"blockOverride"`
+}
+
+// callManyResult is the result of a transaction executed by eth_callMany
+type callManyResult struct {
+	Value *argBytes `json:"value,omitempty"`
+	Error string    `json:"error,omitempty"`
+}
+
+// SimulateV1 executes the calls of the blocks in order on top of the given block,
+// every call sees the state changes of the previous calls.
+// The first block is simulated as the block following the given one
+func (e *Eth) SimulateV1(opts *simulateOpts, filter BlockNumberOrHash) (interface{}, error) {
+	if opts == nil || len(opts.BlockStateCalls) == 0 {
+		return nil, ErrNoSimulatedBlocks
+	}
+
+	if len(opts.BlockStateCalls) > maxSimulatedBlocks {
+		return nil, ErrTooManySimulatedBlocks
+	}
+
+	numCalls := 0
+
+	for _, block := range opts.BlockStateCalls {
+		if block != nil {
+			numCalls += len(block.Calls)
+		}
+	}
+
+	if numCalls > maxSimulatedCalls {
+		return nil, ErrTooManySimulatedCalls
+	}
+
+	header, err := GetHeaderFromBlockNumberOrHash(filter, e.store)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		bundles = make([]*state.CallBundle, len(opts.BlockStateCalls))
+		traces  = make([][]tracers.Tracer, len(opts.BlockStateCalls))
+	)
+
+	for i, block := range opts.BlockStateCalls {
+		if block == nil {
+			return nil, fmt.Errorf("block %d is empty", i)
+		}
+
+		calls, err := e.decodeSimulatedCalls(block.Calls)
+		if err != nil {
+			return nil, err
+		}
+
+		if opts.Tracer != nil {
+			traces[i] = make([]tracers.Tracer, len(calls))
+
+			for j, call := range calls {
+				tracer, err := tracers.New(*opts.Tracer, new(tracers.Context), opts.TracerConfig)
+				if err != nil {
+					return nil, err
+				}
+
+				traces[i][j] = tracer
+				call.Tracer = tracer
+			}
+		}
+
+		bundles[i] = &state.CallBundle{
+			BlockOverrides: block.BlockOverrides.toBlockOverrides(),
+			StateOverride:  block.StateOverrides.toStateOverride(),
+			Calls:          calls,
+		}
+	}
+
+	if err := e.store.SimulateCalls(header, bundles, maxSimulatedGas); err != nil {
+		return nil, err
+	}
+
+	results := make([]*simulatedBlockResult, len(bundles))
+
+	for i, bundle := range bundles {
+		block := &simulatedBlockResult{
+			Number:    argUint64(bundle.Header.Number),
+			Timestamp: argUint64(bundle.Header.Timestamp),
+			GasLimit:  argUint64(bundle.Header.GasLimit),
+			GasUsed:   argUint64(bundle.Header.GasUsed),
+			Miner:     types.BytesToAddress(bundle.Header.Miner),
+			Calls:     make([]*simulatedCallResult, len(bundle.Calls)),
+		}
+
+		logIndex := 0
+
+		for j, call := range bundle.Calls {
+			res := toSimulatedCallResult(bundle.Header, j, logIndex, call)
+			logIndex += len(call.Logs)
+
+			if traces[i] != nil {
+				if res.Trace, err = traces[i][j].GetResult(); err != nil {
+					return nil, err
+				}
+			}
+
+			block.Calls[j] = res
+		}
+
+		results[i] = block
+	}
+
+	return results, nil
+}
+
+// CallMany executes the transactions of the bundles in order on top of the given block,
+// every transaction sees the state changes of the previous transactions
+func (e *Eth) CallMany(
+	bundles []*callManyBundle,
+	simulationContext BlockNumberOrHash,
+	stateOverride *stateOverride,
+) (interface{}, error) {
+	if len(bundles) == 0 {
+		return nil, ErrNoSimulatedBlocks
+	}
+
+	if len(bundles) > maxSimulatedBlocks {
+		return nil, ErrTooManySimulatedBlocks
+	}
+
+	numCalls := 0
+
+	for _, bundle := range bundles {
+		if bundle != nil {
+			numCalls += len(bundle.Transactions)
+		}
+	}
+
+	if numCalls > maxSimulatedCalls {
+		return nil, ErrTooManySimulatedCalls
+	}
+
+	header, err := GetHeaderFromBlockNumberOrHash(simulationContext, e.store)
+	if err != nil {
+		return nil, err
+	}
+
+	callBundles := make([]*state.CallBundle, len(bundles))
+
+	for i, bundle := range bundles {
+		if bundle == nil {
+			return nil, fmt.Errorf("bundle %d is empty", i)
+		}
+
+		calls, err := e.decodeSimulatedCalls(bundle.Transactions)
+		if err != nil {
+			return nil, err
+		}
+
+		callBundles[i] = &state.CallBundle{
+			BlockOverrides: bundle.BlockOverride.toBlockOverrides(),
+			Calls:          calls,
+		}
+	}
+
+	// the state is overridden before the first bundle
+	callBundles[0].StateOverride = stateOverride.toStateOverride()
+
+	if err := e.store.SimulateCalls(header, callBundles, maxSimulatedGas); err != nil {
+		return nil, err
+	}
+
+	results := make([][]*callManyResult, len(callBundles))
+
+	for i, bundle := range callBundles {
+		results[i] = make([]*callManyResult, len(bundle.Calls))
+
+		for j, call := range bundle.Calls {
+			res := &callManyResult{}
+
+			switch {
+			case call.Result.Reverted():
+				res.Error = constructErrorFromRevert(call.Result).Error()
+			case call.Result.Failed():
+				res.Error = call.Result.Err.Error()
+			default:
+				res.Value = argBytesPtr(call.Result.ReturnValue)
+			}
+
+			results[i][j] = res
+		}
+	}
+
+	return results, nil
+}
+
+// decodeSimulatedCalls decodes the calls of a simulated block
+func (e *Eth) decodeSimulatedCalls(args []*txnArgs) ([]*state.SimulatedCall, error) {
+	calls := make([]*state.SimulatedCall, len(args))
+
+	for i, arg := range args {
+		if arg == nil {
+			return nil, fmt.Errorf("call %d is empty", i)
+		}
+
+		msg, err := DecodeTxn(arg, e.store)
+		if err != nil {
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+
+		calls[i] = &state.SimulatedCall{Msg: msg}
+	}
+
+	return calls, nil
+}
+
+// toSimulatedCallResult converts the outcome of the call at the index in the simulated block,
+// the logs of the call are indexed from the given one
+func toSimulatedCallResult(
+	header *types.Header,
+	index int,
+	logIndex int,
+	call *state.SimulatedCall,
+) *simulatedCallResult {
+	res := &simulatedCallResult{
+		ReturnData: argBytes(call.Result.ReturnValue),
+		Logs:       make([]*Log, len(call.Logs)),
+		GasUsed:    argUint64(call.Result.GasUsed),
+		Status:     argUint64(types.ReceiptSuccess),
+	}
+
+	for i, log := range call.Logs {
+		res.Logs[i] = &Log{
+			Address:     log.Address,
+			Topics:      log.Topics,
+			Data:        argBytes(log.Data),
+			BlockNumber: argUint64(header.Number),
+			TxHash:      call.Msg.Hash,
+			TxIndex:     argUint64(index),
+			LogIndex:    argUint64(logIndex + i),
+		}
+	}
+
+	if call.Result.Failed() {
+		res.Status = argUint64(types.ReceiptFailed)
+		res.Error = &simulatedCallError{
+			Code:    simulatedCallFailedCode,
+			Message: call.Result.Err.Error(),
+		}
+
+		if call.Result.Reverted() {
+			res.Error.Code = simulatedCallRevertedCode
+			res.Error.Message = constructErrorFromRevert(call.Result).Error()
+		}
+	}
+
+	return res
+}
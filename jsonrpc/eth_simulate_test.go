@@ -0,0 +1,253 @@
+package jsonrpc
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	_ "github.com/0xPolygon/polygon-edge/tracers/native"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// simulateByCallIndex simulates the calls returning the index of the call in the block,
+// the calls with the value are reverted
+func simulateByCallIndex(t *testing.T, revertData []byte) func(*types.Header, []*state.CallBundle, uint64) error {
+	t.Helper()
+
+	return func(header *types.Header, bundles []*state.CallBundle, gasLimit uint64) error {
+		assert.Equal(t, maxSimulatedGas, gasLimit)
+
+		for i, bundle := range bundles {
+			bundle.Header = header.Copy()
+			bundle.Header.Number += uint64(i + 1)
+			bundle.Header.Miner = addr2.Bytes()
+
+			for j, call := range bundle.Calls {
+				call.Result = &runtime.ExecutionResult{
+					ReturnValue: []byte{byte(j)},
+					GasUsed:     100,
+				}
+
+				if call.Msg.Value.Sign() > 0 {
+					call.Result.ReturnValue = revertData
+					call.Result.Err = runtime.ErrExecutionReverted
+
+					continue
+				}
+
+				call.Logs = []*types.Log{
+					{Address: *call.Msg.To, Data: []byte{byte(j)}},
+				}
+
+				bundle.Header.GasUsed += call.Result.GasUsed
+			}
+		}
+
+		return nil
+	}
+}
+
+func TestEth_SimulateV1(t *testing.T) {
+	t.Parallel()
+
+	// Example revert data that has the string "revert reason" as the revert reason
+	revertData, err := hex.DecodeHex("08c379a000000000000000000000000000000000000000000000000000000000000000" +
+		"20000000000000000000000000000000000000000000000000000000000000000d72657665727420726561736f6e" +
+		"00000000000000000000000000000000000000")
+	assert.NoError(t, err)
+
+	t.Run("returns the results of the calls in the blocks", func(t *testing.T) {
+		t.Parallel()
+
+		store := getExampleStore()
+		store.simulateHook = simulateByCallIndex(t, revertData)
+		ethEndpoint := newTestEthEndpoint(store)
+
+		revertedCall := constructMockTx(nil, nil)
+		revertedCall.Value = argBytesPtr([]byte{0x1})
+
+		res, err := ethEndpoint.SimulateV1(&simulateOpts{
+			BlockStateCalls: []*simulateBlock{
+				{
+					Calls: []*txnArgs{constructMockTx(nil, nil), revertedCall},
+				},
+				{
+					StateOverrides: &stateOverride{
+						addr1: overrideAccount{Balance: argBigPtr(big.NewInt(1))},
+					},
+					Calls: []*txnArgs{constructMockTx(nil, nil)},
+				},
+			},
+		}, BlockNumberOrHash{})
+		assert.NoError(t, err)
+
+		blocks, ok := res.([]*simulatedBlockResult)
+		assert.True(t, ok)
+		assert.Len(t, blocks, 2)
+
+		assert.Equal(t, argUint64(1), blocks[0].Number)
+		assert.Equal(t, argUint64(2), blocks[1].Number)
+		assert.Equal(t, argUint64(100), blocks[0].GasUsed)
+		assert.Equal(t, addr2, blocks[0].Miner)
+
+		assert.Len(t, blocks[0].Calls, 2)
+
+		succeeded := blocks[0].Calls[0]
+		assert.Equal(t, argUint64(types.ReceiptSuccess), succeeded.Status)
+		assert.Equal(t, argBytes{0}, succeeded.ReturnData)
+		assert.Nil(t, succeeded.Error)
+		assert.Len(t, succeeded.Logs, 1)
+		assert.Equal(t, addr1, succeeded.Logs[0].Address)
+		assert.Equal(t, argBytes{0}, succeeded.Logs[0].Data)
+		assert.NotEqual(t, types.ZeroHash, succeeded.Logs[0].TxHash)
+
+		reverted := blocks[0].Calls[1]
+		assert.Equal(t, argUint64(types.ReceiptFailed), reverted.Status)
+		assert.Equal(t, &simulatedCallError{
+			Code:    simulatedCallRevertedCode,
+			Message: "execution was reverted: revert reason",
+		}, reverted.Error)
+		assert.Empty(t, reverted.Logs)
+
+		assert.Len(t, blocks[1].Calls, 1)
+		assert.Equal(t, argUint64(2), blocks[1].Calls[0].Logs[0].BlockNumber)
+	})
+
+	t.Run("passes the overrides and the tracers to the simulation", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			store  = getExampleStore()
+			tracer = "callTracer"
+			number = uint64(5)
+		)
+
+		store.simulateHook = func(header *types.Header, bundles []*state.CallBundle, _ uint64) error {
+			assert.Len(t, bundles, 1)
+
+			bundle := bundles[0]
+			assert.Equal(t, &types.BlockOverrides{Number: &number}, bundle.BlockOverrides)
+			assert.Equal(t, types.StateOverride{
+				addr1: types.OverrideAccount{Balance: big.NewInt(1)},
+			}, bundle.StateOverride)
+
+			assert.Len(t, bundle.Calls, 1)
+			assert.NotNil(t, bundle.Calls[0].Tracer)
+
+			return errors.New("simulation stopped")
+		}
+
+		ethEndpoint := newTestEthEndpoint(store)
+
+		_, err := ethEndpoint.SimulateV1(&simulateOpts{
+			BlockStateCalls: []*simulateBlock{
+				{
+					BlockOverrides: &blockOverrides{Number: argUintPtr(number)},
+					StateOverrides: &stateOverride{
+						addr1: overrideAccount{Balance: argBigPtr(big.NewInt(1))},
+					},
+					Calls: []*txnArgs{constructMockTx(nil, nil)},
+				},
+			},
+			Tracer: &tracer,
+		}, BlockNumberOrHash{})
+		assert.EqualError(t, err, "simulation stopped")
+	})
+
+	t.Run("returns an error if the tracer is not found", func(t *testing.T) {
+		t.Parallel()
+
+		ethEndpoint := newTestEthEndpoint(getExampleStore())
+		tracer := "unknown"
+
+		_, err := ethEndpoint.SimulateV1(&simulateOpts{
+			BlockStateCalls: []*simulateBlock{
+				{Calls: []*txnArgs{constructMockTx(nil, nil)}},
+			},
+			Tracer: &tracer,
+		}, BlockNumberOrHash{})
+		assert.Error(t, err)
+	})
+
+	t.Run("returns an error if the number of the blocks is invalid", func(t *testing.T) {
+		t.Parallel()
+
+		ethEndpoint := newTestEthEndpoint(getExampleStore())
+
+		_, err := ethEndpoint.SimulateV1(&simulateOpts{}, BlockNumberOrHash{})
+		assert.ErrorIs(t, err, ErrNoSimulatedBlocks)
+
+		_, err = ethEndpoint.SimulateV1(&simulateOpts{
+			BlockStateCalls: make([]*simulateBlock, maxSimulatedBlocks+1),
+		}, BlockNumberOrHash{})
+		assert.ErrorIs(t, err, ErrTooManySimulatedBlocks)
+	})
+
+	t.Run("returns an error if the number of the calls is invalid", func(t *testing.T) {
+		t.Parallel()
+
+		ethEndpoint := newTestEthEndpoint(getExampleStore())
+
+		_, err := ethEndpoint.SimulateV1(&simulateOpts{
+			BlockStateCalls: []*simulateBlock{
+				{Calls: make([]*txnArgs, maxSimulatedCalls)},
+				{Calls: make([]*txnArgs, 1)},
+			},
+		}, BlockNumberOrHash{})
+		assert.ErrorIs(t, err, ErrTooManySimulatedCalls)
+
+		_, err = ethEndpoint.CallMany([]*callManyBundle{
+			{Transactions: make([]*txnArgs, maxSimulatedCalls+1)},
+		}, BlockNumberOrHash{}, nil)
+		assert.ErrorIs(t, err, ErrTooManySimulatedCalls)
+	})
+}
+
+func TestEth_CallMany(t *testing.T) {
+	t.Parallel()
+
+	revertData, err := hex.DecodeHex("08c379a000000000000000000000000000000000000000000000000000000000000000" +
+		"20000000000000000000000000000000000000000000000000000000000000000d72657665727420726561736f6e" +
+		"00000000000000000000000000000000000000")
+	assert.NoError(t, err)
+
+	store := getExampleStore()
+	store.simulateHook = func(header *types.Header, bundles []*state.CallBundle, gasLimit uint64) error {
+		// the state is overridden before the first bundle only
+		assert.NotNil(t, bundles[0].StateOverride)
+		assert.Nil(t, bundles[1].StateOverride)
+
+		return simulateByCallIndex(t, revertData)(header, bundles, gasLimit)
+	}
+
+	ethEndpoint := newTestEthEndpoint(store)
+
+	revertedCall := constructMockTx(nil, nil)
+	revertedCall.Value = argBytesPtr([]byte{0x1})
+
+	res, err := ethEndpoint.CallMany(
+		[]*callManyBundle{
+			{Transactions: []*txnArgs{constructMockTx(nil, nil), constructMockTx(nil, nil)}},
+			{Transactions: []*txnArgs{revertedCall}},
+		},
+		BlockNumberOrHash{},
+		&stateOverride{
+			addr1: overrideAccount{Balance: argBigPtr(big.NewInt(1))},
+		},
+	)
+	assert.NoError(t, err)
+
+	assert.Equal(t, [][]*callManyResult{
+		{
+			{Value: argBytesPtr([]byte{0})},
+			{Value: argBytesPtr([]byte{1})},
+		},
+		{
+			{Error: "execution was reverted: revert reason"},
+		},
+	}, res)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/umbracle/fastrlp"
@@ -680,7 +681,7 @@ func TestEth_EstimateGas_GasLimit(t *testing.T) {
 			}
 
 			// Run the estimation
-			estimate, estimateErr := ethEndpoint.EstimateGas(testCase.transaction, nil)
+			estimate, estimateErr := ethEndpoint.EstimateGas(testCase.transaction, nil, nil, nil)
 
 			if testCase.expectedError != nil {
 				if estimateErr == nil {
@@ -731,6 +732,8 @@ func TestEth_EstimateGas_Reverts(t *testing.T) {
 	estimate, estimateErr := ethEndpoint.EstimateGas(
 		constructMockTx(nil, nil),
 		nil,
+		nil,
+		nil,
 	)
 
 	assert.Equal(t, 0, estimate)
@@ -757,6 +760,8 @@ func TestEth_EstimateGas_Errors(t *testing.T) {
 	estimate, estimateErr := ethEndpoint.EstimateGas(
 		mockTx,
 		nil,
+		nil,
+		nil,
 	)
 
 	assert.Equal(t, 0, estimate)
@@ -765,12 +770,194 @@ func TestEth_EstimateGas_Errors(t *testing.T) {
 	assert.ErrorIs(t, estimateErr, ErrInsufficientFunds)
 }
 
+func TestEth_EstimateGas_StateOverride(t *testing.T) {
+	store := getExampleStore()
+	ethEndpoint := newTestEthEndpoint(store)
+
+	// Account doesn't have any balance
+	store.account.account.Balance = big.NewInt(0)
+
+	// The transaction has a value > 0
+	mockTx := constructMockTx(nil, nil)
+	mockTx.Value = argBytesPtr([]byte{0x1})
+
+	// The balance is overridden to cover the value
+	override := &stateOverride{
+		addr0: overrideAccount{
+			Balance: argBigPtr(big.NewInt(100)),
+		},
+	}
+
+	estimate, estimateErr := ethEndpoint.EstimateGas(
+		mockTx,
+		nil,
+		override,
+		nil,
+	)
+
+	assert.NoError(t, estimateErr)
+	assert.Equal(t, argUint64(state.TxGas), estimate)
+
+	// Make sure the execution is overridden
+	assert.Equal(t, types.StateOverride{
+		addr0: types.OverrideAccount{
+			Balance: big.NewInt(100),
+		},
+	}, store.stateOverride)
+}
+
+func TestEth_EstimateGas_BlockOverrides(t *testing.T) {
+	store := getExampleStore()
+	ethEndpoint := newTestEthEndpoint(store)
+
+	gasLimit := argUint64(30000)
+	override := &blockOverrides{
+		GasLimit: &gasLimit,
+	}
+
+	// the transaction fails with any gas so that the estimation reports the highest gas limit
+	store.applyTxnHook = func(header *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error) {
+		assert.Equal(t, uint64(gasLimit), header.GasLimit)
+
+		return &runtime.ExecutionResult{
+			Err: runtime.ErrOutOfGas,
+		}, nil
+	}
+
+	_, estimateErr := ethEndpoint.EstimateGas(
+		constructMockTx(nil, nil),
+		nil,
+		nil,
+		override,
+	)
+
+	// the gas limit of the overridden block is the ceiling
+	assert.ErrorIs(t, estimateErr, runtime.ErrOutOfGas)
+	assert.ErrorContains(t, estimateErr, "highest gas limit 30000")
+
+	// Make sure the execution is overridden
+	assert.Equal(t, override.toBlockOverrides(), store.blockOverrides)
+}
+
+func TestEth_CreateAccessList(t *testing.T) {
+	t.Parallel()
+
+	var (
+		slot        = types.StringToHash("1")
+		touchedAddr = types.StringToAddress("a2")
+		listedAddr  = types.StringToAddress("a3")
+	)
+
+	// sload reports the access of the storage slot of the recipient to the tracer
+	sload := func(tracer runtime.EVMLogger) {
+		stack := &runtime.Stack{}
+		stack.UpdateStack([]*big.Int{new(big.Int).SetBytes(slot.Bytes())}, 1)
+
+		tracer.CaptureState(0, int(evm.SLOAD), 0, 0, &runtime.ScopeContext{
+			Stack:    stack,
+			Contract: &runtime.Contract{Address: addr1},
+		}, nil, 1, nil)
+	}
+
+	// balance reports the access of the account to the tracer
+	balance := func(tracer runtime.EVMLogger) {
+		stack := &runtime.Stack{}
+		stack.UpdateStack([]*big.Int{new(big.Int).SetBytes(touchedAddr.Bytes())}, 1)
+
+		tracer.CaptureState(0, int(evm.BALANCE), 0, 0, &runtime.ScopeContext{
+			Stack:    stack,
+			Contract: &runtime.Contract{Address: addr1},
+		}, nil, 1, nil)
+	}
+
+	t.Run("should run until the access list converges", func(t *testing.T) {
+		t.Parallel()
+
+		store := getExampleStore()
+		ethEndpoint := newTestEthEndpoint(store)
+
+		runs := 0
+		store.applyMessageHook = func(txn *types.Transaction, config runtime.TraceConfig) (*runtime.ExecutionResult, error) {
+			runs++
+
+			assert.True(t, config.Debug)
+			assert.Equal(t, store.block.Header.GasLimit, txn.Gas)
+
+			sload(config.Tracer)
+
+			// the account is touched only once the slot is in the list
+			if runs > 1 {
+				balance(config.Tracer)
+			}
+
+			return &runtime.ExecutionResult{GasUsed: 30000}, nil
+		}
+
+		res, err := ethEndpoint.CreateAccessList(constructMockTx(nil, nil), BlockNumberOrHash{})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, runs)
+
+		result, ok := res.(*accessListResult)
+		assert.True(t, ok)
+		assert.Equal(t, argUint64(30000), result.GasUsed)
+		assert.Empty(t, result.Error)
+		assert.ElementsMatch(t, types.AccessList{
+			{Address: addr1, StorageKeys: []types.Hash{slot}},
+			{Address: touchedAddr, StorageKeys: []types.Hash{}},
+		}, result.AccessList)
+	})
+
+	t.Run("should keep the given access list and return the revert", func(t *testing.T) {
+		t.Parallel()
+
+		store := getExampleStore()
+		ethEndpoint := newTestEthEndpoint(store)
+
+		store.applyMessageHook = func(_ *types.Transaction, _ runtime.TraceConfig) (*runtime.ExecutionResult, error) {
+			return &runtime.ExecutionResult{GasUsed: 21000, Err: runtime.ErrExecutionReverted}, nil
+		}
+
+		arg := constructMockTx(nil, nil)
+		arg.AccessList = &types.AccessList{{Address: listedAddr, StorageKeys: []types.Hash{}}}
+
+		res, err := ethEndpoint.CreateAccessList(arg, BlockNumberOrHash{})
+		assert.NoError(t, err)
+
+		result, ok := res.(*accessListResult)
+		assert.True(t, ok)
+		assert.Equal(t, argUint64(21000), result.GasUsed)
+		assert.Equal(t, runtime.ErrExecutionReverted.Error(), result.Error)
+		assert.Equal(t, *arg.AccessList, result.AccessList)
+	})
+
+	t.Run("should return the error of the execution", func(t *testing.T) {
+		t.Parallel()
+
+		store := getExampleStore()
+		ethEndpoint := newTestEthEndpoint(store)
+
+		store.applyMessageHook = func(_ *types.Transaction, _ runtime.TraceConfig) (*runtime.ExecutionResult, error) {
+			return nil, state.ErrNotEnoughIntrinsicGas
+		}
+
+		_, err := ethEndpoint.CreateAccessList(constructMockTx(nil, nil), BlockNumberOrHash{})
+		assert.ErrorIs(t, err, state.ErrNotEnoughIntrinsicGas)
+	})
+}
+
 type mockSpecialStore struct {
 	ethStore
 	account *mockAccount
 	block   *types.Block
 
-	applyTxnHook func(header *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error)
+	applyTxnHook     func(header *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error)
+	applyMessageHook func(txn *types.Transaction, tracer runtime.TraceConfig) (*runtime.ExecutionResult, error)
+	simulateHook     func(header *types.Header, bundles []*state.CallBundle, gasLimit uint64) error
+
+	// the state override of the last execution
+	stateOverride types.StateOverride
+	// the block overrides of the last execution
+	blockOverrides *types.BlockOverrides
 }
 
 func (m *mockSpecialStore) GetBlockByHash(hash types.Hash, full bool) (*types.Block, bool) {
@@ -840,10 +1027,43 @@ func (m *mockSpecialStore) GetForksInTime(blockNumber uint64) chain.ForksInTime
 	return chain.ForksInTime{}
 }
 
-func (m *mockSpecialStore) ApplyTxn(header *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error) {
+func (m *mockSpecialStore) ApplyTxn(
+	header *types.Header,
+	txn *types.Transaction,
+	stateOverride types.StateOverride,
+	blockOverrides *types.BlockOverrides,
+) (*runtime.ExecutionResult, error) {
+	m.stateOverride = stateOverride
+	m.blockOverrides = blockOverrides
+
 	if m.applyTxnHook != nil {
 		return m.applyTxnHook(header, txn)
 	}
 
 	return &runtime.ExecutionResult{}, nil
 }
+
+func (m *mockSpecialStore) ApplyMessage(
+	_ *types.Header,
+	_ *types.Header,
+	txn *types.Transaction,
+	tracer runtime.TraceConfig,
+	stateOverride types.StateOverride,
+	_ *types.BlockOverrides,
+) (*runtime.ExecutionResult, error) {
+	m.stateOverride = stateOverride
+
+	if m.applyMessageHook != nil {
+		return m.applyMessageHook(txn, tracer)
+	}
+
+	return &runtime.ExecutionResult{}, nil
+}
+
+func (m *mockSpecialStore) SimulateCalls(header *types.Header, bundles []*state.CallBundle, gasLimit uint64) error {
+	if m.simulateHook != nil {
+		return m.simulateHook(header, bundles, gasLimit)
+	}
+
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/armon/go-metrics"
 	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 	"github.com/hashicorp/go-hclog"
@@ -217,6 +218,12 @@ type filterManagerStore interface {
 	// Header returns the current header of the chain (genesis if empty)
 	Header() *types.Header
 
+	// GetFinalizedNumber returns the number of the latest finalized block known by the consensus
+	GetFinalizedNumber(latest uint64) uint64
+
+	// GetSafeNumber returns the number of the latest safe block known by the consensus
+	GetSafeNumber(latest uint64) uint64
+
 	// SubscribeEvents subscribes for chain head events
 	SubscribeEvents() blockchain.Subscription
 
@@ -246,6 +253,9 @@ type FilterManager struct {
 	filters  map[string]filter
 	timeouts timeHeapImpl
 
+	// wsFilters is the number of the filters with web socket connection, i.e. the subscriptions
+	wsFilters int
+
 	updateCh chan struct{}
 	closeCh  chan struct{}
 }
@@ -547,6 +557,12 @@ func (f *FilterManager) removeFilterByID(id string) bool {
 
 	delete(f.filters, id)
 
+	if filter.hasWSConn() {
+		f.wsFilters--
+	}
+
+	f.updateFilterMetrics()
+
 	if removed := f.timeouts.removeFilter(filter.getFilterBase()); removed {
 		f.emitSignalToUpdateCh()
 	}
@@ -587,11 +603,21 @@ func (f *FilterManager) addFilter(filter filter) string {
 	// Set timeout and add to heap if filter doesn't have web socket connection
 	if !filter.hasWSConn() {
 		f.addFilterTimeout(base)
+	} else {
+		f.wsFilters++
 	}
 
+	f.updateFilterMetrics()
+
 	return base.id
 }
 
+// updateFilterMetrics updates the metrics of the installed filters [NOT Thread Safe]
+func (f *FilterManager) updateFilterMetrics() {
+	metrics.SetGauge([]string{jsonRPCMetrics, "filters"}, float32(len(f.filters)))
+	metrics.SetGauge([]string{jsonRPCMetrics, "subscriptions"}, float32(f.wsFilters))
+}
+
 func (f *FilterManager) emitSignalToUpdateCh() {
 	select {
 	// notify worker of new filter with timeout
@@ -340,10 +340,15 @@ func TestRemoveFilterByWebsocket(t *testing.T) {
 
 	id := m.NewBlockFilter(mock)
 
+	// the filter without web socket connection is not a subscription
+	m.NewBlockFilter(nil)
+	assert.Equal(t, 1, m.wsFilters)
+
 	m.RemoveFilterByWs(mock)
 
 	// false because filter was removed
 	assert.False(t, m.Exists(id))
+	assert.Equal(t, 0, m.wsFilters)
 }
 
 func Test_flushWsFilters(t *testing.T) {
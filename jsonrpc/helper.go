@@ -16,8 +16,24 @@ var (
 	ErrNoDataInContractCreation = errors.New("contract creation without data provided")
 )
 
+// finalityGetter returns the finality of the chain known by the consensus
+type finalityGetter interface {
+	GetFinalizedNumber(latest uint64) uint64
+	GetSafeNumber(latest uint64) uint64
+}
+
 type latestHeaderGetter interface {
 	Header() *types.Header
+	finalityGetter
+}
+
+// getFinalityBlockNumber returns the number of the block the finalized or safe tag refers to
+func getFinalityBlockNumber(number BlockNumber, latest uint64, store finalityGetter) uint64 {
+	if number == SafeBlockNumber {
+		return store.GetSafeNumber(latest)
+	}
+
+	return store.GetFinalizedNumber(latest)
 }
 
 // GetNumericBlockNumber returns block number based on current state or specified number
@@ -31,6 +47,14 @@ func GetNumericBlockNumber(number BlockNumber, store latestHeaderGetter) (uint64
 
 		return latest.Number, nil
 
+	case FinalizedBlockNumber, SafeBlockNumber:
+		latest := store.Header()
+		if latest == nil {
+			return 0, ErrLatestNotFound
+		}
+
+		return getFinalityBlockNumber(number, latest.Number, store), nil
+
 	case EarliestBlockNumber:
 		return 0, nil
 
@@ -46,6 +70,7 @@ func GetNumericBlockNumber(number BlockNumber, store latestHeaderGetter) (uint64
 type headerGetter interface {
 	Header() *types.Header
 	GetHeaderByNumber(uint64) (*types.Header, bool)
+	finalityGetter
 }
 
 // GetBlockHeader returns a header using the provided number
@@ -62,6 +87,21 @@ func GetBlockHeader(number BlockNumber, store headerGetter) (*types.Header, erro
 
 		return header, nil
 
+	case FinalizedBlockNumber, SafeBlockNumber:
+		latest := store.Header()
+		if latest == nil {
+			return nil, ErrLatestNotFound
+		}
+
+		num := getFinalityBlockNumber(number, latest.Number, store)
+
+		header, ok := store.GetHeaderByNumber(num)
+		if !ok {
+			return nil, fmt.Errorf("error fetching block number %d header", num)
+		}
+
+		return header, nil
+
 	default:
 		// Convert the block number from hex to uint64
 		header, ok := store.GetHeaderByNumber(uint64(number))
@@ -103,6 +143,7 @@ type blockGetter interface {
 	Header() *types.Header
 	GetHeaderByNumber(uint64) (*types.Header, bool)
 	GetBlockByHash(types.Hash, bool) (*types.Block, bool)
+	finalityGetter
 }
 
 func GetHeaderFromBlockNumberOrHash(bnh BlockNumberOrHash, store blockGetter) (*types.Header, error) {
@@ -135,6 +176,7 @@ type nonceGetter interface {
 	GetHeaderByNumber(uint64) (*types.Header, bool)
 	GetNonce(types.Address) uint64
 	GetAccount(root types.Hash, addr types.Address) (*Account, error)
+	finalityGetter
 }
 
 func GetNextNonce(address types.Address, number BlockNumber, store nonceGetter) (uint64, error) {
@@ -61,6 +61,25 @@ var (
 	}
 )
 
+// newFinalityMockStore returns the store finalizing the blocks 5 blocks and the safe blocks 2 blocks behind the latest
+func newFinalityMockStore(
+	latest *types.Header,
+	getHeaderByNumberFn func(uint64) (*types.Header, bool),
+) *debugEndpointMockStore {
+	return &debugEndpointMockStore{
+		headerFn: func() *types.Header {
+			return latest
+		},
+		getHeaderByNumberFn: getHeaderByNumberFn,
+		getFinalizedNumberFn: func(latest uint64) uint64 {
+			return latest - 5
+		},
+		getSafeNumberFn: func(latest uint64) uint64 {
+			return latest - 2
+		},
+	}
+}
+
 func TestGetNumericBlockNumber(t *testing.T) {
 	t.Parallel()
 
@@ -139,9 +158,30 @@ func TestGetNumericBlockNumber(t *testing.T) {
 			expected: 10,
 			err:      nil,
 		},
+		{
+			name:     "should return the finalized block's number if finalized is given",
+			num:      FinalizedBlockNumber,
+			store:    newFinalityMockStore(&types.Header{Number: 10}, nil),
+			expected: 5,
+			err:      nil,
+		},
+		{
+			name:     "should return the safe block's number if safe is given",
+			num:      SafeBlockNumber,
+			store:    newFinalityMockStore(&types.Header{Number: 10}, nil),
+			expected: 8,
+			err:      nil,
+		},
+		{
+			name:     "should return error if given finalized and the latest block's number is not found",
+			num:      FinalizedBlockNumber,
+			store:    newFinalityMockStore(nil, nil),
+			expected: 0,
+			err:      ErrLatestNotFound,
+		},
 		{
 			name:     "should return error if negative number is given",
-			num:      -5,
+			num:      -10,
 			store:    &debugEndpointMockStore{},
 			expected: 0,
 			err:      ErrNegativeBlockNumber,
@@ -227,6 +267,28 @@ func TestGetBlockHeader(t *testing.T) {
 			expected: testLatestHeader,
 			err:      nil,
 		},
+		{
+			name: "should return the finalized header if finalized is given",
+			num:  FinalizedBlockNumber,
+			store: newFinalityMockStore(testLatestHeader, func(num uint64) (*types.Header, bool) {
+				assert.Equal(t, testLatestHeader.Number-5, num)
+
+				return testHeader10, true
+			}),
+			expected: testHeader10,
+			err:      nil,
+		},
+		{
+			name: "should return error if the safe header not found",
+			num:  SafeBlockNumber,
+			store: newFinalityMockStore(testLatestHeader, func(num uint64) (*types.Header, bool) {
+				assert.Equal(t, testLatestHeader.Number-2, num)
+
+				return nil, false
+			}),
+			expected: nil,
+			err:      fmt.Errorf("error fetching block number %d header", testLatestHeader.Number-2),
+		},
 		{
 			name: "should return header at arbitrary height",
 			num:  10,
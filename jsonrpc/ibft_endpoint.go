@@ -0,0 +1,318 @@
+package jsonrpc
+
+import (
+	"errors"
+
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/fork"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/validators"
+	"github.com/0xPolygon/polygon-edge/validators/store"
+)
+
+var (
+	ErrIBFTNotEnabled    = errors.New("the chain doesn't run IBFT consensus")
+	ErrGenesisNoProposer = errors.New("genesis block has no proposer")
+)
+
+// IBFTBackend provides access to the data of the IBFT consensus
+type IBFTBackend interface {
+	// GetIBFTFork returns the IBFT fork active at the given height
+	GetIBFTFork(height uint64) (*fork.IBFTFork, error)
+
+	// GetIBFTValidators returns the validators of the block at the given height
+	// and the number of the committed seals required for the block
+	GetIBFTValidators(height uint64) (validators.Validators, int, error)
+
+	// GetIBFTVotes returns the votes at the given height, nil if the validators are not voted
+	GetIBFTVotes(height uint64) ([]*store.Vote, error)
+
+	// GetIBFTCandidates returns the candidates proposed by the node
+	GetIBFTCandidates() ([]*store.Candidate, error)
+
+	// GetIBFTProposer returns the proposer of the block at the given height and round
+	GetIBFTProposer(height, round uint64) (types.Address, error)
+
+	// GetIBFTSigners returns the proposer of the block and the validators who signed its committed seals
+	GetIBFTSigners(header *types.Header) (types.Address, []types.Address, error)
+
+	// GetIBFTValidatorKey returns the address of the validator key of the node
+	GetIBFTValidatorKey() (types.Address, error)
+}
+
+// ibftStore provides access to the methods needed by ibft endpoint
+type ibftStore interface {
+	// Header returns the current header of the chain (genesis if empty)
+	Header() *types.Header
+
+	// GetHeaderByNumber gets a header using the provided number
+	GetHeaderByNumber(uint64) (*types.Header, bool)
+
+	// GetFinalizedNumber returns the number of the latest finalized block known by the consensus
+	GetFinalizedNumber(latest uint64) uint64
+
+	// GetSafeNumber returns the number of the latest safe block known by the consensus
+	GetSafeNumber(latest uint64) uint64
+
+	// GetBlockByHash gets a block using the provided hash
+	GetBlockByHash(hash types.Hash, full bool) (*types.Block, bool)
+
+	// GetIBFTBackend returns the IBFT consensus, nil if the chain doesn't run IBFT
+	GetIBFTBackend() IBFTBackend
+}
+
+// IBFT is the ibft jsonrpc endpoint
+type IBFT struct {
+	store ibftStore
+}
+
+type ibftValidator struct {
+	Address      types.Address `json:"address"`
+	BLSPublicKey *argBytes     `json:"blsPublicKey,omitempty"`
+}
+
+type ibftVote struct {
+	Validator types.Address  `json:"validator"`
+	Candidate *ibftValidator `json:"candidate"`
+	Authorize bool           `json:"authorize"`
+}
+
+type ibftCandidate struct {
+	Validator *ibftValidator `json:"validator"`
+	Authorize bool           `json:"authorize"`
+}
+
+type ibftSnapshot struct {
+	Number     argUint64        `json:"number"`
+	Hash       types.Hash       `json:"hash"`
+	Fork       *fork.IBFTFork   `json:"fork"`
+	Validators []*ibftValidator `json:"validators"`
+	Quorum     argUint64        `json:"quorum"`
+	Votes      []*ibftVote      `json:"votes"`
+}
+
+type ibftSigners struct {
+	Number     argUint64       `json:"number"`
+	Hash       types.Hash      `json:"hash"`
+	Proposer   types.Address   `json:"proposer"`
+	Committers []types.Address `json:"committers"`
+}
+
+type ibftStatus struct {
+	ValidatorKey types.Address  `json:"validatorKey"`
+	Number       argUint64      `json:"number"`
+	Fork         *fork.IBFTFork `json:"fork"`
+}
+
+func toIBFTValidator(v validators.Validator) *ibftValidator {
+	res := &ibftValidator{
+		Address: v.Addr(),
+	}
+
+	if blsValidator, ok := v.(*validators.BLSValidator); ok {
+		res.BLSPublicKey = argBytesPtr(blsValidator.BLSPublicKey)
+	}
+
+	return res
+}
+
+func toIBFTValidators(vals validators.Validators) []*ibftValidator {
+	res := make([]*ibftValidator, vals.Len())
+
+	for idx := range res {
+		res[idx] = toIBFTValidator(vals.At(uint64(idx)))
+	}
+
+	return res
+}
+
+func toIBFTVotes(votes []*store.Vote) []*ibftVote {
+	res := make([]*ibftVote, len(votes))
+
+	for idx, v := range votes {
+		res[idx] = &ibftVote{
+			Validator: v.Validator,
+			Candidate: toIBFTValidator(v.Candidate),
+			Authorize: v.Authorize,
+		}
+	}
+
+	return res
+}
+
+// GetSnapshot returns the validators, the votes and the quorum at the given block
+func (i *IBFT) GetSnapshot(filter BlockNumberOrHash) (interface{}, error) {
+	backend, header, err := i.getBackendAndHeader(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	activeFork, err := backend.GetIBFTFork(header.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	vals, quorum, err := backend.GetIBFTValidators(header.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	votes, err := backend.GetIBFTVotes(header.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ibftSnapshot{
+		Number:     argUint64(header.Number),
+		Hash:       header.Hash,
+		Fork:       activeFork,
+		Validators: toIBFTValidators(vals),
+		Quorum:     argUint64(quorum),
+		Votes:      toIBFTVotes(votes),
+	}, nil
+}
+
+// GetValidators returns the validators at the given block
+func (i *IBFT) GetValidators(filter BlockNumberOrHash) (interface{}, error) {
+	backend, header, err := i.getBackendAndHeader(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	vals, _, err := backend.GetIBFTValidators(header.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	return toIBFTValidators(vals), nil
+}
+
+// GetCandidates returns the validator candidates proposed by the node
+func (i *IBFT) GetCandidates() (interface{}, error) {
+	backend, err := i.getBackend()
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := backend.GetIBFTCandidates()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*ibftCandidate, len(candidates))
+
+	for idx, c := range candidates {
+		res[idx] = &ibftCandidate{
+			Validator: toIBFTValidator(c.Validator),
+			Authorize: c.Authorize,
+		}
+	}
+
+	return res, nil
+}
+
+// GetProposer returns the proposer of the block at the given height and round,
+// the pending block is the next block to be proposed
+func (i *IBFT) GetProposer(number BlockNumber, round *argUint64) (interface{}, error) {
+	backend, err := i.getBackend()
+	if err != nil {
+		return nil, err
+	}
+
+	var height uint64
+
+	if number == PendingBlockNumber {
+		height = i.store.Header().Number + 1
+	} else if height, err = GetNumericBlockNumber(number, i.store); err != nil {
+		return nil, err
+	}
+
+	if height == 0 {
+		return nil, ErrGenesisNoProposer
+	}
+
+	var r uint64
+	if round != nil {
+		r = uint64(*round)
+	}
+
+	return backend.GetIBFTProposer(height, r)
+}
+
+// GetSigners returns the proposer of the given block and the validators who signed its committed seals
+func (i *IBFT) GetSigners(filter BlockNumberOrHash) (interface{}, error) {
+	backend, header, err := i.getBackendAndHeader(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	proposer, committers, err := backend.GetIBFTSigners(header)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ibftSigners{
+		Number:     argUint64(header.Number),
+		Hash:       header.Hash,
+		Proposer:   proposer,
+		Committers: committers,
+	}, nil
+}
+
+// GetFork returns the IBFT fork active at the given block
+func (i *IBFT) GetFork(filter BlockNumberOrHash) (interface{}, error) {
+	backend, header, err := i.getBackendAndHeader(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return backend.GetIBFTFork(header.Number)
+}
+
+// Status returns the validator key of the node and the IBFT fork of the next block
+func (i *IBFT) Status() (interface{}, error) {
+	backend, err := i.getBackend()
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := backend.GetIBFTValidatorKey()
+	if err != nil {
+		return nil, err
+	}
+
+	latest := i.store.Header().Number
+
+	activeFork, err := backend.GetIBFTFork(latest + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ibftStatus{
+		ValidatorKey: key,
+		Number:       argUint64(latest),
+		Fork:         activeFork,
+	}, nil
+}
+
+func (i *IBFT) getBackend() (IBFTBackend, error) {
+	backend := i.store.GetIBFTBackend()
+	if backend == nil {
+		return nil, ErrIBFTNotEnabled
+	}
+
+	return backend, nil
+}
+
+func (i *IBFT) getBackendAndHeader(filter BlockNumberOrHash) (IBFTBackend, *types.Header, error) {
+	backend, err := i.getBackend()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	header, err := GetHeaderFromBlockNumberOrHash(filter, i.store)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return backend, header, nil
+}
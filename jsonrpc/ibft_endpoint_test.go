@@ -0,0 +1,352 @@
+package jsonrpc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/consensus/ibft/fork"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/validators"
+	"github.com/0xPolygon/polygon-edge/validators/store"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	ibftTestValidator1 = validators.NewECDSAValidator(types.StringToAddress("1"))
+	ibftTestValidator2 = validators.NewECDSAValidator(types.StringToAddress("2"))
+	ibftTestValidator3 = validators.NewECDSAValidator(types.StringToAddress("3"))
+
+	ibftTestFork = &fork.IBFTFork{
+		Type:          fork.PoA,
+		ValidatorType: validators.ECDSAValidatorType,
+	}
+
+	errIBFTTest = errors.New("test error")
+)
+
+type ibftEndpointMockStore struct {
+	headers []*types.Header
+	backend IBFTBackend
+}
+
+func newIBFTEndpointMockStore(latest uint64, backend IBFTBackend) *ibftEndpointMockStore {
+	headers := make([]*types.Header, latest+1)
+
+	for idx := range headers {
+		headers[idx] = &types.Header{
+			Number: uint64(idx),
+			Hash:   types.BytesToHash([]byte{byte(idx + 1)}),
+		}
+	}
+
+	return &ibftEndpointMockStore{
+		headers: headers,
+		backend: backend,
+	}
+}
+
+func (s *ibftEndpointMockStore) Header() *types.Header {
+	return s.headers[len(s.headers)-1]
+}
+
+func (s *ibftEndpointMockStore) GetHeaderByNumber(num uint64) (*types.Header, bool) {
+	if num >= uint64(len(s.headers)) {
+		return nil, false
+	}
+
+	return s.headers[num], true
+}
+
+func (s *ibftEndpointMockStore) GetBlockByHash(hash types.Hash, full bool) (*types.Block, bool) {
+	for _, h := range s.headers {
+		if h.Hash == hash {
+			return &types.Block{Header: h}, true
+		}
+	}
+
+	return nil, false
+}
+
+// GetFinalizedNumber returns the latest number as all the blocks are finalized in IBFT
+func (s *ibftEndpointMockStore) GetFinalizedNumber(latest uint64) uint64 {
+	return latest
+}
+
+// GetSafeNumber returns the latest number as all the blocks are finalized in IBFT
+func (s *ibftEndpointMockStore) GetSafeNumber(latest uint64) uint64 {
+	return latest
+}
+
+func (s *ibftEndpointMockStore) GetIBFTBackend() IBFTBackend {
+	return s.backend
+}
+
+type mockIBFTBackend struct {
+	getIBFTForkFn         func(uint64) (*fork.IBFTFork, error)
+	getIBFTValidatorsFn   func(uint64) (validators.Validators, int, error)
+	getIBFTVotesFn        func(uint64) ([]*store.Vote, error)
+	getIBFTCandidatesFn   func() ([]*store.Candidate, error)
+	getIBFTProposerFn     func(uint64, uint64) (types.Address, error)
+	getIBFTSignersFn      func(*types.Header) (types.Address, []types.Address, error)
+	getIBFTValidatorKeyFn func() (types.Address, error)
+}
+
+func (m *mockIBFTBackend) GetIBFTFork(height uint64) (*fork.IBFTFork, error) {
+	return m.getIBFTForkFn(height)
+}
+
+func (m *mockIBFTBackend) GetIBFTValidators(height uint64) (validators.Validators, int, error) {
+	return m.getIBFTValidatorsFn(height)
+}
+
+func (m *mockIBFTBackend) GetIBFTVotes(height uint64) ([]*store.Vote, error) {
+	return m.getIBFTVotesFn(height)
+}
+
+func (m *mockIBFTBackend) GetIBFTCandidates() ([]*store.Candidate, error) {
+	return m.getIBFTCandidatesFn()
+}
+
+func (m *mockIBFTBackend) GetIBFTProposer(height, round uint64) (types.Address, error) {
+	return m.getIBFTProposerFn(height, round)
+}
+
+func (m *mockIBFTBackend) GetIBFTSigners(header *types.Header) (types.Address, []types.Address, error) {
+	return m.getIBFTSignersFn(header)
+}
+
+func (m *mockIBFTBackend) GetIBFTValidatorKey() (types.Address, error) {
+	return m.getIBFTValidatorKeyFn()
+}
+
+func TestIBFTEndpointNotEnabled(t *testing.T) {
+	t.Parallel()
+
+	endpoint := &IBFT{newIBFTEndpointMockStore(3, nil)}
+
+	_, err := endpoint.GetSnapshot(BlockNumberOrHash{})
+	assert.ErrorIs(t, err, ErrIBFTNotEnabled)
+
+	_, err = endpoint.GetCandidates()
+	assert.ErrorIs(t, err, ErrIBFTNotEnabled)
+
+	_, err = endpoint.GetProposer(PendingBlockNumber, nil)
+	assert.ErrorIs(t, err, ErrIBFTNotEnabled)
+
+	_, err = endpoint.Status()
+	assert.ErrorIs(t, err, ErrIBFTNotEnabled)
+}
+
+func TestIBFTEndpointGetSnapshot(t *testing.T) {
+	t.Parallel()
+
+	num := BlockNumber(2)
+
+	tests := []struct {
+		name     string
+		filter   BlockNumberOrHash
+		votesErr error
+		expected *ibftSnapshot
+		err      error
+	}{
+		{
+			name:   "should return the snapshot of the latest block by default",
+			filter: BlockNumberOrHash{},
+			expected: &ibftSnapshot{
+				Number: 3,
+				Hash:   types.BytesToHash([]byte{4}),
+				Fork:   ibftTestFork,
+				Validators: []*ibftValidator{
+					{Address: ibftTestValidator1.Address},
+					{Address: ibftTestValidator2.Address},
+				},
+				Quorum: 2,
+				Votes: []*ibftVote{
+					{
+						Validator: ibftTestValidator1.Address,
+						Candidate: &ibftValidator{Address: ibftTestValidator3.Address},
+						Authorize: true,
+					},
+				},
+			},
+		},
+		{
+			name:   "should return the snapshot of the given block",
+			filter: BlockNumberOrHash{BlockNumber: &num},
+			expected: &ibftSnapshot{
+				Number: 2,
+				Hash:   types.BytesToHash([]byte{3}),
+				Fork:   ibftTestFork,
+				Validators: []*ibftValidator{
+					{Address: ibftTestValidator1.Address},
+					{Address: ibftTestValidator2.Address},
+				},
+				Quorum: 2,
+				Votes: []*ibftVote{
+					{
+						Validator: ibftTestValidator1.Address,
+						Candidate: &ibftValidator{Address: ibftTestValidator3.Address},
+						Authorize: true,
+					},
+				},
+			},
+		},
+		{
+			name:     "should return error if the votes can't be fetched",
+			filter:   BlockNumberOrHash{},
+			votesErr: errIBFTTest,
+			err:      errIBFTTest,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			endpoint := &IBFT{newIBFTEndpointMockStore(3, &mockIBFTBackend{
+				getIBFTForkFn: func(u uint64) (*fork.IBFTFork, error) {
+					return ibftTestFork, nil
+				},
+				getIBFTValidatorsFn: func(u uint64) (validators.Validators, int, error) {
+					return validators.NewECDSAValidatorSet(ibftTestValidator1, ibftTestValidator2), 2, nil
+				},
+				getIBFTVotesFn: func(u uint64) ([]*store.Vote, error) {
+					if test.votesErr != nil {
+						return nil, test.votesErr
+					}
+
+					return []*store.Vote{
+						{
+							Validator: ibftTestValidator1.Address,
+							Candidate: ibftTestValidator3,
+							Authorize: true,
+						},
+					}, nil
+				},
+			})}
+
+			res, err := endpoint.GetSnapshot(test.filter)
+
+			assert.ErrorIs(t, err, test.err)
+
+			if test.expected != nil {
+				assert.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
+
+func TestIBFTEndpointGetProposer(t *testing.T) {
+	t.Parallel()
+
+	round := argUint64(2)
+
+	tests := []struct {
+		name           string
+		number         BlockNumber
+		round          *argUint64
+		expectedHeight uint64
+		expectedRound  uint64
+		err            error
+	}{
+		{
+			name:           "should return the proposer of the next block for pending",
+			number:         PendingBlockNumber,
+			expectedHeight: 4,
+			expectedRound:  0,
+		},
+		{
+			name:           "should return the proposer of the given height and round",
+			number:         BlockNumber(2),
+			round:          &round,
+			expectedHeight: 2,
+			expectedRound:  2,
+		},
+		{
+			name:   "should return error for genesis",
+			number: EarliestBlockNumber,
+			err:    ErrGenesisNoProposer,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			endpoint := &IBFT{newIBFTEndpointMockStore(3, &mockIBFTBackend{
+				getIBFTProposerFn: func(height, round uint64) (types.Address, error) {
+					assert.Equal(t, test.expectedHeight, height)
+					assert.Equal(t, test.expectedRound, round)
+
+					return ibftTestValidator2.Address, nil
+				},
+			})}
+
+			res, err := endpoint.GetProposer(test.number, test.round)
+
+			assert.ErrorIs(t, err, test.err)
+
+			if test.err == nil {
+				assert.Equal(t, ibftTestValidator2.Address, res)
+			}
+		})
+	}
+}
+
+func TestIBFTEndpointGetSigners(t *testing.T) {
+	t.Parallel()
+
+	hash := types.BytesToHash([]byte{2})
+
+	endpoint := &IBFT{newIBFTEndpointMockStore(3, &mockIBFTBackend{
+		getIBFTSignersFn: func(header *types.Header) (types.Address, []types.Address, error) {
+			assert.Equal(t, uint64(1), header.Number)
+
+			return ibftTestValidator1.Address, []types.Address{
+				ibftTestValidator1.Address,
+				ibftTestValidator2.Address,
+			}, nil
+		},
+	})}
+
+	res, err := endpoint.GetSigners(BlockNumberOrHash{BlockHash: &hash})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &ibftSigners{
+		Number:   1,
+		Hash:     hash,
+		Proposer: ibftTestValidator1.Address,
+		Committers: []types.Address{
+			ibftTestValidator1.Address,
+			ibftTestValidator2.Address,
+		},
+	}, res)
+}
+
+func TestIBFTEndpointStatus(t *testing.T) {
+	t.Parallel()
+
+	endpoint := &IBFT{newIBFTEndpointMockStore(3, &mockIBFTBackend{
+		getIBFTForkFn: func(height uint64) (*fork.IBFTFork, error) {
+			assert.Equal(t, uint64(4), height)
+
+			return ibftTestFork, nil
+		},
+		getIBFTValidatorKeyFn: func() (types.Address, error) {
+			return ibftTestValidator3.Address, nil
+		},
+	})}
+
+	res, err := endpoint.Status()
+
+	assert.NoError(t, err)
+	assert.Equal(t, &ibftStatus{
+		ValidatorKey: ibftTestValidator3.Address,
+		Number:       3,
+		Fork:         ibftTestFork,
+	}, res)
+}
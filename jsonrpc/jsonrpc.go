@@ -9,6 +9,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/health"
 	"github.com/0xPolygon/polygon-edge/versioning"
 	"github.com/gorilla/websocket"
 	"github.com/hashicorp/go-hclog"
@@ -56,6 +57,7 @@ type JSONRPCStore interface {
 	txPoolStore
 	filterManagerStore
 	debugStore
+	ibftStore
 }
 
 type Config struct {
@@ -67,6 +69,10 @@ type Config struct {
 	PriceLimit               uint64
 	BatchLengthLimit         uint64
 	BlockRangeLimit          uint64
+
+	// Health runs the readiness checks of the /ready endpoint,
+	// the endpoint is not served if it's not set
+	Health *health.Checker
 }
 
 // NewJSONRPC returns the JSONRPC http server
@@ -114,6 +120,12 @@ func (j *JSONRPC) setupHTTP() error {
 
 	mux.HandleFunc("/ws", j.handleWs)
 
+	mux.HandleFunc("/health", j.handleHealth)
+
+	if j.config.Health != nil {
+		mux.HandleFunc("/ready", j.handleReady)
+	}
+
 	srv := http.Server{
 		Handler:           mux,
 		ReadHeaderTimeout: 60 * time.Second,
@@ -329,3 +341,43 @@ func (j *JSONRPC) handleGetRequest(writer io.Writer) {
 		_, _ = writer.Write([]byte(err.Error()))
 	}
 }
+
+// HealthResponse is the response of the /health endpoint
+type HealthResponse struct {
+	Alive bool `json:"alive"`
+}
+
+// handleHealth reports the node process is alive
+func (j *JSONRPC) handleHealth(w http.ResponseWriter, _ *http.Request) {
+	j.writeJSON(w, http.StatusOK, &HealthResponse{Alive: true})
+}
+
+// handleReady reports the results of the readiness checks,
+// the response status is 503 if any of the checks has failed
+func (j *JSONRPC) handleReady(w http.ResponseWriter, _ *http.Request) {
+	status := j.config.Health.Readiness()
+
+	code := http.StatusOK
+	if !status.Ready {
+		code = http.StatusServiceUnavailable
+	}
+
+	j.writeJSON(w, code, status)
+}
+
+// writeJSON writes the json encoded response with the given status code
+func (j *JSONRPC) writeJSON(w http.ResponseWriter, code int, data interface{}) {
+	resp, err := json.Marshal(data)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	if _, err := w.Write(resp); err != nil {
+		j.logger.Error("unable to write the response", "err", err)
+	}
+}
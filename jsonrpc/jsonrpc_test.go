@@ -4,9 +4,15 @@ import (
 	"bytes"
 	"encoding/json"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/0xPolygon/polygon-edge/health"
+	"github.com/0xPolygon/polygon-edge/helper/progress"
 	"github.com/0xPolygon/polygon-edge/helper/tests"
+	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/0xPolygon/polygon-edge/versioning"
 	"github.com/stretchr/testify/assert"
 
@@ -66,3 +72,89 @@ func Test_handleGetRequest(t *testing.T) {
 		response,
 	)
 }
+
+type mockHealthStore struct {
+	peers int
+}
+
+func (m *mockHealthStore) GetPeers() int {
+	return m.peers
+}
+
+func (m *mockHealthStore) GetSyncProgression() *progress.Progression {
+	return nil
+}
+
+func (m *mockHealthStore) Header() *types.Header {
+	return &types.Header{Number: 1, Timestamp: uint64(time.Now().Unix())}
+}
+
+func (m *mockHealthStore) GetCapacity() (uint64, uint64) {
+	return 0, 100
+}
+
+func (m *mockHealthStore) GetMaxBlockInterval(uint64) time.Duration {
+	return 0
+}
+
+func Test_handleHealth(t *testing.T) {
+	jsonRPC := &JSONRPC{
+		logger: hclog.NewNullLogger(),
+		config: &Config{},
+	}
+
+	recorder := httptest.NewRecorder()
+	jsonRPC.handleHealth(recorder, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"alive": true}`, recorder.Body.String())
+}
+
+func Test_handleReady(t *testing.T) {
+	testTable := []struct {
+		name  string
+		peers int
+		code  int
+	}{
+		{
+			"node is ready",
+			1,
+			http.StatusOK,
+		},
+		{
+			"node without peers is not ready",
+			0,
+			http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			jsonRPC := &JSONRPC{
+				logger: hclog.NewNullLogger(),
+				config: &Config{
+					Health: health.NewChecker(&mockHealthStore{peers: testCase.peers}, &health.Config{
+						MinPeers:    1,
+						MaxBlockAge: time.Minute,
+					}),
+				},
+			}
+
+			recorder := httptest.NewRecorder()
+			jsonRPC.handleReady(recorder, httptest.NewRequest(http.MethodGet, "/ready", nil))
+
+			assert.Equal(t, testCase.code, recorder.Code)
+			assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+
+			status := &health.Status{}
+
+			assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), status))
+			assert.Equal(t, testCase.code == http.StatusOK, status.Ready)
+			assert.Len(t, status.Checks, 4)
+			assert.Equal(t, health.PeersCheck, status.Checks[0].Name)
+			assert.Equal(t, testCase.peers > 0, status.Checks[0].Passed)
+		})
+	}
+}
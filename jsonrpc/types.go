@@ -315,6 +315,90 @@ type txnArgs struct {
 	Data     *argBytes
 	Input    *argBytes
 	Nonce    *argUint64
+
+	AccessList *types.AccessList
+}
+
+// overrideAccount is the fields of an account overridden before a call is executed
+type overrideAccount struct {
+	Nonce     *argUint64                 `json:"nonce"`
+	Code      *argBytes                  `json:"code"`
+	Balance   *argBig                    `json:"balance"`
+	State     *map[types.Hash]types.Hash `json:"state"`
+	StateDiff *map[types.Hash]types.Hash `json:"stateDiff"`
+}
+
+// stateOverride is the set of the accounts overridden before a call is executed
+type stateOverride map[types.Address]overrideAccount
+
+// toStateOverride converts the overrides to the ones the state applies
+func (s *stateOverride) toStateOverride() types.StateOverride {
+	if s == nil {
+		return nil
+	}
+
+	override := make(types.StateOverride, len(*s))
+
+	for addr, account := range *s {
+		acc := types.OverrideAccount{}
+
+		if account.Nonce != nil {
+			nonce := uint64(*account.Nonce)
+			acc.Nonce = &nonce
+		}
+
+		if account.Code != nil {
+			acc.Code = []byte(*account.Code)
+		}
+
+		if account.Balance != nil {
+			acc.Balance = new(big.Int).Set((*big.Int)(account.Balance))
+		}
+
+		if account.State != nil {
+			acc.State = *account.State
+		}
+
+		if account.StateDiff != nil {
+			acc.StateDiff = *account.StateDiff
+		}
+
+		override[addr] = acc
+	}
+
+	return override
+}
+
+// blockOverrides is the fields of the block overridden before a call is executed
+type blockOverrides struct {
+	Number   *argUint64     `json:"number"`
+	Time     *argUint64     `json:"time"`
+	Coinbase *types.Address `json:"coinbase"`
+	GasLimit *argUint64     `json:"gasLimit"`
+}
+
+// toBlockOverrides converts the overrides to the ones applied to the header
+func (b *blockOverrides) toBlockOverrides() *types.BlockOverrides {
+	if b == nil {
+		return nil
+	}
+
+	toUint64Ptr := func(v *argUint64) *uint64 {
+		if v == nil {
+			return nil
+		}
+
+		u := uint64(*v)
+
+		return &u
+	}
+
+	return &types.BlockOverrides{
+		Number:    toUint64Ptr(b.Number),
+		Timestamp: toUint64Ptr(b.Time),
+		Coinbase:  b.Coinbase,
+		GasLimit:  toUint64Ptr(b.GasLimit),
+	}
 }
 
 type progression struct {
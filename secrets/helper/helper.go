@@ -15,6 +15,8 @@ import (
 	"github.com/0xPolygon/polygon-edge/secrets/gcpssm"
 	"github.com/0xPolygon/polygon-edge/secrets/hashicorpvault"
 	"github.com/0xPolygon/polygon-edge/secrets/local"
+	"github.com/0xPolygon/polygon-edge/secrets/pkcs11"
+	"github.com/0xPolygon/polygon-edge/secrets/remotesigner"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
 	libp2pCrypto "github.com/libp2p/go-libp2p/core/crypto"
@@ -34,6 +36,29 @@ func SetupLocalSecretsManager(dataDir string) (secrets.SecretsManager, error) {
 	)
 }
 
+// SetupLocalSecretsManagerWithPassword is a helper method for boilerplate local secrets manager setup
+// with keystore encryption. If the password is empty, it is read from the password file,
+// the environment or the terminal once it's needed
+func SetupLocalSecretsManagerWithPassword(
+	dataDir string,
+	encrypted bool,
+	password string,
+	passwordFile string,
+) (secrets.SecretsManager, error) {
+	return local.SecretsManagerFactory(
+		nil, // Local secrets manager doesn't require a config
+		&secrets.SecretsManagerParams{
+			Logger: hclog.NewNullLogger(),
+			Extra: map[string]interface{}{
+				secrets.Path:         dataDir,
+				secrets.Encrypted:    encrypted,
+				secrets.Password:     password,
+				secrets.PasswordFile: passwordFile,
+			},
+		},
+	)
+}
+
 // setupHashicorpVault is a helper method for boilerplate hashicorp vault secrets manager setup
 func setupHashicorpVault(
 	secretsConfig *secrets.SecretsManagerConfig,
@@ -98,12 +123,57 @@ func SetupAwsKms(
 	)
 }
 
+// SetupPKCS11 is a helper method for boilerplate PKCS#11 secrets manager setup
+func SetupPKCS11(
+	secretsConfig *secrets.SecretsManagerConfig, dataDir string,
+) (secrets.SecretsManager, error) {
+	return pkcs11.SecretsManagerFactory(
+		secretsConfig,
+		&secrets.SecretsManagerParams{
+			Logger: hclog.NewNullLogger(),
+			Extra: map[string]interface{}{
+				secrets.Path: dataDir,
+			},
+		},
+	)
+}
+
+// SetupRemoteSigner is a helper method for boilerplate remote signer secrets manager setup
+func SetupRemoteSigner(
+	secretsConfig *secrets.SecretsManagerConfig, dataDir string,
+) (secrets.SecretsManager, error) {
+	return remotesigner.SecretsManagerFactory(
+		secretsConfig,
+		&secrets.SecretsManagerParams{
+			Logger: hclog.NewNullLogger(),
+			Extra: map[string]interface{}{
+				secrets.Path: dataDir,
+			},
+		},
+	)
+}
+
 // InitECDSAValidatorKey creates new ECDSA key and set as a validator key
 func InitECDSAValidatorKey(secretsManager secrets.SecretsManager) (types.Address, error) {
 	if secretsManager.HasSecret(secrets.ValidatorKey) {
 		return types.ZeroAddress, fmt.Errorf(`secrets "%s" has been already initialized`, secrets.ValidatorKey)
 	}
 
+	// Let the secrets manager generate the key if it supports it,
+	// so the private key never leaves the backend
+	if generator, ok := secretsManager.(secrets.SecretGenerator); ok {
+		if err := generator.GenerateSecret(secrets.ValidatorKey); err != nil {
+			return types.ZeroAddress, err
+		}
+
+		info, err := secretsManager.GetSecretInfo(secrets.ValidatorKey)
+		if err != nil {
+			return types.ZeroAddress, err
+		}
+
+		return types.StringToAddress(info.Address), nil
+	}
+
 	validatorKey, validatorKeyEncoded, err := crypto.GenerateAndEncodeECDSAPrivateKey()
 	if err != nil {
 		return types.ZeroAddress, err
@@ -172,8 +242,16 @@ func InitNetworkingPrivateKey(secretsManager secrets.SecretsManager) (libp2pCryp
 
 // LoadValidatorAddress loads ECDSA key by SecretsManager and returns validator address
 func LoadValidatorAddress(secretsManager secrets.SecretsManager) (types.Address, error) {
-	if k, ok := secretsManager.(*awskms.KmsSecretManager); ok {
-		info, _ := k.GetSecretInfo(secrets.ValidatorKey)
+	if secrets.IsRemoteSigner(secretsManager.GetSecretsManagerType()) {
+		if !secretsManager.HasSecret(secrets.ValidatorKey) {
+			return types.ZeroAddress, nil
+		}
+
+		info, err := secretsManager.GetSecretInfo(secrets.ValidatorKey)
+		if err != nil {
+			return types.ZeroAddress, err
+		}
+
 		return types.StringToAddress(info.Address), nil
 	}
 
@@ -242,6 +320,46 @@ func LoadNodeID(secretsManager secrets.SecretsManager) (string, error) {
 	return nodeID.String(), nil
 }
 
+// GetSecretIdentity parses the hex encoded secret and returns its public identity:
+// the address for the validator key, the public key for the BLS key and the node ID for the network key
+func GetSecretIdentity(name string, encodedKey []byte) (string, error) {
+	switch name {
+	case secrets.ValidatorKey:
+		privateKey, err := crypto.BytesToECDSAPrivateKey(encodedKey)
+		if err != nil {
+			return "", err
+		}
+
+		return crypto.PubKeyToAddress(&privateKey.PublicKey).String(), nil
+	case secrets.ValidatorBLSKey:
+		secretKey, err := crypto.BytesToBLSSecretKey(encodedKey)
+		if err != nil {
+			return "", err
+		}
+
+		pubkeyBytes, err := crypto.BLSSecretKeyToPubkeyBytes(secretKey)
+		if err != nil {
+			return "", err
+		}
+
+		return hex.EncodeToHex(pubkeyBytes), nil
+	case secrets.NetworkKey:
+		parsedKey, err := network.ParseLibp2pKey(encodedKey)
+		if err != nil {
+			return "", err
+		}
+
+		nodeID, err := peer.IDFromPrivateKey(parsedKey)
+		if err != nil {
+			return "", err
+		}
+
+		return nodeID.String(), nil
+	default:
+		return "", secrets.ErrSecretNotFound
+	}
+}
+
 // GetCloudSecretsManager returns the cloud secrets manager from the provided config
 func InitCloudSecretsManager(secretsConfig *secrets.SecretsManagerConfig, dataDir string) (secrets.SecretsManager, error) {
 	var secretsManager secrets.SecretsManager
@@ -275,6 +393,20 @@ func InitCloudSecretsManager(secretsConfig *secrets.SecretsManagerConfig, dataDi
 		}
 
 		secretsManager = AwsKms
+	case secrets.PKCS11:
+		PKCS11, err := SetupPKCS11(secretsConfig, dataDir)
+		if err != nil {
+			return secretsManager, err
+		}
+
+		secretsManager = PKCS11
+	case secrets.RemoteSigner:
+		RemoteSigner, err := SetupRemoteSigner(secretsConfig, dataDir)
+		if err != nil {
+			return secretsManager, err
+		}
+
+		secretsManager = RemoteSigner
 	default:
 		return secretsManager, errors.New("unsupported secrets manager")
 	}
@@ -0,0 +1,77 @@
+package helper
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSecretIdentity(t *testing.T) {
+	t.Parallel()
+
+	validatorKey, validatorKeyEncoded, err := crypto.GenerateAndEncodeECDSAPrivateKey()
+	require.NoError(t, err)
+
+	blsKey, blsKeyEncoded, err := crypto.GenerateAndEncodeBLSSecretKey()
+	require.NoError(t, err)
+
+	blsPubkey, err := crypto.BLSSecretKeyToPubkeyBytes(blsKey)
+	require.NoError(t, err)
+
+	networkKey, networkKeyEncoded, err := network.GenerateAndEncodeLibp2pKey()
+	require.NoError(t, err)
+
+	nodeID, err := peer.IDFromPrivateKey(networkKey)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		secret   string
+		value    []byte
+		expected string
+	}{
+		{
+			name:     "validator key",
+			secret:   secrets.ValidatorKey,
+			value:    validatorKeyEncoded,
+			expected: crypto.PubKeyToAddress(&validatorKey.PublicKey).String(),
+		},
+		{
+			name:     "BLS key",
+			secret:   secrets.ValidatorBLSKey,
+			value:    blsKeyEncoded,
+			expected: hex.EncodeToHex(blsPubkey),
+		},
+		{
+			name:     "network key",
+			secret:   secrets.NetworkKey,
+			value:    networkKeyEncoded,
+			expected: nodeID.String(),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			identity, err := GetSecretIdentity(test.secret, test.value)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, identity)
+		})
+	}
+
+	_, err = GetSecretIdentity(secrets.ValidatorKey, []byte("invalid"))
+	assert.Error(t, err)
+
+	_, err = GetSecretIdentity("unknown", validatorKeyEncoded)
+	assert.ErrorIs(t, err, secrets.ErrSecretNotFound)
+}
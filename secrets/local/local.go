@@ -1,17 +1,30 @@
 package local
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"sync"
 
+	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/keystore"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/hashicorp/go-hclog"
 )
 
+// secretFilePerm is the permission of the secret files, readable only by the owner
+const secretFilePerm = 0600
+
+// Scrypt parameters used for encrypting new secrets,
+// overridden in tests to speed up encryption
+var (
+	scryptN = keystore.StandardScryptN
+	scryptP = keystore.StandardScryptP
+)
+
 // LocalSecretsManager is a SecretsManager that
 // stores secrets locally on disk
 type LocalSecretsManager struct {
@@ -26,6 +39,18 @@ type LocalSecretsManager struct {
 
 	// Mux for the secretPathMap
 	secretPathMapLock sync.RWMutex
+
+	// Flag indicating whether new secrets are written as encrypted keystore files
+	encrypted bool
+
+	// Path to the file containing the keystore password
+	passwordFile string
+
+	// Keystore password, resolved on first use
+	password string
+
+	// Mux for the password
+	passwordLock sync.Mutex
 }
 
 // SecretsManagerFactory implements the factory method
@@ -50,6 +75,25 @@ func SecretsManagerFactory(
 		return nil, errors.New("invalid type assertion")
 	}
 
+	// Grab the optional keystore encryption settings
+	if encrypted, ok := params.Extra[secrets.Encrypted]; ok {
+		if localManager.encrypted, ok = encrypted.(bool); !ok {
+			return nil, errors.New("invalid type assertion for encrypted flag")
+		}
+	}
+
+	if password, ok := params.Extra[secrets.Password]; ok {
+		if localManager.password, ok = password.(string); !ok {
+			return nil, errors.New("invalid type assertion for password")
+		}
+	}
+
+	if passwordFile, ok := params.Extra[secrets.PasswordFile]; ok {
+		if localManager.passwordFile, ok = passwordFile.(string); !ok {
+			return nil, errors.New("invalid type assertion for password file")
+		}
+	}
+
 	// Run the initial setup
 	_ = localManager.Setup()
 
@@ -114,7 +158,27 @@ func (l *LocalSecretsManager) GetSecret(name string) ([]byte, error) {
 		)
 	}
 
-	return secret, nil
+	if !keystore.IsEncryptedKey(secret) {
+		return secret, nil
+	}
+
+	// The secret is stored in the keystore format,
+	// return it in the same encoding as the plaintext one
+	password, err := l.getPassword(false)
+	if err != nil {
+		return nil, err
+	}
+
+	rawSecret, err := keystore.DecryptKey(secret, password)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"unable to decrypt secret (%s), %w",
+			secretPath,
+			err,
+		)
+	}
+
+	return []byte(hex.EncodeToString(rawSecret)), nil
 }
 
 // SetSecret saves the local SecretsManager's secret to disk
@@ -139,8 +203,18 @@ func (l *LocalSecretsManager) SetSecret(name string, value []byte) error {
 			secretPath,
 		)
 	}
+
+	if l.shouldEncrypt() {
+		encrypted, err := l.encryptSecret(name, value)
+		if err != nil {
+			return err
+		}
+
+		value = encrypted
+	}
+
 	// Write the secret to disk
-	if err := os.WriteFile(secretPath, value, os.ModePerm); err != nil {
+	if err := os.WriteFile(secretPath, value, secretFilePerm); err != nil {
 		return fmt.Errorf(
 			"unable to write secret to disk (%s), %w",
 			secretPath,
@@ -153,23 +227,116 @@ func (l *LocalSecretsManager) SetSecret(name string, value []byte) error {
 
 // HasSecret checks if the secret is present on disk
 func (l *LocalSecretsManager) HasSecret(name string) bool {
-	_, err := l.GetSecret(name)
+	l.secretPathMapLock.RLock()
+	secretPath, ok := l.secretPathMap[name]
+	l.secretPathMapLock.RUnlock()
+
+	if !ok {
+		return false
+	}
+
+	_, err := os.Stat(secretPath)
 
 	return err == nil
 }
 
+// IsEncrypted checks if the secret is stored on disk as an encrypted keystore file
+func (l *LocalSecretsManager) IsEncrypted(name string) bool {
+	l.secretPathMapLock.RLock()
+	secretPath, ok := l.secretPathMap[name]
+	l.secretPathMapLock.RUnlock()
+
+	if !ok {
+		return false
+	}
+
+	secret, err := os.ReadFile(secretPath)
+
+	return err == nil && keystore.IsEncryptedKey(secret)
+}
+
+// shouldEncrypt checks if new secrets should be encrypted. Secrets are encrypted
+// if the encryption is explicitly requested, or if any of the existing secrets are encrypted,
+// so that the keys in the same data directory are stored consistently
+func (l *LocalSecretsManager) shouldEncrypt() bool {
+	if l.encrypted {
+		return true
+	}
+
+	l.secretPathMapLock.RLock()
+	names := make([]string, 0, len(l.secretPathMap))
+
+	for name := range l.secretPathMap {
+		names = append(names, name)
+	}
+	l.secretPathMapLock.RUnlock()
+
+	for _, name := range names {
+		if l.IsEncrypted(name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// encryptSecret converts the hex encoded secret into the encrypted keystore format
+func (l *LocalSecretsManager) encryptSecret(name string, value []byte) ([]byte, error) {
+	rawSecret, err := hex.DecodeString(string(value))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode secret %s, %w", name, err)
+	}
+
+	// Keep the address in the keystore for the validator key to stay compatible with geth
+	address := ""
+
+	if name == secrets.ValidatorKey {
+		privateKey, err := crypto.BytesToECDSAPrivateKey(value)
+		if err != nil {
+			return nil, err
+		}
+
+		address = hex.EncodeToString(crypto.PubKeyToAddress(&privateKey.PublicKey).Bytes())
+	}
+
+	password, err := l.getPassword(true)
+	if err != nil {
+		return nil, err
+	}
+
+	return keystore.EncryptKey(rawSecret, address, password, scryptN, scryptP)
+}
+
+// getPassword returns the keystore password, reading it on the first use
+func (l *LocalSecretsManager) getPassword(confirm bool) (string, error) {
+	l.passwordLock.Lock()
+	defer l.passwordLock.Unlock()
+
+	if l.password != "" {
+		return l.password, nil
+	}
+
+	password, err := keystore.ReadPassword(l.passwordFile, confirm)
+	if err != nil {
+		return "", err
+	}
+
+	l.password = password
+
+	return password, nil
+}
+
 // RemoveSecret removes the local SecretsManager's secret from disk
 func (l *LocalSecretsManager) RemoveSecret(name string) error {
-	l.secretPathMapLock.Lock()
+	l.secretPathMapLock.RLock()
 	secretPath, ok := l.secretPathMap[name]
-	defer l.secretPathMapLock.Unlock()
+	l.secretPathMapLock.RUnlock()
 
 	if !ok {
 		return secrets.ErrSecretNotFound
 	}
 
-	delete(l.secretPathMap, name)
-
+	// The path mapping is kept so the secret can be set again
 	if removeErr := os.Remove(secretPath); removeErr != nil {
 		return fmt.Errorf("unable to remove secret, %w", removeErr)
 	}
@@ -3,14 +3,17 @@ package local
 import (
 	"encoding/hex"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/keystore"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/hashicorp/go-hclog"
 	libp2pCrypto "github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLocalSecretsManagerFactory(t *testing.T) {
@@ -272,3 +275,111 @@ func TestLocalSecretsManager_RemoveSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestLocalSecretsManager_EncryptedSecrets(t *testing.T) {
+	// Use the light scrypt parameters to speed up the test
+	scryptN, scryptP = keystore.LightScryptN, keystore.LightScryptP
+
+	t.Cleanup(func() {
+		scryptN, scryptP = keystore.StandardScryptN, keystore.StandardScryptP
+	})
+
+	workingDirectory := t.TempDir()
+
+	newManager := func(t *testing.T, encrypted bool, password string) secrets.SecretsManager {
+		t.Helper()
+
+		manager, err := SecretsManagerFactory(nil, &secrets.SecretsManagerParams{
+			Logger: hclog.NewNullLogger(),
+			Extra: map[string]interface{}{
+				secrets.Path:      workingDirectory,
+				secrets.Encrypted: encrypted,
+				secrets.Password:  password,
+			},
+		})
+		require.NoError(t, err)
+
+		return manager
+	}
+
+	validatorKey, validatorKeyEncoded, err := crypto.GenerateAndEncodeECDSAPrivateKey()
+	require.NoError(t, err)
+
+	_, libp2pKeyEncoded, err := generateAndEncodeLibp2pKey()
+	require.NoError(t, err)
+
+	// Write the validator key in the encrypted format
+	require.NoError(t, newManager(t, true, "password").SetSecret(secrets.ValidatorKey, validatorKeyEncoded))
+
+	content, err := os.ReadFile(filepath.Join(workingDirectory, secrets.ConsensusFolderLocal, secrets.ValidatorKeyLocal))
+	require.NoError(t, err)
+
+	assert.True(t, keystore.IsEncryptedKey(content))
+	assert.NotContains(t, string(content), string(validatorKeyEncoded))
+
+	// The keystore contains the validator address like the geth keystore files
+	assert.Contains(
+		t,
+		string(content),
+		hex.EncodeToString(crypto.PubKeyToAddress(&validatorKey.PublicKey).Bytes()),
+	)
+
+	// New secrets follow the encryption of the existing ones
+	manager := newManager(t, false, "password")
+	require.NoError(t, manager.SetSecret(secrets.NetworkKey, libp2pKeyEncoded))
+
+	content, err = os.ReadFile(filepath.Join(workingDirectory, secrets.NetworkFolderLocal, secrets.NetworkKeyLocal))
+	require.NoError(t, err)
+
+	assert.True(t, keystore.IsEncryptedKey(content))
+
+	// The secret files are readable only by the owner
+	for _, path := range []string{
+		filepath.Join(workingDirectory, secrets.ConsensusFolderLocal, secrets.ValidatorKeyLocal),
+		filepath.Join(workingDirectory, secrets.NetworkFolderLocal, secrets.NetworkKeyLocal),
+	} {
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	}
+
+	// Secrets are decrypted into the same encoding as the plaintext ones
+	parsedKey, err := crypto.ReadConsensusKey(manager)
+	require.NoError(t, err)
+	assert.True(t, validatorKey.Equal(parsedKey))
+
+	networkKey, err := manager.GetSecret(secrets.NetworkKey)
+	require.NoError(t, err)
+	assert.Equal(t, libp2pKeyEncoded, networkKey)
+
+	// Decryption fails with the wrong password
+	_, err = newManager(t, false, "wrong password").GetSecret(secrets.ValidatorKey)
+	assert.ErrorIs(t, err, keystore.ErrDecrypt)
+
+	// The presence of a secret can be checked without the password
+	assert.True(t, newManager(t, false, "").HasSecret(secrets.ValidatorKey))
+}
+
+func TestLocalSecretsManager_SetSecretAfterRemove(t *testing.T) {
+	_, validatorKeyEncoded, err := crypto.GenerateAndEncodeECDSAPrivateKey()
+	require.NoError(t, err)
+
+	_, newValidatorKeyEncoded, err := crypto.GenerateAndEncodeECDSAPrivateKey()
+	require.NoError(t, err)
+
+	manager := getLocalSecretsManager(t)
+
+	require.NoError(t, manager.SetSecret(secrets.ValidatorKey, validatorKeyEncoded))
+
+	// Existing secrets are not overwritten
+	assert.Error(t, manager.SetSecret(secrets.ValidatorKey, newValidatorKeyEncoded))
+
+	// The secret can be replaced once it's removed
+	require.NoError(t, manager.RemoveSecret(secrets.ValidatorKey))
+	require.NoError(t, manager.SetSecret(secrets.ValidatorKey, newValidatorKeyEncoded))
+
+	secret, err := manager.GetSecret(secrets.ValidatorKey)
+	require.NoError(t, err)
+	assert.Equal(t, newValidatorKeyEncoded, secret)
+}
@@ -0,0 +1,39 @@
+package pkcs11
+
+import (
+	"errors"
+	"fmt"
+)
+
+type configExtraParamFields string
+
+const (
+	// moduleField is the path to the PKCS#11 module shared library, e.g. /usr/lib/softhsm/libsofthsm2.so
+	moduleField configExtraParamFields = "module"
+
+	// tokenLabelField is the label of the token holding the validator key
+	tokenLabelField configExtraParamFields = "token-label"
+
+	// keyLabelField is the optional label of the validator key objects,
+	// <name>-validator-key by default
+	keyLabelField configExtraParamFields = "key-label"
+)
+
+var (
+	errNoNodeName        = errors.New("no node name specified for pkcs11 secrets manager")
+	errNoPIN             = errors.New("no token PIN specified for pkcs11 secrets manager")
+	errTokenNotFound     = errors.New("PKCS#11 token not found")
+	errKeyExists         = errors.New("validator key already exists on PKCS#11 token")
+	errKeyNotExportable  = errors.New("validator key can't be exported from PKCS#11 token")
+	errDuplicateKeyLabel = errors.New("multiple PKCS#11 objects found with the key label")
+)
+
+// getExtraString returns the non-empty string value of the config extra field
+func getExtraString(extra map[string]interface{}, field configExtraParamFields) (string, error) {
+	value, ok := extra[string(field)].(string)
+	if !ok || value == "" {
+		return "", fmt.Errorf("no %s variable specified for pkcs11 secrets manager", field)
+	}
+
+	return value, nil
+}
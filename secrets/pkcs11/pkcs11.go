@@ -0,0 +1,437 @@
+//go:build cgo
+// +build cgo
+
+package pkcs11
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/secrets/local"
+	"github.com/hashicorp/go-hclog"
+	p11 "github.com/miekg/pkcs11"
+)
+
+// PKCS11SecretsManager is a SecretsManager that keeps the validator ECDSA key
+// on a PKCS#11 token (HSM, SoftHSM...). The private key never leaves the token,
+// it is only used through SignBySecret and GetSecretInfo.
+// The remaining secrets are stored by the local secrets manager
+type PKCS11SecretsManager struct {
+	// Logger object
+	logger hclog.Logger
+
+	// Path to the PKCS#11 module shared library
+	modulePath string
+
+	// Label of the token holding the validator key
+	tokenLabel string
+
+	// User PIN of the token
+	pin string
+
+	// Label of the validator key objects on the token
+	keyLabel string
+
+	// PKCS#11 module context and the logged in session.
+	// The session is not safe for concurrent use, so it's guarded by the lock
+	ctx     *p11.Ctx
+	session p11.SessionHandle
+	lock    sync.Mutex
+
+	// The cached public key of the validator key
+	pubkey *ecdsa.PublicKey
+
+	// The remaining secrets use the local secrets manager
+	localSM secrets.SecretsManager
+}
+
+// SecretsManagerFactory implements the factory method
+func SecretsManagerFactory(
+	config *secrets.SecretsManagerConfig,
+	params *secrets.SecretsManagerParams,
+) (secrets.SecretsManager, error) {
+	if config.Name == "" {
+		return nil, errNoNodeName
+	}
+
+	modulePath, err := getExtraString(config.Extra, moduleField)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenLabel, err := getExtraString(config.Extra, tokenLabelField)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Token == "" {
+		return nil, errNoPIN
+	}
+
+	keyLabel := fmt.Sprintf("%s-%s", config.Name, secrets.ValidatorKey)
+	if _, ok := config.Extra[string(keyLabelField)]; ok {
+		if keyLabel, err = getExtraString(config.Extra, keyLabelField); err != nil {
+			return nil, err
+		}
+	}
+
+	pkcs11Manager := &PKCS11SecretsManager{
+		logger:     params.Logger.Named(string(secrets.PKCS11)),
+		modulePath: modulePath,
+		tokenLabel: tokenLabel,
+		pin:        config.Token,
+		keyLabel:   keyLabel,
+	}
+
+	if err := pkcs11Manager.Setup(); err != nil {
+		return nil, err
+	}
+
+	// Init the local secrets manager
+	pkcs11Manager.localSM, err = local.SecretsManagerFactory(
+		nil, // Local secrets manager doesn't require a config
+		params,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return pkcs11Manager, nil
+}
+
+// Setup loads the PKCS#11 module and logs into the token
+func (p *PKCS11SecretsManager) Setup() error {
+	ctx := p11.New(p.modulePath)
+	if ctx == nil {
+		return fmt.Errorf("unable to load PKCS#11 module %s", p.modulePath)
+	}
+
+	if err := ctx.Initialize(); err != nil && !isPKCS11Error(err, p11.CKR_CRYPTOKI_ALREADY_INITIALIZED) {
+		return fmt.Errorf("unable to initialize PKCS#11 module, %w", err)
+	}
+
+	slot, err := findTokenSlot(ctx, p.tokenLabel)
+	if err != nil {
+		return err
+	}
+
+	session, err := ctx.OpenSession(slot, p11.CKF_SERIAL_SESSION|p11.CKF_RW_SESSION)
+	if err != nil {
+		return fmt.Errorf("unable to open PKCS#11 session, %w", err)
+	}
+
+	if err := ctx.Login(session, p11.CKU_USER, p.pin); err != nil &&
+		!isPKCS11Error(err, p11.CKR_USER_ALREADY_LOGGED_IN) {
+		_ = ctx.CloseSession(session)
+
+		return fmt.Errorf("unable to log into PKCS#11 token, %w", err)
+	}
+
+	p.ctx = ctx
+	p.session = session
+
+	p.logger.Info("PKCS#11 token ready", "token", p.tokenLabel, "key", p.keyLabel)
+
+	return nil
+}
+
+// GetSecret gets the secret by name
+func (p *PKCS11SecretsManager) GetSecret(name string) ([]byte, error) {
+	if name == secrets.ValidatorKey {
+		return nil, errKeyNotExportable
+	}
+
+	return p.localSM.GetSecret(name)
+}
+
+// SetSecret sets the secret to a provided value.
+// The validator key is imported into the token as a non-extractable key
+func (p *PKCS11SecretsManager) SetSecret(name string, value []byte) error {
+	if name != secrets.ValidatorKey {
+		return p.localSM.SetSecret(name, value)
+	}
+
+	privateKey, err := crypto.BytesToECDSAPrivateKey(value)
+	if err != nil {
+		return err
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if _, err := p.findKeyObject(p11.CKO_PRIVATE_KEY); err == nil {
+		return errKeyExists
+	}
+
+	ecPoint, err := encodeECPoint(&privateKey.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	publicTemplate := append(p.publicKeyTemplate(), p11.NewAttribute(p11.CKA_EC_POINT, ecPoint))
+	if _, err := p.ctx.CreateObject(p.session, publicTemplate); err != nil {
+		return fmt.Errorf("unable to import validator public key, %w", err)
+	}
+
+	privateKeyBytes, err := crypto.MarshalECDSAPrivateKey(privateKey)
+	if err != nil {
+		return err
+	}
+
+	privateTemplate := append(p.privateKeyTemplate(), p11.NewAttribute(p11.CKA_VALUE, privateKeyBytes))
+	if _, err := p.ctx.CreateObject(p.session, privateTemplate); err != nil {
+		return fmt.Errorf("unable to import validator private key, %w", err)
+	}
+
+	p.pubkey = &privateKey.PublicKey
+
+	return nil
+}
+
+// GenerateSecret generates the validator key on the token
+func (p *PKCS11SecretsManager) GenerateSecret(name string) error {
+	if name != secrets.ValidatorKey {
+		return fmt.Errorf("unable to generate secret %s on PKCS#11 token", name)
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if _, err := p.findKeyObject(p11.CKO_PRIVATE_KEY); err == nil {
+		return errKeyExists
+	}
+
+	if _, _, err := p.ctx.GenerateKeyPair(
+		p.session,
+		[]*p11.Mechanism{p11.NewMechanism(p11.CKM_EC_KEY_PAIR_GEN, nil)},
+		p.publicKeyTemplate(),
+		p.privateKeyTemplate(),
+	); err != nil {
+		return fmt.Errorf("unable to generate validator key, %w", err)
+	}
+
+	return nil
+}
+
+// HasSecret checks if the secret is present
+func (p *PKCS11SecretsManager) HasSecret(name string) bool {
+	if name != secrets.ValidatorKey {
+		return p.localSM.HasSecret(name)
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	_, err := p.findKeyObject(p11.CKO_PRIVATE_KEY)
+
+	return err == nil
+}
+
+// RemoveSecret removes the secret from storage
+func (p *PKCS11SecretsManager) RemoveSecret(name string) error {
+	if name != secrets.ValidatorKey {
+		return p.localSM.RemoveSecret(name)
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for _, class := range []uint{p11.CKO_PRIVATE_KEY, p11.CKO_PUBLIC_KEY} {
+		object, err := p.findKeyObject(class)
+		if err != nil {
+			return err
+		}
+
+		if err := p.ctx.DestroyObject(p.session, object); err != nil {
+			return fmt.Errorf("unable to remove validator key, %w", err)
+		}
+	}
+
+	p.pubkey = nil
+
+	return nil
+}
+
+// SignBySecret signs the hash by the validator key on the token.
+// The signature is returned in the same format as crypto.Sign
+func (p *PKCS11SecretsManager) SignBySecret(key string, _ int, data []byte) ([]byte, error) {
+	if key != secrets.ValidatorKey {
+		return nil, fmt.Errorf("unable to sign by secret %s on PKCS#11 token", key)
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	pubkey, err := p.getPublicKey()
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := p.findKeyObject(p11.CKO_PRIVATE_KEY)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.ctx.SignInit(
+		p.session,
+		[]*p11.Mechanism{p11.NewMechanism(p11.CKM_ECDSA, nil)},
+		privateKey,
+	); err != nil {
+		return nil, fmt.Errorf("unable to initialize signing, %w", err)
+	}
+
+	rawSig, err := p.ctx.Sign(p.session, data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign data, %w", err)
+	}
+
+	return encodeSignature(rawSig, data, pubkey)
+}
+
+// GetSecretInfo returns the public key and the address of the validator key
+func (p *PKCS11SecretsManager) GetSecretInfo(name string) (*secrets.SecretInfo, error) {
+	if name != secrets.ValidatorKey {
+		return nil, fmt.Errorf("unable to get info of secret %s on PKCS#11 token", name)
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	pubkey, err := p.getPublicKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return &secrets.SecretInfo{
+		Pubkey:  hex.EncodeToHex(crypto.MarshalPublicKey(pubkey)),
+		Address: crypto.PubKeyToAddress(pubkey).String(),
+	}, nil
+}
+
+// GetSecretsManagerType returns the type of the secrets manager
+func (p *PKCS11SecretsManager) GetSecretsManagerType() secrets.SecretsManagerType {
+	return secrets.PKCS11
+}
+
+// getPublicKey returns the cached validator public key, reading it from the token if needed.
+// The caller needs to hold the lock
+func (p *PKCS11SecretsManager) getPublicKey() (*ecdsa.PublicKey, error) {
+	if p.pubkey != nil {
+		return p.pubkey, nil
+	}
+
+	object, err := p.findKeyObject(p11.CKO_PUBLIC_KEY)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes, err := p.ctx.GetAttributeValue(
+		p.session,
+		object,
+		[]*p11.Attribute{p11.NewAttribute(p11.CKA_EC_POINT, nil)},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read validator public key, %w", err)
+	}
+
+	pubkey, err := decodeECPoint(attributes[0].Value)
+	if err != nil {
+		return nil, err
+	}
+
+	p.pubkey = pubkey
+
+	return pubkey, nil
+}
+
+// findKeyObject returns the handle of the validator key object of the given class.
+// The caller needs to hold the lock
+func (p *PKCS11SecretsManager) findKeyObject(class uint) (p11.ObjectHandle, error) {
+	if err := p.ctx.FindObjectsInit(p.session, []*p11.Attribute{
+		p11.NewAttribute(p11.CKA_CLASS, class),
+		p11.NewAttribute(p11.CKA_KEY_TYPE, p11.CKK_EC),
+		p11.NewAttribute(p11.CKA_LABEL, p.keyLabel),
+	}); err != nil {
+		return 0, fmt.Errorf("unable to search PKCS#11 objects, %w", err)
+	}
+
+	objects, _, err := p.ctx.FindObjects(p.session, 2)
+
+	if finalErr := p.ctx.FindObjectsFinal(p.session); err == nil {
+		err = finalErr
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("unable to search PKCS#11 objects, %w", err)
+	}
+
+	switch len(objects) {
+	case 0:
+		return 0, secrets.ErrSecretNotFound
+	case 1:
+		return objects[0], nil
+	default:
+		return 0, fmt.Errorf("%w: %s", errDuplicateKeyLabel, p.keyLabel)
+	}
+}
+
+// publicKeyTemplate returns the attributes of the validator public key object
+func (p *PKCS11SecretsManager) publicKeyTemplate() []*p11.Attribute {
+	return []*p11.Attribute{
+		p11.NewAttribute(p11.CKA_CLASS, p11.CKO_PUBLIC_KEY),
+		p11.NewAttribute(p11.CKA_KEY_TYPE, p11.CKK_EC),
+		p11.NewAttribute(p11.CKA_TOKEN, true),
+		p11.NewAttribute(p11.CKA_LABEL, p.keyLabel),
+		p11.NewAttribute(p11.CKA_VERIFY, true),
+		p11.NewAttribute(p11.CKA_EC_PARAMS, secp256k1OID),
+	}
+}
+
+// privateKeyTemplate returns the attributes of the validator private key object,
+// which can only be used for signing and can't be extracted from the token
+func (p *PKCS11SecretsManager) privateKeyTemplate() []*p11.Attribute {
+	return []*p11.Attribute{
+		p11.NewAttribute(p11.CKA_CLASS, p11.CKO_PRIVATE_KEY),
+		p11.NewAttribute(p11.CKA_KEY_TYPE, p11.CKK_EC),
+		p11.NewAttribute(p11.CKA_TOKEN, true),
+		p11.NewAttribute(p11.CKA_PRIVATE, true),
+		p11.NewAttribute(p11.CKA_LABEL, p.keyLabel),
+		p11.NewAttribute(p11.CKA_SIGN, true),
+		p11.NewAttribute(p11.CKA_SENSITIVE, true),
+		p11.NewAttribute(p11.CKA_EXTRACTABLE, false),
+		p11.NewAttribute(p11.CKA_EC_PARAMS, secp256k1OID),
+	}
+}
+
+// findTokenSlot returns the slot containing the token with the given label
+func findTokenSlot(ctx *p11.Ctx, tokenLabel string) (uint, error) {
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return 0, fmt.Errorf("unable to list PKCS#11 slots, %w", err)
+	}
+
+	for _, slot := range slots {
+		info, err := ctx.GetTokenInfo(slot)
+		if err != nil {
+			return 0, fmt.Errorf("unable to get PKCS#11 token info, %w", err)
+		}
+
+		if info.Label == tokenLabel {
+			return slot, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %s", errTokenNotFound, tokenLabel)
+}
+
+// isPKCS11Error checks if the error is the given PKCS#11 return value
+func isPKCS11Error(err error, code uint) bool {
+	var pkcs11Err p11.Error
+
+	return errors.As(err, &pkcs11Err) && uint(pkcs11Err) == code
+}
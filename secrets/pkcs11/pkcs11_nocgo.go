@@ -0,0 +1,19 @@
+//go:build !cgo
+// +build !cgo
+
+package pkcs11
+
+import (
+	"errors"
+
+	"github.com/0xPolygon/polygon-edge/secrets"
+)
+
+// SecretsManagerFactory implements the factory method.
+// The PKCS#11 modules are loaded through cgo, so the secrets manager is not available without it
+func SecretsManagerFactory(
+	_ *secrets.SecretsManagerConfig,
+	_ *secrets.SecretsManagerParams,
+) (secrets.SecretsManager, error) {
+	return nil, errors.New("pkcs11 secrets manager requires a binary built with cgo enabled")
+}
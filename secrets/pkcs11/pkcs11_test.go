@@ -0,0 +1,195 @@
+//go:build cgo
+// +build cgo
+
+package pkcs11
+
+import (
+	"os"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The SoftHSM tests run only if the module path is set, e.g. with a token initialized by
+// softhsm2-util --init-token --free --label edge-test --pin 1234 --so-pin 1234
+const (
+	testModuleEnvVar = "PKCS11_TEST_MODULE"
+	testTokenLabel   = "edge-test"
+	testPIN          = "1234"
+)
+
+// getSoftHSMSecretsManager creates the PKCS#11 secrets manager with a random key label
+// on the SoftHSM test token, skipping the test if no module is configured
+func getSoftHSMSecretsManager(t *testing.T) *PKCS11SecretsManager {
+	t.Helper()
+
+	module := os.Getenv(testModuleEnvVar)
+	if module == "" {
+		t.Skipf("%s is not set", testModuleEnvVar)
+	}
+
+	manager, err := SecretsManagerFactory(
+		&secrets.SecretsManagerConfig{
+			Token: testPIN,
+			Type:  secrets.PKCS11,
+			Name:  "node",
+			Extra: map[string]interface{}{
+				string(moduleField):     module,
+				string(tokenLabelField): testTokenLabel,
+				string(keyLabelField):   uuid.NewString(),
+			},
+		},
+		&secrets.SecretsManagerParams{
+			Logger: hclog.NewNullLogger(),
+			Extra: map[string]interface{}{
+				secrets.Path: t.TempDir(),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = manager.RemoveSecret(secrets.ValidatorKey)
+	})
+
+	pkcs11Manager, ok := manager.(*PKCS11SecretsManager)
+	require.True(t, ok)
+
+	return pkcs11Manager
+}
+
+func TestPKCS11SecretsManager_Factory(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		config *secrets.SecretsManagerConfig
+		err    string
+	}{
+		{
+			name: "no node name",
+			config: &secrets.SecretsManagerConfig{
+				Token: testPIN,
+			},
+			err: errNoNodeName.Error(),
+		},
+		{
+			name: "no module",
+			config: &secrets.SecretsManagerConfig{
+				Token: testPIN,
+				Name:  "node",
+				Extra: map[string]interface{}{
+					string(tokenLabelField): testTokenLabel,
+				},
+			},
+			err: "no module variable specified",
+		},
+		{
+			name: "no token label",
+			config: &secrets.SecretsManagerConfig{
+				Token: testPIN,
+				Name:  "node",
+				Extra: map[string]interface{}{
+					string(moduleField): "/usr/lib/softhsm/libsofthsm2.so",
+				},
+			},
+			err: "no token-label variable specified",
+		},
+		{
+			name: "no PIN",
+			config: &secrets.SecretsManagerConfig{
+				Name: "node",
+				Extra: map[string]interface{}{
+					string(moduleField):     "/usr/lib/softhsm/libsofthsm2.so",
+					string(tokenLabelField): testTokenLabel,
+				},
+			},
+			err: errNoPIN.Error(),
+		},
+		{
+			name: "missing module",
+			config: &secrets.SecretsManagerConfig{
+				Token: testPIN,
+				Name:  "node",
+				Extra: map[string]interface{}{
+					string(moduleField):     "/nonexistent/libpkcs11.so",
+					string(tokenLabelField): testTokenLabel,
+				},
+			},
+			err: "unable to load PKCS#11 module",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := SecretsManagerFactory(test.config, &secrets.SecretsManagerParams{
+				Logger: hclog.NewNullLogger(),
+			})
+
+			assert.ErrorContains(t, err, test.err)
+		})
+	}
+}
+
+func TestPKCS11SecretsManager_SignBySecret(t *testing.T) {
+	manager := getSoftHSMSecretsManager(t)
+
+	assert.False(t, manager.HasSecret(secrets.ValidatorKey))
+
+	require.NoError(t, manager.GenerateSecret(secrets.ValidatorKey))
+	assert.True(t, manager.HasSecret(secrets.ValidatorKey))
+	assert.ErrorIs(t, manager.GenerateSecret(secrets.ValidatorKey), errKeyExists)
+
+	_, err := manager.GetSecret(secrets.ValidatorKey)
+	assert.ErrorIs(t, err, errKeyNotExportable)
+
+	info, err := manager.GetSecretInfo(secrets.ValidatorKey)
+	require.NoError(t, err)
+
+	hash := crypto.Keccak256([]byte("message"))
+
+	sig, err := manager.SignBySecret(secrets.ValidatorKey, 100, hash)
+	require.NoError(t, err)
+
+	pubkey, err := crypto.RecoverPubkey(sig, hash)
+	require.NoError(t, err)
+	assert.Equal(t, info.Address, crypto.PubKeyToAddress(pubkey).String())
+}
+
+func TestPKCS11SecretsManager_ImportKey(t *testing.T) {
+	manager := getSoftHSMSecretsManager(t)
+
+	key, encodedKey, err := crypto.GenerateAndEncodeECDSAPrivateKey()
+	require.NoError(t, err)
+
+	require.NoError(t, manager.SetSecret(secrets.ValidatorKey, encodedKey))
+	assert.ErrorIs(t, manager.SetSecret(secrets.ValidatorKey, encodedKey), errKeyExists)
+
+	// Drop the cached public key, so it's read from the token
+	manager.pubkey = nil
+
+	info, err := manager.GetSecretInfo(secrets.ValidatorKey)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.PubKeyToAddress(&key.PublicKey).String(), info.Address)
+
+	hash := crypto.Keccak256([]byte("message"))
+
+	sig, err := manager.SignBySecret(secrets.ValidatorKey, 100, hash)
+	require.NoError(t, err)
+
+	pubkey, err := crypto.RecoverPubkey(sig, hash)
+	require.NoError(t, err)
+	assert.True(t, key.PublicKey.Equal(pubkey))
+
+	require.NoError(t, manager.RemoveSecret(secrets.ValidatorKey))
+	assert.False(t, manager.HasSecret(secrets.ValidatorKey))
+}
@@ -0,0 +1,66 @@
+package pkcs11
+
+import (
+	"crypto/ecdsa"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+)
+
+// secp256k1OID is the DER encoded object identifier of the secp256k1 curve (1.3.132.0.10),
+// used as the CKA_EC_PARAMS attribute of the validator key objects
+var secp256k1OID = []byte{0x06, 0x05, 0x2b, 0x81, 0x04, 0x00, 0x0a}
+
+const (
+	// uncompressedPointLength is the length of the 0x04 || X || Y encoded public key
+	uncompressedPointLength = 65
+
+	// rawSignatureLength is the length of the r || s signature returned by CKM_ECDSA
+	rawSignatureLength = 64
+)
+
+var errInvalidSignatureLength = errors.New("invalid raw signature length")
+
+// encodeECPoint encodes the public key as the DER OCTET STRING stored in CKA_EC_POINT
+func encodeECPoint(pub *ecdsa.PublicKey) ([]byte, error) {
+	return asn1.Marshal(crypto.MarshalPublicKey(pub))
+}
+
+// decodeECPoint parses the CKA_EC_POINT attribute value.
+// The point is expected to be DER encoded, but some modules return the raw point
+func decodeECPoint(ecPoint []byte) (*ecdsa.PublicKey, error) {
+	if len(ecPoint) == uncompressedPointLength {
+		return crypto.ParsePublicKey(ecPoint)
+	}
+
+	var point []byte
+
+	rest, err := asn1.Unmarshal(ecPoint, &point)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode EC point, %w", err)
+	}
+
+	if len(rest) != 0 {
+		return nil, errors.New("unable to decode EC point, trailing data")
+	}
+
+	return crypto.ParsePublicKey(point)
+}
+
+// encodeSignature converts the r || s signature returned by the token into
+// the [R || S || V] format produced by crypto.Sign, so it can be verified by ecrecover
+func encodeSignature(rawSig, hash []byte, pub *ecdsa.PublicKey) ([]byte, error) {
+	if len(rawSig) != rawSignatureLength {
+		return nil, fmt.Errorf("%w: %d", errInvalidSignatureLength, len(rawSig))
+	}
+
+	return crypto.ToRecoverableSignature(
+		new(big.Int).SetBytes(rawSig[:32]),
+		new(big.Int).SetBytes(rawSig[32:]),
+		hash,
+		pub,
+	)
+}
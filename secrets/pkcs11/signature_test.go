@@ -0,0 +1,119 @@
+package pkcs11
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rawSign returns the r || s signature of the hash, as returned by CKM_ECDSA
+func rawSign(t *testing.T, key *ecdsa.PrivateKey, hash []byte, highS bool) []byte {
+	t.Helper()
+
+	r, s, err := ecdsa.Sign(rand.Reader, key, hash)
+	require.NoError(t, err)
+
+	// Both s and N - s are valid signatures, force the requested one
+	n := crypto.S256.Params().N
+	if (s.Cmp(new(big.Int).Rsh(n, 1)) > 0) != highS {
+		s = new(big.Int).Sub(n, s)
+	}
+
+	rawSig := make([]byte, rawSignatureLength)
+	r.FillBytes(rawSig[:32])
+	s.FillBytes(rawSig[32:])
+
+	return rawSig
+}
+
+func Test_encodeSignature(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	otherKey, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	hash := crypto.Keccak256([]byte("message"))
+
+	tests := []struct {
+		name   string
+		rawSig []byte
+		pubkey *ecdsa.PublicKey
+		err    error
+	}{
+		{
+			name:   "low S signature",
+			rawSig: rawSign(t, key, hash, false),
+			pubkey: &key.PublicKey,
+		},
+		{
+			name:   "high S signature is normalized",
+			rawSig: rawSign(t, key, hash, true),
+			pubkey: &key.PublicKey,
+		},
+		{
+			name:   "signature by another key",
+			rawSig: rawSign(t, otherKey, hash, false),
+			pubkey: &key.PublicKey,
+			err:    crypto.ErrSignatureKeyMismatch,
+		},
+		{
+			name:   "invalid signature length",
+			rawSig: make([]byte, 63),
+			pubkey: &key.PublicKey,
+			err:    errInvalidSignatureLength,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			sig, err := encodeSignature(test.rawSig, hash, test.pubkey)
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, sig, 65)
+			assert.True(t, new(big.Int).SetBytes(sig[32:64]).Cmp(new(big.Int).Rsh(crypto.S256.Params().N, 1)) <= 0)
+
+			pubkey, err := crypto.RecoverPubkey(sig, hash)
+			require.NoError(t, err)
+			assert.Equal(t, crypto.PubKeyToAddress(test.pubkey), crypto.PubKeyToAddress(pubkey))
+		})
+	}
+}
+
+func Test_decodeECPoint(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	encoded, err := encodeECPoint(&key.PublicKey)
+	require.NoError(t, err)
+
+	for name, ecPoint := range map[string][]byte{
+		"DER encoded point": encoded,
+		"raw point":         crypto.MarshalPublicKey(&key.PublicKey),
+	} {
+		pubkey, err := decodeECPoint(ecPoint)
+		require.NoError(t, err, name)
+		assert.True(t, key.PublicKey.Equal(pubkey), name)
+	}
+
+	_, err = decodeECPoint(append(encoded, 0x00))
+	assert.Error(t, err)
+}
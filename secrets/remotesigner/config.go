@@ -0,0 +1,180 @@
+package remotesigner
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+type configExtraParamFields string
+
+const (
+	// publicKeyField is the hex encoded public key identifying the validator key on the signer.
+	// It can be omitted if the signer holds a single key
+	publicKeyField configExtraParamFields = "public-key"
+
+	// tlsCAFileField is the CA certificate used to verify the signer, the system pool by default
+	tlsCAFileField configExtraParamFields = "tls-ca-file"
+
+	// tlsCertFileField and tlsKeyFileField are the client certificate and key used for mTLS
+	tlsCertFileField configExtraParamFields = "tls-cert-file"
+	tlsKeyFileField  configExtraParamFields = "tls-key-file"
+
+	// tlsServerNameField overrides the server name the signer certificate is verified against
+	tlsServerNameField configExtraParamFields = "tls-server-name"
+
+	// timeoutField is the timeout of a single request to the signer, e.g. 5s
+	timeoutField configExtraParamFields = "timeout"
+
+	// retriesField is the number of times a failed request is retried
+	retriesField configExtraParamFields = "retries"
+
+	// retryDelayField is the delay between the retries of a failed request, e.g. 500ms
+	retryDelayField configExtraParamFields = "retry-delay"
+
+	// deadlineField is the time a request may take including its retries, e.g. 5s
+	deadlineField configExtraParamFields = "deadline"
+)
+
+const (
+	defaultTimeout    = 2 * time.Second
+	defaultRetries    = 3
+	defaultRetryDelay = 250 * time.Millisecond
+	defaultDeadline   = 5 * time.Second
+
+	// maxDeadline keeps the signing requests below the 10s timeout of the first IBFT round,
+	// so that the node can still send its messages in the round the signing started at
+	maxDeadline = 8 * time.Second
+)
+
+var (
+	errNoServerURL       = errors.New("no server URL specified for remote signer secrets manager")
+	errIncompleteTLSPair = fmt.Errorf("both %s and %s need to be specified for mTLS", tlsCertFileField, tlsKeyFileField)
+)
+
+// signerConfig is the connection configuration of the remote signer, parsed from the config extra fields
+type signerConfig struct {
+	publicKey  string
+	tlsConfig  *tls.Config
+	timeout    time.Duration
+	retries    int
+	retryDelay time.Duration
+	deadline   time.Duration
+}
+
+// parseSignerConfig parses the config extra fields, using the defaults for the missing ones.
+// The fields are either strings (secrets generate --extra) or JSON numbers
+func parseSignerConfig(extra map[string]interface{}) (*signerConfig, error) {
+	var (
+		config = &signerConfig{
+			timeout:    defaultTimeout,
+			retries:    defaultRetries,
+			retryDelay: defaultRetryDelay,
+			deadline:   defaultDeadline,
+		}
+		err error
+	)
+
+	config.publicKey = getExtraString(extra, publicKeyField)
+
+	if value, ok := extra[string(timeoutField)]; ok {
+		if config.timeout, err = parseDuration(value); err != nil {
+			return nil, fmt.Errorf("invalid %s, %w", timeoutField, err)
+		}
+	}
+
+	if value, ok := extra[string(retryDelayField)]; ok {
+		if config.retryDelay, err = parseDuration(value); err != nil {
+			return nil, fmt.Errorf("invalid %s, %w", retryDelayField, err)
+		}
+	}
+
+	if value, ok := extra[string(deadlineField)]; ok {
+		if config.deadline, err = parseDuration(value); err != nil {
+			return nil, fmt.Errorf("invalid %s, %w", deadlineField, err)
+		}
+
+		if config.deadline <= 0 || config.deadline > maxDeadline {
+			return nil, fmt.Errorf("invalid %s %s, it needs to be positive and at most %s",
+				deadlineField, config.deadline, maxDeadline)
+		}
+	}
+
+	if value, ok := extra[string(retriesField)]; ok {
+		if config.retries, err = parseInt(value); err != nil || config.retries < 0 {
+			return nil, fmt.Errorf("invalid %s: %v", retriesField, value)
+		}
+	}
+
+	if config.tlsConfig, err = parseTLSConfig(extra); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// parseTLSConfig loads the CA and the client certificate, if they are specified
+func parseTLSConfig(extra map[string]interface{}) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: getExtraString(extra, tlsServerNameField),
+	}
+
+	if caFile := getExtraString(extra, tlsCAFileField); caFile != "" {
+		caCert, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read CA certificate, %w", err)
+		}
+
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no certificates found in %s", caFile)
+		}
+	}
+
+	certFile, keyFile := getExtraString(extra, tlsCertFileField), getExtraString(extra, tlsKeyFileField)
+	if (certFile == "") != (keyFile == "") {
+		return nil, errIncompleteTLSPair
+	}
+
+	if certFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate, %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+func getExtraString(extra map[string]interface{}, field configExtraParamFields) string {
+	value, _ := extra[string(field)].(string)
+
+	return value
+}
+
+func parseDuration(value interface{}) (time.Duration, error) {
+	raw, ok := value.(string)
+	if !ok {
+		return 0, fmt.Errorf("expected duration string, got %v", value)
+	}
+
+	return time.ParseDuration(raw)
+}
+
+func parseInt(value interface{}) (int, error) {
+	switch v := value.(type) {
+	case float64:
+		return int(v), nil
+	case string:
+		return strconv.Atoi(v)
+	default:
+		return 0, fmt.Errorf("expected number, got %v", value)
+	}
+}
@@ -0,0 +1,198 @@
+package fakesigner
+
+import (
+	"crypto/ecdsa"
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+)
+
+const (
+	publicKeysPath = "/api/v1/eth1/publicKeys"
+	signPath       = "/api/v1/eth1/sign/"
+)
+
+// Server is a fake remote signer serving the Web3Signer eth1 API for a single ECDSA key, used for testing
+// the remote-signer secrets manager without running an external signing service
+type Server struct {
+	*httptest.Server
+
+	key    *ecdsa.PrivateKey
+	pubkey string
+	token  string
+
+	lock     sync.Mutex
+	failures int
+	delay    time.Duration
+	requests int
+}
+
+// NewServer starts a fake signer for the given key over plain HTTP
+func NewServer(key *ecdsa.PrivateKey) *Server {
+	s := newServer(key)
+	s.Start()
+
+	return s
+}
+
+// NewTLSServer starts a fake signer for the given key over HTTPS.
+// The TLS config holds the server certificate and the client verification settings for mTLS
+func NewTLSServer(key *ecdsa.PrivateKey, tlsConfig *tls.Config) *Server {
+	s := newServer(key)
+	s.TLS = tlsConfig
+	s.StartTLS()
+
+	return s
+}
+
+func newServer(key *ecdsa.PrivateKey) *Server {
+	// Web3Signer identifies the keys by the public keys without the 0x04 prefix
+	s := &Server{
+		key:    key,
+		pubkey: hex.EncodeToHex(crypto.MarshalPublicKey(&key.PublicKey)[1:]),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(publicKeysPath, s.handlePublicKeys)
+	mux.HandleFunc(signPath, s.handleSign)
+
+	s.Server = httptest.NewUnstartedServer(s.middleware(mux))
+
+	return s
+}
+
+// PublicKey returns the hex encoded public key of the signer key
+func (s *Server) PublicKey() string {
+	return s.pubkey
+}
+
+// SetToken makes the signer require the bearer token
+func (s *Server) SetToken(token string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.token = token
+}
+
+// FailNext makes the next n requests fail with 503 Service Unavailable
+func (s *Server) FailNext(n int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.failures = n
+}
+
+// SetDelay delays all responses by the given duration
+func (s *Server) SetDelay(delay time.Duration) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.delay = delay
+}
+
+// Requests returns the number of requests received by the signer
+func (s *Server) Requests() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.requests
+}
+
+// middleware counts the requests and applies the configured delay, failures and authentication
+func (s *Server) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.lock.Lock()
+		s.requests++
+		delay, token := s.delay, s.token
+
+		fail := s.failures > 0
+		if fail {
+			s.failures--
+		}
+		s.lock.Unlock()
+
+		if delay > 0 {
+			select {
+			case <-time.After(delay):
+			case <-r.Context().Done():
+				return
+			}
+		}
+
+		if fail {
+			http.Error(w, "signer unavailable", http.StatusServiceUnavailable)
+
+			return
+		}
+
+		if token != "" && r.Header.Get("Authorization") != "Bearer "+token {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) handlePublicKeys(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode([]string{s.pubkey})
+}
+
+func (s *Server) handleSign(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	if !strings.EqualFold(strings.TrimPrefix(r.URL.Path, signPath), s.pubkey) {
+		http.Error(w, "key not found", http.StatusNotFound)
+
+		return
+	}
+
+	var req struct {
+		Data string `json:"data"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	data, err := hex.DecodeHex(req.Data)
+	if err != nil {
+		http.Error(w, "invalid data", http.StatusBadRequest)
+
+		return
+	}
+
+	sig, err := crypto.Sign(s.key, crypto.Keccak256(data))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	// Return the recovery id in the 27 / 28 form, as Web3Signer does
+	sig[64] += 27
+
+	w.Header().Set("Content-Type", "text/plain")
+	_, _ = w.Write([]byte(hex.EncodeToHex(sig)))
+}
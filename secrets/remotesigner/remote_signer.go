@@ -0,0 +1,418 @@
+package remotesigner
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/secrets/local"
+	"github.com/hashicorp/go-hclog"
+)
+
+// API of the remote signer, the eth1 signing API of Web3Signer. The signer signs the keccak256 hash
+// of the data, so the IBFT signer passes the preimages of the digests (see secrets.PreimageSigner):
+//
+//	GET  /api/v1/eth1/publicKeys                 -> ["0x<public key>", ...]
+//	POST /api/v1/eth1/sign/0x<public key>        {"data": "0x<preimage>"} -> 0x<R || S || V>
+const (
+	// publicKeysPath returns the JSON array of the hex encoded public keys held by the signer
+	publicKeysPath = "/api/v1/eth1/publicKeys"
+
+	// signPath signs the keccak256 hash of the {"data": "0x..."} data by the key with the given public key,
+	// returning the hex encoded R || S || V signature
+	signPath = "/api/v1/eth1/sign/"
+
+	// maxResponseSize limits the size of the response body read from the signer
+	maxResponseSize = 1 << 20
+)
+
+var (
+	errKeyNotExportable = errors.New("validator key can't be exported from remote signer")
+	errKeyNotManaged    = errors.New("validator key is managed by the remote signer")
+	errDigestNotSigned  = errors.New("remote signer signs the keccak256 hash of the data, not the digest")
+	errNoPublicKey      = fmt.Errorf(
+		"remote signer doesn't hold exactly one key, %s needs to be specified", publicKeyField,
+	)
+)
+
+// RemoteSignerSecretsManager is a SecretsManager that delegates signing by the validator key
+// to an external signing service over HTTP(S), so the key never resides on the node host.
+// The remaining secrets are stored by the local secrets manager
+type RemoteSignerSecretsManager struct {
+	// Logger object
+	logger hclog.Logger
+
+	// The base URL of the signer
+	serverURL string
+
+	// Optional bearer token used for signer authentication
+	token string
+
+	// Connection configuration of the signer
+	config *signerConfig
+
+	// The HTTP client used for interacting with the signer
+	client *http.Client
+
+	// The cached public key of the validator key and its identifier on the signer
+	pubkey     *ecdsa.PublicKey
+	identifier string
+	pubkeyLock sync.Mutex
+
+	// The remaining secrets use the local secrets manager
+	localSM secrets.SecretsManager
+}
+
+// signRequest is the body of the sign request
+type signRequest struct {
+	Data string `json:"data"`
+}
+
+// statusError is the error returned for unsuccessful signer responses
+type statusError struct {
+	code int
+	body string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("remote signer returned status %d: %s", e.code, e.body)
+}
+
+// SecretsManagerFactory implements the factory method
+func SecretsManagerFactory(
+	config *secrets.SecretsManagerConfig,
+	params *secrets.SecretsManagerParams,
+) (secrets.SecretsManager, error) {
+	if config.ServerURL == "" {
+		return nil, errNoServerURL
+	}
+
+	signerConfig, err := parseSignerConfig(config.Extra)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteManager := &RemoteSignerSecretsManager{
+		logger:    params.Logger.Named(string(secrets.RemoteSigner)),
+		serverURL: strings.TrimSuffix(config.ServerURL, "/"),
+		token:     config.Token,
+		config:    signerConfig,
+	}
+
+	if err := remoteManager.Setup(); err != nil {
+		return nil, err
+	}
+
+	// Init the local secrets manager
+	remoteManager.localSM, err = local.SecretsManagerFactory(
+		nil, // Local secrets manager doesn't require a config
+		params,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return remoteManager, nil
+}
+
+// Setup sets up the HTTP client of the remote signer
+func (r *RemoteSignerSecretsManager) Setup() error {
+	r.client = &http.Client{
+		Timeout: r.config.timeout,
+		Transport: &http.Transport{
+			TLSClientConfig:     r.config.tlsConfig,
+			MaxIdleConns:        10,
+			IdleConnTimeout:     30 * time.Second,
+			TLSHandshakeTimeout: r.config.timeout,
+		},
+	}
+
+	return nil
+}
+
+// GetSecret gets the secret by name
+func (r *RemoteSignerSecretsManager) GetSecret(name string) ([]byte, error) {
+	if name == secrets.ValidatorKey {
+		return nil, errKeyNotExportable
+	}
+
+	return r.localSM.GetSecret(name)
+}
+
+// SetSecret sets the secret to a provided value
+func (r *RemoteSignerSecretsManager) SetSecret(name string, value []byte) error {
+	if name == secrets.ValidatorKey {
+		return errKeyNotManaged
+	}
+
+	return r.localSM.SetSecret(name, value)
+}
+
+// HasSecret checks if the secret is present
+func (r *RemoteSignerSecretsManager) HasSecret(name string) bool {
+	if name != secrets.ValidatorKey {
+		return r.localSM.HasSecret(name)
+	}
+
+	_, _, err := r.getPublicKey()
+
+	return err == nil
+}
+
+// RemoveSecret removes the secret from storage
+func (r *RemoteSignerSecretsManager) RemoveSecret(name string) error {
+	if name == secrets.ValidatorKey {
+		return errKeyNotManaged
+	}
+
+	return r.localSM.RemoveSecret(name)
+}
+
+// SignBySecret can't sign the digest as is, the signer hashes the data itself
+func (r *RemoteSignerSecretsManager) SignBySecret(_ string, _ int, _ []byte) ([]byte, error) {
+	return nil, errDigestNotSigned
+}
+
+// SignPreimageBySecret signs the keccak256 hash of the preimage by the validator key on the remote signer.
+// The signature is verified against the validator public key and
+// returned in the same format as crypto.Sign
+func (r *RemoteSignerSecretsManager) SignPreimageBySecret(key string, _ int, preimage []byte) ([]byte, error) {
+	if key != secrets.ValidatorKey {
+		return nil, fmt.Errorf("unable to sign by secret %s on remote signer", key)
+	}
+
+	pubkey, identifier, err := r.getPublicKey()
+	if err != nil {
+		return nil, err
+	}
+
+	reqBody, err := json.Marshal(&signRequest{
+		Data: hex.EncodeToHex(preimage),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := r.request(http.MethodPost, signPath+identifier, reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := hex.DecodeHex(strings.Trim(strings.TrimSpace(string(respBody)), `"`))
+	if err != nil || len(sig) != 65 {
+		return nil, fmt.Errorf("invalid signature returned by remote signer: %s", respBody)
+	}
+
+	// The recovery id returned by the signer is ignored,
+	// as it's recomputed while verifying the signature
+	return crypto.ToRecoverableSignature(
+		new(big.Int).SetBytes(sig[:32]),
+		new(big.Int).SetBytes(sig[32:64]),
+		crypto.Keccak256(preimage),
+		pubkey,
+	)
+}
+
+// GetSecretInfo returns the public key and the address of the validator key
+func (r *RemoteSignerSecretsManager) GetSecretInfo(name string) (*secrets.SecretInfo, error) {
+	if name != secrets.ValidatorKey {
+		return nil, fmt.Errorf("unable to get info of secret %s on remote signer", name)
+	}
+
+	pubkey, _, err := r.getPublicKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return &secrets.SecretInfo{
+		Pubkey:  hex.EncodeToHex(crypto.MarshalPublicKey(pubkey)),
+		Address: crypto.PubKeyToAddress(pubkey).String(),
+	}, nil
+}
+
+// GetSecretsManagerType returns the type of the secrets manager
+func (r *RemoteSignerSecretsManager) GetSecretsManagerType() secrets.SecretsManagerType {
+	return secrets.RemoteSigner
+}
+
+// getPublicKey returns the cached validator public key and its identifier on the signer,
+// loading them from the signer if needed. The configured public key needs to be held by the signer
+func (r *RemoteSignerSecretsManager) getPublicKey() (*ecdsa.PublicKey, string, error) {
+	r.pubkeyLock.Lock()
+	defer r.pubkeyLock.Unlock()
+
+	if r.pubkey != nil {
+		return r.pubkey, r.identifier, nil
+	}
+
+	respBody, err := r.request(http.MethodGet, publicKeysPath, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var rawPubkeys []string
+	if err := json.Unmarshal(respBody, &rawPubkeys); err != nil {
+		return nil, "", fmt.Errorf("invalid public keys returned by remote signer, %w", err)
+	}
+
+	pubkeys := make([]*ecdsa.PublicKey, 0, len(rawPubkeys))
+
+	for _, rawPubkey := range rawPubkeys {
+		pubkey, err := parsePublicKey(rawPubkey)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid public key %s returned by remote signer, %w", rawPubkey, err)
+		}
+
+		pubkeys = append(pubkeys, pubkey)
+	}
+
+	if r.config.publicKey == "" {
+		if len(pubkeys) != 1 {
+			return nil, "", errNoPublicKey
+		}
+
+		r.pubkey, r.identifier = pubkeys[0], rawPubkeys[0]
+
+		return r.pubkey, r.identifier, nil
+	}
+
+	expected, err := parsePublicKey(r.config.publicKey)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid %s, %w", publicKeyField, err)
+	}
+
+	for i, pubkey := range pubkeys {
+		if pubkey.Equal(expected) {
+			r.pubkey, r.identifier = pubkey, rawPubkeys[i]
+
+			return r.pubkey, r.identifier, nil
+		}
+	}
+
+	return nil, "", fmt.Errorf("%w: %s", secrets.ErrSecretNotFound, r.config.publicKey)
+}
+
+// request sends the request to the signer and returns the response body.
+// Connection errors, timeouts and server errors are retried until the deadline of the request,
+// so that a failing signer doesn't hold the node past the round timeout
+func (r *RemoteSignerSecretsManager) request(method, path string, body []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.config.deadline)
+	defer cancel()
+
+	var (
+		respBody []byte
+		err      error
+	)
+
+	for attempt := 0; ; attempt++ {
+		if respBody, err = r.doRequest(ctx, method, path, body); err == nil {
+			return respBody, nil
+		}
+
+		if !isRetryable(err) || attempt >= r.config.retries || !r.waitRetry(ctx) {
+			break
+		}
+
+		r.logger.Debug("retrying remote signer request", "path", path, "attempt", attempt+1, "err", err)
+	}
+
+	r.logger.Error("remote signer request failed", "path", path, "err", err)
+
+	return nil, err
+}
+
+// waitRetry waits for the retry delay, it returns false if the deadline
+// of the request is reached before
+func (r *RemoteSignerSecretsManager) waitRetry(ctx context.Context) bool {
+	timer := time.NewTimer(r.config.retryDelay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// doRequest sends a single request, bounded by the request timeout and the deadline of ctx
+func (r *RemoteSignerSecretsManager) doRequest(
+	ctx context.Context,
+	method, path string,
+	body []byte,
+) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.config.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, method, r.serverURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if r.token != "" {
+		req.Header.Set("Authorization", "Bearer "+r.token)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &statusError{
+			code: resp.StatusCode,
+			body: strings.TrimSpace(string(respBody)),
+		}
+	}
+
+	return respBody, nil
+}
+
+// isRetryable checks if the failed request should be retried.
+// Only the client errors (4xx) are final, except for rate limiting
+func isRetryable(err error) bool {
+	var statusErr *statusError
+	if !errors.As(err, &statusErr) {
+		return true
+	}
+
+	return statusErr.code >= http.StatusInternalServerError ||
+		statusErr.code == http.StatusTooManyRequests
+}
+
+// parsePublicKey parses the hex encoded public key, with or without the 0x04 prefix
+func parsePublicKey(raw string) (*ecdsa.PublicKey, error) {
+	buf, err := hex.DecodeHex(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(buf) == 64 {
+		buf = append([]byte{0x04}, buf...)
+	}
+
+	return crypto.ParsePublicKey(buf)
+}
@@ -0,0 +1,514 @@
+package remotesigner
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/secrets/remotesigner/fakesigner"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeSigner starts the fake signer for a new key
+func newFakeSigner(t *testing.T) (*fakesigner.Server, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	key, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	server := fakesigner.NewServer(key)
+	t.Cleanup(server.Close)
+
+	return server, key
+}
+
+// newRemoteSigner creates the remote signer secrets manager for the given config
+func newRemoteSigner(t *testing.T, config *secrets.SecretsManagerConfig) *RemoteSignerSecretsManager {
+	t.Helper()
+
+	config.Type = secrets.RemoteSigner
+
+	manager, err := SecretsManagerFactory(config, &secrets.SecretsManagerParams{
+		Logger: hclog.NewNullLogger(),
+		Extra: map[string]interface{}{
+			secrets.Path: t.TempDir(),
+		},
+	})
+	require.NoError(t, err)
+
+	remoteManager, ok := manager.(*RemoteSignerSecretsManager)
+	require.True(t, ok)
+
+	return remoteManager
+}
+
+// assertSignature signs the preimage by the remote signer and checks it's made by the key
+func assertSignature(t *testing.T, manager *RemoteSignerSecretsManager, key *ecdsa.PrivateKey) {
+	t.Helper()
+
+	preimage := []byte("message")
+
+	sig, err := manager.SignPreimageBySecret(secrets.ValidatorKey, 100, preimage)
+	require.NoError(t, err)
+
+	pubkey, err := crypto.RecoverPubkey(sig, crypto.Keccak256(preimage))
+	require.NoError(t, err)
+	assert.True(t, key.PublicKey.Equal(pubkey))
+}
+
+func TestRemoteSigner_SignBySecret(t *testing.T) {
+	t.Parallel()
+
+	server, key := newFakeSigner(t)
+	server.SetToken("secret-token")
+
+	manager := newRemoteSigner(t, &secrets.SecretsManagerConfig{
+		ServerURL: server.URL,
+		Token:     "secret-token",
+	})
+
+	assert.True(t, manager.HasSecret(secrets.ValidatorKey))
+
+	info, err := manager.GetSecretInfo(secrets.ValidatorKey)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.PubKeyToAddress(&key.PublicKey).String(), info.Address)
+	assert.Equal(t, hex.EncodeToHex(crypto.MarshalPublicKey(&key.PublicKey)), info.Pubkey)
+
+	assertSignature(t, manager, key)
+
+	_, err = manager.GetSecret(secrets.ValidatorKey)
+	assert.ErrorIs(t, err, errKeyNotExportable)
+	assert.ErrorIs(t, manager.SetSecret(secrets.ValidatorKey, []byte{}), errKeyNotManaged)
+
+	// The other secrets are stored locally
+	require.NoError(t, manager.SetSecret(secrets.NetworkKey, []byte("network-key")))
+
+	networkKey, err := manager.GetSecret(secrets.NetworkKey)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("network-key"), networkKey)
+}
+
+func TestRemoteSigner_RequestFormat(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	// The signer returns the public key without the 0x04 prefix
+	pubkey := hex.EncodeToHex(crypto.MarshalPublicKey(&key.PublicKey)[1:])
+	preimage := []byte("message")
+
+	var requests []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		requests = append(requests, fmt.Sprintf(
+			"%s %s %s %s %s",
+			r.Method, r.URL.Path, r.Header.Get("Authorization"), r.Header.Get("Content-Type"), body,
+		))
+
+		switch r.URL.Path {
+		case "/api/v1/eth1/publicKeys":
+			_, _ = w.Write([]byte(`["` + pubkey + `"]`))
+		default:
+			sig, err := crypto.Sign(key, crypto.Keccak256(preimage))
+			assert.NoError(t, err)
+
+			sig[64] += 27
+
+			_, _ = w.Write([]byte(hex.EncodeToHex(sig)))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	manager := newRemoteSigner(t, &secrets.SecretsManagerConfig{
+		ServerURL: server.URL,
+		Token:     "token",
+	})
+
+	sig, err := manager.SignPreimageBySecret(secrets.ValidatorKey, 100, preimage)
+	require.NoError(t, err)
+
+	recovered, err := crypto.RecoverPubkey(sig, crypto.Keccak256(preimage))
+	require.NoError(t, err)
+	assert.True(t, key.PublicKey.Equal(recovered))
+
+	// The key is identified by the public key as returned by the signer
+	assert.Equal(t, []string{
+		"GET /api/v1/eth1/publicKeys Bearer token  ",
+		"POST /api/v1/eth1/sign/" + pubkey +
+			` Bearer token application/json {"data":"` + hex.EncodeToHex(preimage) + `"}`,
+	}, requests)
+
+	// The signer hashes the data, so the digests can't be signed as is
+	_, err = manager.SignBySecret(secrets.ValidatorKey, 100, crypto.Keccak256(preimage))
+	assert.ErrorIs(t, err, errDigestNotSigned)
+	assert.Len(t, requests, 2)
+}
+
+func TestRemoteSigner_PublicKey(t *testing.T) {
+	t.Parallel()
+
+	server, key := newFakeSigner(t)
+
+	otherKey, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	manager := newRemoteSigner(t, &secrets.SecretsManagerConfig{
+		ServerURL: server.URL,
+		Extra: map[string]interface{}{
+			string(publicKeyField): server.PublicKey(),
+		},
+	})
+
+	assertSignature(t, manager, key)
+
+	manager = newRemoteSigner(t, &secrets.SecretsManagerConfig{
+		ServerURL: server.URL,
+		Extra: map[string]interface{}{
+			string(publicKeyField): hex.EncodeToHex(crypto.MarshalPublicKey(&otherKey.PublicKey)),
+		},
+	})
+
+	assert.False(t, manager.HasSecret(secrets.ValidatorKey))
+
+	_, err = manager.SignPreimageBySecret(secrets.ValidatorKey, 100, []byte("message"))
+	assert.ErrorIs(t, err, secrets.ErrSecretNotFound)
+}
+
+func TestRemoteSigner_Unauthorized(t *testing.T) {
+	t.Parallel()
+
+	server, _ := newFakeSigner(t)
+	server.SetToken("secret-token")
+
+	manager := newRemoteSigner(t, &secrets.SecretsManagerConfig{
+		ServerURL: server.URL,
+		Token:     "wrong-token",
+		Extra: map[string]interface{}{
+			string(retriesField): "3",
+		},
+	})
+
+	_, err := manager.GetSecretInfo(secrets.ValidatorKey)
+	assert.ErrorContains(t, err, "status 401")
+
+	// Client errors are not retried
+	assert.Equal(t, 1, server.Requests())
+}
+
+func TestRemoteSigner_Retries(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		retries          int
+		failures         int
+		expectedRequests int
+		shouldFail       bool
+	}{
+		{
+			name:             "succeeds after retries",
+			retries:          3,
+			failures:         2,
+			expectedRequests: 3,
+		},
+		{
+			name:             "fails once the retries are exhausted",
+			retries:          1,
+			failures:         5,
+			expectedRequests: 2,
+			shouldFail:       true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, _ := newFakeSigner(t)
+			server.FailNext(test.failures)
+
+			manager := newRemoteSigner(t, &secrets.SecretsManagerConfig{
+				ServerURL: server.URL,
+				Extra: map[string]interface{}{
+					string(retriesField):    float64(test.retries),
+					string(retryDelayField): "1ms",
+				},
+			})
+
+			_, err := manager.GetSecretInfo(secrets.ValidatorKey)
+			if test.shouldFail {
+				assert.ErrorContains(t, err, "status 503")
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, test.expectedRequests, server.Requests())
+		})
+	}
+}
+
+func TestRemoteSigner_Timeout(t *testing.T) {
+	t.Parallel()
+
+	server, _ := newFakeSigner(t)
+	server.SetDelay(time.Second)
+
+	manager := newRemoteSigner(t, &secrets.SecretsManagerConfig{
+		ServerURL: server.URL,
+		Extra: map[string]interface{}{
+			string(timeoutField): "50ms",
+			string(retriesField): "0",
+		},
+	})
+
+	_, err := manager.GetSecretInfo(secrets.ValidatorKey)
+	assert.Error(t, err)
+}
+
+func TestRemoteSigner_Deadline(t *testing.T) {
+	t.Parallel()
+
+	server, _ := newFakeSigner(t)
+	server.FailNext(100)
+
+	manager := newRemoteSigner(t, &secrets.SecretsManagerConfig{
+		ServerURL: server.URL,
+		Extra: map[string]interface{}{
+			string(retriesField):    "50",
+			string(retryDelayField): "50ms",
+			string(deadlineField):   "200ms",
+		},
+	})
+
+	start := time.Now()
+
+	_, err := manager.GetSecretInfo(secrets.ValidatorKey)
+	assert.ErrorContains(t, err, "status 503")
+
+	// The retries stop at the deadline instead of running out
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Less(t, server.Requests(), 10)
+}
+
+func TestRemoteSigner_MutualTLS(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	caCert, caKey := generateCertificate(t, nil, nil, dir, "ca")
+	generateCertificate(t, caCert, caKey, dir, "server")
+	generateCertificate(t, caCert, caKey, dir, "client")
+
+	caPool := x509.NewCertPool()
+	caPool.AddCert(caCert)
+
+	serverKeyPair, err := tls.LoadX509KeyPair(filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key"))
+	require.NoError(t, err)
+
+	key, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	server := fakesigner.NewTLSServer(key, &tls.Config{
+		Certificates: []tls.Certificate{serverKeyPair},
+		ClientCAs:    caPool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	})
+	t.Cleanup(server.Close)
+
+	manager := newRemoteSigner(t, &secrets.SecretsManagerConfig{
+		ServerURL: server.URL,
+		Extra: map[string]interface{}{
+			string(tlsCAFileField):   filepath.Join(dir, "ca.crt"),
+			string(tlsCertFileField): filepath.Join(dir, "client.crt"),
+			string(tlsKeyFileField):  filepath.Join(dir, "client.key"),
+		},
+	})
+
+	assertSignature(t, manager, key)
+
+	// The signer rejects the connection without the client certificate
+	manager = newRemoteSigner(t, &secrets.SecretsManagerConfig{
+		ServerURL: server.URL,
+		Extra: map[string]interface{}{
+			string(tlsCAFileField): filepath.Join(dir, "ca.crt"),
+			string(retriesField):   "0",
+		},
+	})
+
+	assert.False(t, manager.HasSecret(secrets.ValidatorKey))
+}
+
+func Test_parseSignerConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		extra    map[string]interface{}
+		expected *signerConfig
+		err      string
+	}{
+		{
+			name:  "defaults",
+			extra: map[string]interface{}{},
+			expected: &signerConfig{
+				timeout:    defaultTimeout,
+				retries:    defaultRetries,
+				retryDelay: defaultRetryDelay,
+				deadline:   defaultDeadline,
+			},
+		},
+		{
+			name: "custom values",
+			extra: map[string]interface{}{
+				string(publicKeyField):  "0x04",
+				string(timeoutField):    "2s",
+				string(retriesField):    "5",
+				string(retryDelayField): "100ms",
+				string(deadlineField):   "3s",
+			},
+			expected: &signerConfig{
+				publicKey:  "0x04",
+				timeout:    2 * time.Second,
+				retries:    5,
+				retryDelay: 100 * time.Millisecond,
+				deadline:   3 * time.Second,
+			},
+		},
+		{
+			name: "deadline above the first round timeout",
+			extra: map[string]interface{}{
+				string(deadlineField): "10s",
+			},
+			err: "invalid deadline",
+		},
+		{
+			name: "invalid timeout",
+			extra: map[string]interface{}{
+				string(timeoutField): 5,
+			},
+			err: "invalid timeout",
+		},
+		{
+			name: "negative retries",
+			extra: map[string]interface{}{
+				string(retriesField): float64(-1),
+			},
+			err: "invalid retries",
+		},
+		{
+			name: "client certificate without key",
+			extra: map[string]interface{}{
+				string(tlsCertFileField): "client.crt",
+			},
+			err: errIncompleteTLSPair.Error(),
+		},
+		{
+			name: "missing CA file",
+			extra: map[string]interface{}{
+				string(tlsCAFileField): "/nonexistent/ca.crt",
+			},
+			err: "unable to read CA certificate",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			config, err := parseSignerConfig(test.extra)
+			if test.err != "" {
+				assert.ErrorContains(t, err, test.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected.publicKey, config.publicKey)
+			assert.Equal(t, test.expected.timeout, config.timeout)
+			assert.Equal(t, test.expected.retries, config.retries)
+			assert.Equal(t, test.expected.retryDelay, config.retryDelay)
+			assert.Equal(t, test.expected.deadline, config.deadline)
+		})
+	}
+}
+
+// generateCertificate generates the certificate signed by the parent (self-signed if nil)
+// and writes it to <name>.crt and <name>.key in the directory
+func generateCertificate(
+	t *testing.T,
+	parent *x509.Certificate,
+	parentKey *ecdsa.PrivateKey,
+	dir, name string,
+) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, name+".crt"),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		0600,
+	))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, name+".key"),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		0600,
+	))
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return cert, key
+}
@@ -19,6 +19,16 @@ const (
 
 	// Name is the name of the current node
 	Name = "name"
+
+	// Encrypted is the flag indicating whether new local secrets
+	// should be written as encrypted keystore files
+	Encrypted = "encrypted"
+
+	// Password is the password used for encrypting / decrypting local secrets
+	Password = "password"
+
+	// PasswordFile is the path to the file containing the password for local secrets
+	PasswordFile = "password-file"
 )
 
 // Define constant names for available secrets
@@ -68,6 +78,12 @@ const (
 
 	// Aws Kms type
 	AwsKms SecretsManagerType = "aws-kms"
+
+	// PKCS11 pertains to a PKCS#11 token, such as an HSM or SoftHSM
+	PKCS11 SecretsManagerType = "pkcs11"
+
+	// RemoteSigner pertains to an external signing service accessed over HTTP(S)
+	RemoteSigner SecretsManagerType = "remote-signer"
 )
 
 // SecretsManager defines the base public interface that all
@@ -98,6 +114,20 @@ type SecretsManager interface {
 	GetSecretsManagerType() SecretsManagerType
 }
 
+// SecretGenerator is implemented by the secrets managers that generate
+// secrets themselves, so the private key material never leaves the backend
+type SecretGenerator interface {
+	// GenerateSecret generates the secret by name
+	GenerateSecret(name string) error
+}
+
+// PreimageSigner is implemented by the secrets managers that hash the data themselves
+// before signing it, like the remote signers following the Web3Signer API
+type PreimageSigner interface {
+	// SignPreimageBySecret signs the keccak256 hash of the preimage by key
+	SignPreimageBySecret(key string, chainId int, preimage []byte) ([]byte, error)
+}
+
 // SecretsManagerParams defines the configuration params for the
 // secrets manager
 type SecretsManagerParams struct {
@@ -128,5 +158,12 @@ type SecretsManagerFactory func(
 // SupportedServiceManager checks if the passed in service manager type is supported
 func SupportedServiceManager(service SecretsManagerType) bool {
 	return service == HashicorpVault || service == AWSSSM ||
-		service == Local || service == GCPSSM || service == AwsKms
+		service == Local || service == GCPSSM || service == AwsKms ||
+		service == PKCS11 || service == RemoteSigner
+}
+
+// IsRemoteSigner checks if the passed in service manager type keeps the validator key
+// to itself, so it can only be used through SignBySecret and GetSecretInfo
+func IsRemoteSigner(service SecretsManagerType) bool {
+	return service == AwsKms || service == PKCS11 || service == RemoteSigner
 }
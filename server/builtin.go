@@ -11,6 +11,8 @@ import (
 	"github.com/0xPolygon/polygon-edge/secrets/gcpssm"
 	"github.com/0xPolygon/polygon-edge/secrets/hashicorpvault"
 	"github.com/0xPolygon/polygon-edge/secrets/local"
+	"github.com/0xPolygon/polygon-edge/secrets/pkcs11"
+	"github.com/0xPolygon/polygon-edge/secrets/remotesigner"
 )
 
 type ConsensusType string
@@ -35,6 +37,8 @@ var secretsManagerBackends = map[secrets.SecretsManagerType]secrets.SecretsManag
 	secrets.AWSSSM:         awsssm.SecretsManagerFactory,
 	secrets.GCPSSM:         gcpssm.SecretsManagerFactory,
 	secrets.AwsKms:         awskms.SecretsManagerFactory,
+	secrets.PKCS11:         pkcs11.SecretsManagerFactory,
+	secrets.RemoteSigner:   remotesigner.SecretsManagerFactory,
 }
 
 func ConsensusSupported(value string) bool {
@@ -6,6 +6,8 @@ import (
 	"github.com/hashicorp/go-hclog"
 
 	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/health"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
 )
@@ -36,16 +38,30 @@ type Config struct {
 
 	SecretsManager *secrets.SecretsManagerConfig
 
+	// SecretsPasswordFile is the path to the file containing
+	// the password of the encrypted local secrets
+	SecretsPasswordFile string
+
 	LogLevel hclog.Level
 
 	JSONLogFormat bool
 
 	LogFilePath string
+
+	// IBFTTransport is the transport of the IBFT messages
+	IBFTTransport string
+
+	// Health holds the thresholds of the readiness checks
+	Health *health.Config
 }
 
 // Telemetry holds the config details for metric services
 type Telemetry struct {
 	PrometheusAddr *net.TCPAddr
+
+	// Tracing is the config of the OTLP exporter of the spans,
+	// the tracing is disabled if it's not set
+	Tracing *tracing.Config
 }
 
 // JSONRPC holds the config details for the JSON-RPC server
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+)
+
+// OfflineServer is the blockchain stack of a stopped node opened directly on its data dir.
+// The networking, the gRPC and the JSON-RPC servers are not set up,
+// and the consensus and the txpool are not started
+type OfflineServer struct {
+	server *Server
+}
+
+// NewOfflineServer opens the chain in the data dir of the stopped node.
+// The databases are locked by the running node, so the node needs to be stopped first
+func NewOfflineServer(config *Config) (*OfflineServer, error) {
+	logger, err := newLoggerFromConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("could not setup new logger instance, %w", err)
+	}
+
+	m := &Server{
+		logger: logger.Named("server"),
+		config: config,
+		chain:  config.Chain,
+	}
+
+	m.logger.Info("Data dir", "path", config.DataDir)
+
+	// Generate all the paths in the dataDir
+	if err := common.SetupDataDir(config.DataDir, dirPaths); err != nil {
+		return nil, fmt.Errorf("failed to create data directories: %w", err)
+	}
+
+	// Set up the secrets manager
+	if err := m.setupSecretsManager(); err != nil {
+		return nil, fmt.Errorf("failed to set up the secrets manager: %w", err)
+	}
+
+	if err := m.setupBlockchain(logger); err != nil {
+		return nil, err
+	}
+
+	return &OfflineServer{server: m}, nil
+}
+
+// Blockchain returns the blockchain of the node
+func (s *OfflineServer) Blockchain() *blockchain.Blockchain {
+	return s.server.blockchain
+}
+
+// Close closes the blockchain, the consensus and the state storage
+func (s *OfflineServer) Close() {
+	if err := s.server.blockchain.Close(); err != nil {
+		s.server.logger.Error("failed to close blockchain", "err", err.Error())
+	}
+
+	if err := s.server.consensus.Close(); err != nil {
+		s.server.logger.Error("failed to close consensus", "err", err.Error())
+	}
+
+	if err := s.server.stateStorage.Close(); err != nil {
+		s.server.logger.Error("failed to close storage for trie", "err", err.Error())
+	}
+}
@@ -15,7 +15,9 @@ import (
 	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/consensus"
+	"github.com/0xPolygon/polygon-edge/contracts/chainparams"
 	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/health"
 	"github.com/0xPolygon/polygon-edge/helper/common"
 	configHelper "github.com/0xPolygon/polygon-edge/helper/config"
 	"github.com/0xPolygon/polygon-edge/helper/progress"
@@ -26,6 +28,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/state"
 	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/addresslist"
 
 	//"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
 	"github.com/0xPolygon/polygon-edge/txpool"
@@ -33,6 +36,7 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"google.golang.org/grpc"
 )
 
@@ -66,6 +70,12 @@ type Server struct {
 
 	prometheusServer *http.Server
 
+	// health runs the readiness checks of the node
+	health *health.Checker
+
+	// tracerProvider exports the spans, it's nil if the tracing is disabled
+	tracerProvider *sdktrace.TracerProvider
+
 	// secrets manager
 	secretsManager secrets.SecretsManager
 
@@ -150,6 +160,12 @@ func NewServer(config *Config) (*Server, error) {
 		m.prometheusServer = m.startPrometheusServer(config.Telemetry.PrometheusAddr)
 	}
 
+	if config.Telemetry.Tracing != nil {
+		if err := m.setupTracing(config.Telemetry.Tracing); err != nil {
+			return nil, err
+		}
+	}
+
 	// Set up datadog profiler
 	if ddErr := m.enableDataDogProfiler(); err != nil {
 		m.logger.Error("DataDog profiler setup failed", "err", ddErr.Error())
@@ -174,113 +190,141 @@ func NewServer(config *Config) (*Server, error) {
 		m.network = network
 	}
 
+	// start the blockchain stack
+	if err := m.setupBlockchain(logger); err != nil {
+		return nil, err
+	}
+
+	m.setupHealth()
+
+	// setup and start grpc server
+	if err := m.setupGRPC(); err != nil {
+		return nil, err
+	}
+
+	if err := m.network.Start(); err != nil {
+		return nil, err
+	}
+
+	// setup and start jsonrpc server
+	if err := m.setupJSONRPC(); err != nil {
+		return nil, err
+	}
+
+	// restore archive data before starting
+	if err := m.restoreChain(); err != nil {
+		return nil, err
+	}
+
+	// start consensus
+	if err := m.consensus.Start(); err != nil {
+		return nil, err
+	}
+
+	m.txpool.Start()
+
+	return m, nil
+}
+
+// setupBlockchain sets up the state, the blockchain, the txpool and the consensus,
+// and initializes the consensus on top of the stored chain
+func (s *Server) setupBlockchain(logger hclog.Logger) error {
 	// start blockchain object
-	stateStorage, err := itrie.NewLevelDBStorage(filepath.Join(m.config.DataDir, "trie"), logger)
+	stateStorage, err := itrie.NewLevelDBStorage(filepath.Join(s.config.DataDir, "trie"), logger)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	m.stateStorage = stateStorage
+	s.stateStorage = stateStorage
 
 	st := itrie.NewState(stateStorage)
-	m.state = st
+	s.state = st
 
-	m.executor = state.NewExecutor(config.Chain.Params, st, logger)
+	s.executor = state.NewExecutor(s.config.Chain.Params, st, logger)
 
 	// compute the genesis root state
-	genesisRoot := m.executor.WriteGenesis(config.Chain.Genesis.Alloc)
-	config.Chain.Genesis.StateRoot = genesisRoot
+	genesisRoot := s.executor.WriteGenesis(s.config.Chain.Genesis.Alloc)
+	s.config.Chain.Genesis.StateRoot = genesisRoot
 
 	// use the eip155 signer
-	signer := crypto.NewEIP155Signer(uint64(m.config.Chain.Params.ChainID))
+	signer := crypto.NewEIP155Signer(uint64(s.config.Chain.Params.ChainID))
 
 	// blockchain object
-	m.blockchain, err = blockchain.NewBlockchain(logger, m.config.DataDir, config.Chain, nil, m.executor, signer)
+	s.blockchain, err = blockchain.NewBlockchain(logger, s.config.DataDir, s.config.Chain, nil, s.executor, signer)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	m.executor.GetHash = m.blockchain.GetHashHelper
+	s.executor.GetHash = s.blockchain.GetHashHelper
 
 	{
 		hub := &txpoolHub{
-			state:      m.state,
-			Blockchain: m.blockchain,
+			state:      s.state,
+			Blockchain: s.blockchain,
 		}
 
-		deploymentWhitelist, err := configHelper.GetDeploymentWhitelist(config.Chain)
+		deploymentWhitelist, err := configHelper.GetDeploymentWhitelist(s.config.Chain)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
 		// start transaction pool
-		m.txpool, err = txpool.NewTxPool(
+		s.txpool, err = txpool.NewTxPool(
 			logger,
-			m.chain.Params.Forks.At(0),
+			s.chain.Params.Forks.At(0),
 			hub,
-			m.grpcServer,
-			m.network,
+			s.grpcServer,
+			s.network,
 			&txpool.Config{
-				MaxSlots:            m.config.MaxSlots,
-				PriceLimit:          m.config.PriceLimit,
-				MaxAccountEnqueued:  m.config.MaxAccountEnqueued,
+				MaxSlots:            s.config.MaxSlots,
+				PriceLimit:          s.config.PriceLimit,
+				MaxAccountEnqueued:  s.config.MaxAccountEnqueued,
 				DeploymentWhitelist: deploymentWhitelist,
 			},
 		)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		m.txpool.SetSigner(signer)
+		s.txpool.SetSigner(signer)
+
+		if s.chain.Params.ContractDeployerAllowList != nil || s.chain.Params.TransactionsAllowList != nil {
+			s.txpool.SetAddressLists(hub)
+		}
+	}
+
+	if s.chain.Params.ChainParametersContract {
+		// read the parameters governed by the validators from the contract
+		chainParams := chainparams.NewReader(s.executor)
+
+		s.blockchain.SetChainParameters(chainParams)
+		s.txpool.SetChainParameters(chainParams)
+
+		// enforce the governed values on the transactions of the blocks
+		s.executor.SetChainParameters(chainparams.NewStateReader())
 	}
 
 	{
 		// Setup consensus
-		if err := m.setupConsensus(); err != nil {
-			return nil, err
+		if err := s.setupConsensus(); err != nil {
+			return err
 		}
-		m.blockchain.SetConsensus(m.consensus)
+		s.blockchain.SetConsensus(s.consensus)
 	}
 
 	// after consensus is done, we can mine the genesis block in blockchain
 	// This is done because consensus might use a custom Hash function so we need
 	// to wait for consensus because we do any block hashing like genesis
-	if err := m.blockchain.ComputeGenesis(); err != nil {
-		return nil, err
+	if err := s.blockchain.ComputeGenesis(); err != nil {
+		return err
 	}
 
 	// initialize data in consensus layer
-	if err := m.consensus.Initialize(); err != nil {
-		return nil, err
-	}
-
-	// setup and start grpc server
-	if err := m.setupGRPC(); err != nil {
-		return nil, err
-	}
-
-	if err := m.network.Start(); err != nil {
-		return nil, err
-	}
-
-	// setup and start jsonrpc server
-	if err := m.setupJSONRPC(); err != nil {
-		return nil, err
-	}
-
-	// restore archive data before starting
-	if err := m.restoreChain(); err != nil {
-		return nil, err
-	}
-
-	// start consensus
-	if err := m.consensus.Start(); err != nil {
-		return nil, err
+	if err := s.consensus.Initialize(); err != nil {
+		return err
 	}
 
-	m.txpool.Start()
-
-	return m, nil
+	return nil
 }
 
 func (s *Server) restoreChain() error {
@@ -329,6 +373,42 @@ func (t *txpoolHub) GetNonce(root types.Hash, addr types.Address) uint64 {
 	return account.Nonce
 }
 
+// IsContractDeployerAllowed returns whether the address is allowed to deploy contracts
+// by the allow list in the state
+func (t *txpoolHub) IsContractDeployerAllowed(root types.Hash, addr types.Address) (bool, error) {
+	if t.Config().ContractDeployerAllowList == nil {
+		return true, nil
+	}
+
+	return t.isAllowed(root, addresslist.AllowListContractsAddr, addr)
+}
+
+// IsTransactionSenderAllowed returns whether the address is allowed to send transactions
+// by the allow list in the state
+func (t *txpoolHub) IsTransactionSenderAllowed(root types.Hash, addr types.Address) (bool, error) {
+	if t.Config().TransactionsAllowList == nil {
+		return true, nil
+	}
+
+	return t.isAllowed(root, addresslist.AllowListTransactionsAddr, addr)
+}
+
+func (t *txpoolHub) isAllowed(root types.Hash, listAddr, addr types.Address) (bool, error) {
+	account, err := getAccountImpl(t.state, root, listAddr)
+	if err != nil {
+		return false, err
+	}
+
+	snap, err := t.state.NewSnapshotAt(root)
+	if err != nil {
+		return false, err
+	}
+
+	role := snap.GetStorage(listAddr, account.Root, addresslist.RoleKey(addr))
+
+	return addresslist.Role(role).Enabled(), nil
+}
+
 func (t *txpoolHub) GetBalance(root types.Hash, addr types.Address) (*big.Int, error) {
 	account, err := getAccountImpl(t.state, root, addr)
 
@@ -362,15 +442,20 @@ func (s *Server) setupSecretsManager() error {
 		// Only the base directory is required for
 		// the local secrets manager
 		secretsManagerParams.Extra = map[string]interface{}{
-			secrets.Path: s.config.DataDir,
+			secrets.Path:         s.config.DataDir,
+			secrets.PasswordFile: s.config.SecretsPasswordFile,
 		}
 	}
 
-	if secretsManagerType == secrets.AwsKms {
+	if secrets.IsRemoteSigner(secretsManagerType) {
+		// The secrets other than the validator key
+		// are kept by the local secrets manager
 		secretsManagerParams.Extra = map[string]interface{}{
-			secrets.Path: s.config.DataDir,
+			secrets.Path:         s.config.DataDir,
+			secrets.PasswordFile: s.config.SecretsPasswordFile,
 		}
 	}
+
 	// Grab the factory method
 	secretsManagerFactory, ok := secretsManagerBackends[secretsManagerType]
 	if !ok {
@@ -424,6 +509,7 @@ func (s *Server) setupConsensus() error {
 			Logger:         s.logger,
 			SecretsManager: s.secretsManager,
 			BlockTime:      s.config.BlockTime,
+			IBFTTransport:  s.config.IBFTTransport,
 		},
 	)
 
@@ -447,6 +533,48 @@ type jsonRPCHub struct {
 	consensus.Consensus
 }
 
+// blockIntervalConsensus is the consensus skipping the empty blocks
+type blockIntervalConsensus interface {
+	GetMaxBlockInterval(height uint64) (time.Duration, error)
+}
+
+// GetMaxBlockInterval returns the max interval between the block at the given height and the next one,
+// zero if the consensus doesn't skip the empty blocks
+func (j *jsonRPCHub) GetMaxBlockInterval(height uint64) time.Duration {
+	c, ok := j.Consensus.(blockIntervalConsensus)
+	if !ok {
+		return 0
+	}
+
+	interval, err := c.GetMaxBlockInterval(height + 1)
+	if err != nil {
+		return 0
+	}
+
+	return interval
+}
+
+// GetIBFTBackend returns the IBFT consensus, nil if the consensus is not IBFT
+func (j *jsonRPCHub) GetIBFTBackend() jsonrpc.IBFTBackend {
+	if backend, ok := j.Consensus.(jsonrpc.IBFTBackend); ok {
+		return backend
+	}
+
+	return nil
+}
+
+// GetFinalizedNumber returns the number of the latest finalized block known by the consensus,
+// the finalized block tag of the JSON-RPC resolves to it
+func (j *jsonRPCHub) GetFinalizedNumber(latest uint64) uint64 {
+	return j.Consensus.GetFinalizedNumber(latest)
+}
+
+// GetSafeNumber returns the number of the latest safe block known by the consensus,
+// the safe block tag of the JSON-RPC resolves to it
+func (j *jsonRPCHub) GetSafeNumber(latest uint64) uint64 {
+	return j.Consensus.GetSafeNumber(latest)
+}
+
 func (j *jsonRPCHub) GetPeers() int {
 	return len(j.Server.Peers())
 }
@@ -503,17 +631,23 @@ func (j *jsonRPCHub) GetCode(root types.Hash, addr types.Address) ([]byte, error
 func (j *jsonRPCHub) ApplyTxn(
 	header *types.Header,
 	txn *types.Transaction,
+	stateOverride types.StateOverride,
+	blockOverrides *types.BlockOverrides,
 ) (result *runtime.ExecutionResult, err error) {
-	blockCreator, err := j.GetConsensus().GetBlockCreator(header)
+	blockCreator, err := j.getCallBlockCreator(header, blockOverrides)
 	if err != nil {
 		return nil, err
 	}
 
-	transition, err := j.BeginTxn(header.StateRoot, header, blockCreator)
+	transition, err := j.BeginTxn(header.StateRoot, blockOverrides.Apply(header), blockCreator)
 	if err != nil {
 		return
 	}
 
+	if err = transition.ApplyStateOverride(stateOverride); err != nil {
+		return
+	}
+
 	result, err = transition.Apply(txn)
 
 	return
@@ -558,24 +692,57 @@ func (j *jsonRPCHub) ApplyMessage(
 	header *types.Header,
 	txn *types.Transaction,
 	tracerConfig runtime.TraceConfig,
+	stateOverride types.StateOverride,
+	blockOverrides *types.BlockOverrides,
 ) (result *runtime.ExecutionResult, err error) {
-	blockCreator, err := j.GetConsensus().GetBlockCreator(header)
+	blockCreator, err := j.getCallBlockCreator(header, blockOverrides)
 	if err != nil {
 		return nil, err
 	}
 
 	// using tracerConfig to capture log
-	transition, err := j.BeginTxnTracer(parentHeader.StateRoot, header, blockCreator, tracerConfig)
+	transition, err := j.BeginTxnTracer(parentHeader.StateRoot, blockOverrides.Apply(header), blockCreator, tracerConfig)
 
 	if err != nil {
 		return
 	}
 
+	if err = transition.ApplyStateOverride(stateOverride); err != nil {
+		return
+	}
+
 	result, err = transition.Apply(txn)
 
 	return
 }
 
+func (j *jsonRPCHub) SimulateCalls(header *types.Header, bundles []*state.CallBundle, gasLimit uint64) error {
+	blockCreator, err := j.GetConsensus().GetBlockCreator(header)
+	if err != nil {
+		return err
+	}
+
+	transition, err := j.BeginTxn(header.StateRoot, header, blockCreator)
+	if err != nil {
+		return err
+	}
+
+	return transition.Simulate(header, blockCreator, bundles, gasLimit)
+}
+
+// getCallBlockCreator returns the coinbase a call is executed with,
+// the creator of the block unless it's overridden
+func (j *jsonRPCHub) getCallBlockCreator(
+	header *types.Header,
+	blockOverrides *types.BlockOverrides,
+) (types.Address, error) {
+	if blockOverrides != nil && blockOverrides.Coinbase != nil {
+		return *blockOverrides.Coinbase, nil
+	}
+
+	return j.GetConsensus().GetBlockCreator(header)
+}
+
 func (j *jsonRPCHub) GetSyncProgression() *progress.Progression {
 	// restore progression
 	if restoreProg := j.restoreProgression.GetProgression(); restoreProg != nil {
@@ -613,6 +780,7 @@ func (s *Server) setupJSONRPC() error {
 		PriceLimit:               s.config.PriceLimit,
 		BatchLengthLimit:         s.config.JSONRPC.BatchLengthLimit,
 		BlockRangeLimit:          s.config.JSONRPC.BlockRangeLimit,
+		Health:                   s.health,
 	}
 
 	srv, err := jsonrpc.NewJSONRPC(s.logger, conf)
@@ -625,6 +793,19 @@ func (s *Server) setupJSONRPC() error {
 	return nil
 }
 
+// setupHealth sets up the readiness checks, reported by the jsonrpc and grpc servers
+func (s *Server) setupHealth() {
+	hub := &jsonRPCHub{
+		restoreProgression: s.restoreProgression,
+		Blockchain:         s.blockchain,
+		TxPool:             s.txpool,
+		Consensus:          s.consensus,
+		Server:             s.network,
+	}
+
+	s.health = health.NewChecker(hub, s.config.Health)
+}
+
 // setupGRPC sets up the grpc server and listens on tcp
 func (s *Server) setupGRPC() error {
 	proto.RegisterSystemServer(s.grpcServer, &systemService{server: s})
@@ -686,6 +867,9 @@ func (s *Server) Close() {
 	// close the txpool's main loop
 	s.txpool.Close()
 
+	// flush the remaining spans
+	s.closeTracing()
+
 	// close DataDog profiler
 	s.closeDataDogProfiler()
 }
@@ -1,10 +1,12 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/armon/go-metrics"
 	"github.com/armon/go-metrics/prometheus"
 	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
@@ -29,6 +31,31 @@ func (s *Server) setupTelemetry() error {
 	return nil
 }
 
+// setupTracing sets up the export of the OpenTelemetry spans to the OTLP collector
+func (s *Server) setupTracing(config *tracing.Config) error {
+	provider, err := tracing.NewProvider(context.Background(), config)
+	if err != nil {
+		return err
+	}
+
+	s.tracerProvider = provider
+	s.logger.Info("OpenTelemetry tracing enabled", "endpoint", config.Endpoint)
+
+	return nil
+}
+
+func (s *Server) closeTracing() {
+	if s.tracerProvider == nil {
+		return
+	}
+
+	s.logger.Debug("closing OpenTelemetry tracer provider")
+
+	if err := s.tracerProvider.Shutdown(context.Background()); err != nil {
+		s.logger.Error("OpenTelemetry tracer provider shutdown error", "err", err)
+	}
+}
+
 // enableDataDogProfiler enables DataDog profiler. Enable it by setting DD_ENABLE env var.
 // Additional parameters can be set with env vars (DD_) - https://docs.datadoghq.com/profiler/enabling/go/
 func (s *Server) enableDataDogProfiler() error {
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/consensus"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type finalityMockConsensus struct {
+	consensus.Consensus
+
+	confirmations uint64
+}
+
+func (m *finalityMockConsensus) GetFinalizedNumber(latest uint64) uint64 {
+	return consensus.ConfirmedNumber(latest, m.confirmations)
+}
+
+func (m *finalityMockConsensus) GetSafeNumber(latest uint64) uint64 {
+	return consensus.ConfirmedNumber(latest, m.confirmations/2)
+}
+
+func TestJSONRPCHub_Finality(t *testing.T) {
+	t.Parallel()
+
+	hub := &jsonRPCHub{
+		Blockchain: blockchain.NewTestBlockchain(t, blockchain.NewTestHeaders(11)),
+		Consensus: &finalityMockConsensus{
+			confirmations: 4,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		number   jsonrpc.BlockNumber
+		expected uint64
+	}{
+		{
+			name:     "latest block",
+			number:   jsonrpc.LatestBlockNumber,
+			expected: 10,
+		},
+		{
+			name:     "finalized block from the consensus",
+			number:   jsonrpc.FinalizedBlockNumber,
+			expected: 6,
+		},
+		{
+			name:     "safe block from the consensus",
+			number:   jsonrpc.SafeBlockNumber,
+			expected: 8,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			num, err := jsonrpc.GetNumericBlockNumber(test.number, hub)
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, num)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/health"
 	"github.com/0xPolygon/polygon-edge/network/common"
 	"github.com/0xPolygon/polygon-edge/server/proto"
 	"github.com/0xPolygon/polygon-edge/types"
@@ -27,6 +28,8 @@ type systemService struct {
 // Current: { Number: <blockNumber>; Hash: <headerHash> }
 //
 // P2PAddr: <libp2pAddress>
+//
+// Readiness: { Ready: <allChecksPassed>; Checks: [{ Name; Passed; Message }] }
 func (s *systemService) GetStatus(ctx context.Context, req *empty.Empty) (*proto.ServerStatus, error) {
 	header := s.server.blockchain.Header()
 
@@ -36,12 +39,31 @@ func (s *systemService) GetStatus(ctx context.Context, req *empty.Empty) (*proto
 			Number: int64(header.Number),
 			Hash:   header.Hash.String(),
 		},
-		P2PAddr: common.AddrInfoToString(s.server.network.AddrInfo()),
+		P2PAddr:   common.AddrInfoToString(s.server.network.AddrInfo()),
+		Readiness: toProtoReadiness(s.server.health.Readiness()),
 	}
 
 	return status, nil
 }
 
+// toProtoReadiness converts the readiness status to the proto message
+func toProtoReadiness(status *health.Status) *proto.ServerStatus_Readiness {
+	readiness := &proto.ServerStatus_Readiness{
+		Ready:  status.Ready,
+		Checks: make([]*proto.ServerStatus_Check, len(status.Checks)),
+	}
+
+	for i, check := range status.Checks {
+		readiness.Checks[i] = &proto.ServerStatus_Check{
+			Name:    check.Name,
+			Passed:  check.Passed,
+			Message: check.Message,
+		}
+	}
+
+	return readiness
+}
+
 // Subscribe implements the blockchain event subscription service
 func (s *systemService) Subscribe(req *empty.Empty, stream proto.System_SubscribeServer) error {
 	sub := s.server.blockchain.SubscribeEvents()
@@ -1,6 +1,7 @@
 package state
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
@@ -9,21 +10,29 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/addresslist"
 	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
 	"github.com/0xPolygon/polygon-edge/state/runtime/precompiled"
 	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
 	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/armon/go-metrics"
 	"github.com/hashicorp/go-hclog"
 )
 
 const (
 	spuriousDragonMaxCodeSize = 24576
 
+	executorMetrics = "executor"
+
 	TxGas                 uint64 = 21000 // Per transaction not creating a contract
 	TxGasContractCreation uint64 = 53000 // Per transaction that creates a contract
 )
 
+// otelTracer records the spans of the executor, not to be confused with the EVM tracers
+var otelTracer = tracing.Tracer("state")
+
 var emptyCodeHashTwo = types.BytesToHash(crypto.Keccak256(nil))
 
 // GetHashByNumber returns the hash function of a block number
@@ -40,6 +49,19 @@ type Executor struct {
 	GetHash  GetHashByNumberHelper
 
 	PostHook func(txn *Transition)
+
+	// chain parameters governed on chain, nil if not enabled
+	chainParams ChainParameters
+}
+
+// ChainParameters is the interface of the chain parameters governed on chain,
+// the transactions written to the blocks must satisfy them
+type ChainParameters interface {
+	// MinGasPrice returns the min gas price of the transactions in the state, nil if not set
+	MinGasPrice(txn *Txn) *big.Int
+	// IsDeploymentAllowed returns whether the address is allowed to deploy contracts in the state,
+	// the second flag is false if the deployment whitelist is not governed
+	IsDeploymentAllowed(txn *Txn, addr types.Address) (bool, bool)
 }
 
 // NewExecutor creates a new executor
@@ -90,29 +112,49 @@ func (e *Executor) ProcessBlock(
 	parentRoot types.Hash,
 	block *types.Block,
 	blockCreator types.Address,
-) (*Transition, error) {
+) (_ *Transition, err error) {
+	defer metrics.MeasureSince([]string{executorMetrics, "block_execution_time"}, time.Now())
+
+	ctx, span := otelTracer.Start(context.Background(), "Executor.ProcessBlock")
+	span.SetAttributes(tracing.Block(block.Header)...)
+
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
 	txn, err := e.BeginTxn(parentRoot, block.Header, blockCreator)
 	if err != nil {
 		return nil, err
 	}
 
 	for _, t := range block.Transactions {
-		if t.ExceedsBlockGasLimit(block.Header.GasLimit) {
-			if err := txn.WriteFailedReceipt(t); err != nil {
-				return nil, err
-			}
-
-			continue
-		}
-
-		if err := txn.Write(t); err != nil {
+		if err := e.processTx(ctx, txn, t, block.Header.GasLimit); err != nil {
 			return nil, err
 		}
 	}
 
+	metrics.SetGauge([]string{executorMetrics, "block_gas_used"}, float32(txn.TotalGas()))
+
 	return txn, nil
 }
 
+// processTx writes the transaction of the processed block to the transition,
+// the transaction is traced by the child span of the block
+func (e *Executor) processTx(ctx context.Context, txn *Transition, t *types.Transaction, gasLimit uint64) (err error) {
+	_, span := otelTracer.Start(ctx, "Executor.processTx")
+	span.SetAttributes(tracing.TxHash(t.Hash))
+
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
+	if t.ExceedsBlockGasLimit(gasLimit) {
+		return txn.WriteFailedReceipt(t)
+	}
+
+	return txn.Write(t)
+}
+
 // StateAt returns snapshot at given root
 func (e *Executor) State() State {
 	return e.state
@@ -123,6 +165,11 @@ func (e *Executor) StateAt(root types.Hash) (Snapshot, error) {
 	return e.state.NewSnapshotAt(root)
 }
 
+// SetChainParameters sets the chain parameters governed on chain
+func (e *Executor) SetChainParameters(p ChainParameters) {
+	e.chainParams = p
+}
+
 // GetForksInTime returns the active forks at the given block height
 func (e *Executor) GetForksInTime(blockNumber uint64) chain.ForksInTime {
 	return e.config.Forks.At(blockNumber)
@@ -175,8 +222,11 @@ func (e *Executor) BeginTxn(
 		evm:         evm.NewEVM(),
 		precompiles: precompiled.NewPrecompiled(),
 		PostHook:    e.PostHook,
+		chainParams: e.chainParams,
 	}
 
+	txn.setupAddressLists()
+
 	return txn, nil
 }
 
@@ -215,6 +265,7 @@ func (e *Executor) BeginTxnTracer(
 		r:        e,
 		ctx:      txCtx,
 		state:    newTxn,
+		snap:     auxSnap2,
 		getHash:  e.GetHash(header),
 		auxState: e.state,
 		config:   forkConfig,
@@ -223,8 +274,14 @@ func (e *Executor) BeginTxnTracer(
 		receipts:    []*types.Receipt{},
 		totalGas:    0,
 		traceConfig: tracerConfig, // 由调用者传入新的tracerConfig...
+
+		evm:         evm.NewEVM(),
+		precompiles: precompiled.NewPrecompiled(),
+		chainParams: e.chainParams,
 	}
 
+	txn.setupAddressLists()
+
 	return txn, nil
 }
 
@@ -259,6 +316,13 @@ type Transition struct {
 	// runtimes
 	evm         *evm.EVM
 	precompiles *precompiled.Precompiled
+
+	// address lists, nil if not enabled in the chain
+	deploymentAllowList *addresslist.AddressList
+	txnAllowList        *addresslist.AddressList
+
+	// chain parameters governed on chain, nil if not enabled
+	chainParams ChainParameters
 }
 
 func NewTransition(config chain.ForksInTime, snap Snapshot, radix *Txn) *Transition {
@@ -277,6 +341,17 @@ func NewTransition(config chain.ForksInTime, snap Snapshot, radix *Txn) *Transit
 	}
 }
 
+// setupAddressLists sets up the address lists enabled in the chain
+func (t *Transition) setupAddressLists() {
+	if t.r.config.ContractDeployerAllowList != nil {
+		t.deploymentAllowList = addresslist.NewAddressList(t, addresslist.AllowListContractsAddr)
+	}
+
+	if t.r.config.TransactionsAllowList != nil {
+		t.txnAllowList = addresslist.NewAddressList(t, addresslist.AllowListTransactionsAddr)
+	}
+}
+
 func (t *Transition) TotalGas() uint64 {
 	return t.totalGas
 }
@@ -333,6 +408,10 @@ func (t *Transition) Write(txn *types.Transaction) error {
 	// Make a local copy and apply the transaction
 	msg := txn.Copy()
 
+	if err := t.chainParamsCheck(msg); err != nil {
+		return NewTransitionApplicationError(err, false)
+	}
+
 	result, e := t.Apply(msg)
 	if e != nil {
 		t.logger.Error("failed to apply tx", "err", e)
@@ -452,6 +531,40 @@ func (t *Transition) subGasLimitPrice(msg *types.Transaction) error {
 	return nil
 }
 
+func (t *Transition) addressListsCheck(msg *types.Transaction) error {
+	if t.txnAllowList != nil && !t.txnAllowList.GetRole(msg.From).Enabled() {
+		return ErrTransactionNotAllowed
+	}
+
+	if msg.IsContractCreation() && t.deploymentAllowList != nil &&
+		!t.deploymentAllowList.GetRole(msg.From).Enabled() {
+		return ErrDeploymentNotAllowed
+	}
+
+	return nil
+}
+
+// chainParamsCheck checks the transaction of the block satisfies the chain parameters governed on chain.
+// The calls not written to the blocks, e.g. eth_call, are not checked.
+// The deployments by the contracts are checked in applyCreate
+func (t *Transition) chainParamsCheck(msg *types.Transaction) error {
+	if t.chainParams == nil {
+		return nil
+	}
+
+	if minGasPrice := t.chainParams.MinGasPrice(t.state); minGasPrice != nil && msg.GasPrice.Cmp(minGasPrice) < 0 {
+		return ErrGasPriceTooLow
+	}
+
+	if msg.IsContractCreation() {
+		if allowed, governed := t.chainParams.IsDeploymentAllowed(t.state, msg.From); governed && !allowed {
+			return ErrDeploymentNotAllowed
+		}
+	}
+
+	return nil
+}
+
 func (t *Transition) nonceCheck(msg *types.Transaction) error {
 	nonce := t.state.GetNonce(msg.From)
 
@@ -472,6 +585,9 @@ var (
 	ErrIntrinsicGasOverflow  = fmt.Errorf("overflow in intrinsic gas calculation")
 	ErrNotEnoughIntrinsicGas = fmt.Errorf("not enough gas supplied for intrinsic gas costs")
 	ErrNotEnoughFunds        = fmt.Errorf("not enough funds for transfer with given value")
+	ErrDeploymentNotAllowed  = fmt.Errorf("sender is not allowed to deploy contracts")
+	ErrTransactionNotAllowed = fmt.Errorf("sender is not allowed to send transactions")
+	ErrGasPriceTooLow        = fmt.Errorf("gas price lower than the min gas price of the chain")
 )
 
 type TransitionApplicationError struct {
@@ -507,9 +623,10 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 	// 1. the nonce of the message caller is correct
 	// 2. caller has enough balance to cover transaction fee(gaslimit * gasprice)
 	// 3. the amount of gas required is available in the block
-	// 4. there is no overflow when calculating intrinsic gas
-	// 5. the purchased gas is enough to cover intrinsic usage
-	// 6. caller has enough balance to cover asset transfer for **topmost** call
+	// 4. caller is allowed by the address lists
+	// 5. there is no overflow when calculating intrinsic gas
+	// 6. the purchased gas is enough to cover intrinsic usage
+	// 7. caller has enough balance to cover asset transfer for **topmost** call
 	txn := t.state
 
 	// 1. the nonce of the message caller is correct
@@ -527,33 +644,40 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 		return nil, NewGasLimitReachedTransitionApplicationError(err)
 	}
 
-	// start tracing
-	var result *runtime.ExecutionResult
-	if t.traceConfig.Debug {
-		t.traceConfig.Tracer.CaptureTxStart(msg.Gas)
-		defer func() {
-			t.traceConfig.Tracer.CaptureTxEnd(result.GasLeft)
-		}()
+	// 4. caller is allowed by the address lists
+	if err := t.addressListsCheck(msg); err != nil {
+		return nil, NewTransitionApplicationError(err, false)
 	}
 
-	// 4. there is no overflow when calculating intrinsic gas
+	// 5. there is no overflow when calculating intrinsic gas
 	intrinsicGasCost, err := TransactionGasCost(msg, t.config.Homestead, t.config.Istanbul)
 	if err != nil {
 		return nil, NewTransitionApplicationError(err, false)
 	}
 
-	// 5. the purchased gas is enough to cover intrinsic usage
+	// 6. the purchased gas is enough to cover intrinsic usage
 	gasLeft := msg.Gas - intrinsicGasCost
 	// Because we are working with unsigned integers for gas, the `>` operator is used instead of the more intuitive `<`
 	if gasLeft > msg.Gas {
 		return nil, NewTransitionApplicationError(ErrNotEnoughIntrinsicGas, false)
 	}
 
-	// 6. caller has enough balance to cover asset transfer for **topmost** call
+	// 7. caller has enough balance to cover asset transfer for **topmost** call
 	if balance := txn.GetBalance(msg.From); balance.Cmp(msg.Value) < 0 {
 		return nil, NewTransitionApplicationError(ErrNotEnoughFunds, true)
 	}
 
+	// start tracing once the message is valid
+	var result *runtime.ExecutionResult
+	if t.traceConfig.Debug {
+		t.traceConfig.Tracer.CaptureTxStart(msg.Gas)
+		defer func() {
+			if result != nil {
+				t.traceConfig.Tracer.CaptureTxEnd(result.GasLeft)
+			}
+		}()
+	}
+
 	gasPrice := new(big.Int).Set(msg.GasPrice)
 	value := new(big.Int).Set(msg.Value)
 
@@ -614,6 +738,13 @@ func (t *Transition) Call2(
 }
 
 func (t *Transition) run(contract *runtime.Contract, host runtime.Host) *runtime.ExecutionResult {
+	// check the address lists
+	for _, list := range []*addresslist.AddressList{t.deploymentAllowList, t.txnAllowList} {
+		if list != nil && list.CanRun(contract, host, &t.config) {
+			return list.Run(contract, host, &t.config)
+		}
+	}
+
 	// check the precompiles
 	if t.precompiles.CanRun(contract, host, &t.config) {
 		return t.precompiles.Run(contract, host, &t.config)
@@ -658,6 +789,39 @@ func (t *Transition) SetTracerConfig(tracerConfig runtime.TraceConfig) {
 	t.traceConfig = tracerConfig
 }
 
+var ErrStateOverrideConflict = fmt.Errorf("both state and stateDiff are overridden for the account")
+
+// ApplyStateOverride overrides the accounts in the state before a call is executed
+func (t *Transition) ApplyStateOverride(override types.StateOverride) error {
+	for addr, account := range override {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("%w: %s", ErrStateOverrideConflict, addr)
+		}
+
+		if account.Nonce != nil {
+			t.state.SetNonce(addr, *account.Nonce)
+		}
+
+		if account.Balance != nil {
+			t.state.SetBalance(addr, account.Balance)
+		}
+
+		if account.Code != nil {
+			t.state.SetCode(addr, account.Code)
+		}
+
+		if account.State != nil {
+			t.state.SetFullStorage(addr, account.State)
+		}
+
+		for key, value := range account.StateDiff {
+			t.state.SetState(addr, key, value)
+		}
+	}
+
+	return nil
+}
+
 func (t *Transition) applyCall(
 	c *runtime.Contract,
 	callType runtime.CallType,
@@ -754,6 +918,25 @@ func (t *Transition) applyCreate(c *runtime.Contract, host runtime.Host, op evm.
 		}
 	}
 
+	// Check the caller is allowed to deploy contracts,
+	// the contracts deployed by the transactions and by the other contracts are checked alike
+	if t.deploymentAllowList != nil && !t.deploymentAllowList.GetRole(c.Caller).Enabled() {
+		return &runtime.ExecutionResult{
+			GasLeft: 0,
+			Err:     runtime.ErrNotAuth,
+		}
+	}
+
+	// Check the caller is in the deployment whitelist governed on chain
+	if t.chainParams != nil {
+		if allowed, governed := t.chainParams.IsDeploymentAllowed(t.state, c.Caller); governed && !allowed {
+			return &runtime.ExecutionResult{
+				GasLeft: 0,
+				Err:     runtime.ErrNotAuth,
+			}
+		}
+	}
+
 	// Increment the nonce of the caller
 	t.state.IncrNonce(c.Caller)
 
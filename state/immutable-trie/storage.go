@@ -81,6 +81,21 @@ func (kv *KVStorage) Get(k []byte) ([]byte, bool) {
 	return data, true
 }
 
+// Iterate calls the function for every key-value pair in leveldb,
+// the iteration stops once the function returns false
+func (kv *KVStorage) Iterate(fn func(k, v []byte) bool) error {
+	iter := kv.db.NewIterator(nil, nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		if !fn(iter.Key(), iter.Value()) {
+			break
+		}
+	}
+
+	return iter.Error()
+}
+
 func (kv *KVStorage) Close() error {
 	return kv.db.Close()
 }
@@ -0,0 +1,306 @@
+package addresslist
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/abi"
+)
+
+var (
+	// AllowListContractsAddr is the address of the list of the accounts allowed to deploy contracts
+	AllowListContractsAddr = types.StringToAddress("0x0200000000000000000000000000000000000000")
+	// AllowListTransactionsAddr is the address of the list of the accounts allowed to send transactions
+	AllowListTransactionsAddr = types.StringToAddress("0x0200000000000000000000000000000000000002")
+)
+
+// list of the methods of the address list
+var (
+	SetAdminFunc        = abi.MustNewMethod("function setAdmin(address)")
+	SetEnabledFunc      = abi.MustNewMethod("function setEnabled(address)")
+	SetNoneFunc         = abi.MustNewMethod("function setNone(address)")
+	ReadAddressListFunc = abi.MustNewMethod("function readAddressList(address) returns (uint256)")
+	ListAddressesFunc   = abi.MustNewMethod("function listAddresses() returns (address[])")
+)
+
+// signatureSize is the size of the method selector in the input
+const signatureSize = 4
+
+// list of the gas costs of the methods
+var (
+	writeAddressListCost = uint64(20000)
+	readAddressListCost  = uint64(5000)
+	listAddressCost      = uint64(200)
+)
+
+var (
+	errNoFunctionSignature = errors.New("input is too short for a function call")
+	errInvalidInputSize    = errors.New("wrong input size, expected 32")
+	errFunctionNotFound    = errors.New("function not found")
+	errWriteProtection     = errors.New("write protection")
+
+	// ErrNotAuth is returned when the caller is not an admin of the list
+	ErrNotAuth = errors.New("not authorized")
+)
+
+var (
+	// lengthKey is the storage key of the number of the accounts in the list,
+	// the accounts are stored from keccak(lengthKey) as in a Solidity dynamic array
+	lengthKey = types.BytesToHash(crypto.Keccak256([]byte("addresslist.length")))
+	// membersKey is the storage key of the first account in the list
+	membersKey = types.BytesToHash(crypto.Keccak256(lengthKey.Bytes()))
+)
+
+// Role is the role of an account in the list
+type Role types.Hash
+
+var (
+	NoRole      = Role(types.BytesToHash([]byte{0}))
+	EnabledRole = Role(types.BytesToHash([]byte{1}))
+	AdminRole   = Role(types.BytesToHash([]byte{2}))
+)
+
+// Enabled returns whether the role allows the account to do the restricted operation
+func (r Role) Enabled() bool {
+	return r == EnabledRole || r == AdminRole
+}
+
+// Bytes returns the role encoded as uint256
+func (r Role) Bytes() []byte {
+	return types.Hash(r).Bytes()
+}
+
+// Uint64 returns the role as a number
+func (r Role) Uint64() uint64 {
+	return new(big.Int).SetBytes(r.Bytes()).Uint64()
+}
+
+// stateRef is the state the list is stored in
+type stateRef interface {
+	GetStorage(addr types.Address, key types.Hash) types.Hash
+	SetStorage(addr types.Address, key types.Hash, value types.Hash, config *chain.ForksInTime) runtime.StorageStatus
+}
+
+// AddressList is a native contract keeping the roles of the accounts in the storage of its address.
+// The admins of the list can add the accounts with setAdmin or setEnabled and remove them with setNone,
+// anyone can read the role of an account and list all the accounts having a role
+type AddressList struct {
+	state stateRef
+	addr  types.Address
+}
+
+var _ runtime.Runtime = &AddressList{}
+
+// NewAddressList creates a new address list stored at the given address
+func NewAddressList(state stateRef, addr types.Address) *AddressList {
+	return &AddressList{
+		state: state,
+		addr:  addr,
+	}
+}
+
+// Addr returns the address of the list
+func (a *AddressList) Addr() types.Address {
+	return a.addr
+}
+
+// CanRun implements the runtime interface
+func (a *AddressList) CanRun(c *runtime.Contract, _ runtime.Host, _ *chain.ForksInTime) bool {
+	return c.CodeAddress == a.addr
+}
+
+// Name implements the runtime interface
+func (a *AddressList) Name() string {
+	return "addresslist"
+}
+
+// Run implements the runtime interface
+func (a *AddressList) Run(c *runtime.Contract, _ runtime.Host, config *chain.ForksInTime) *runtime.ExecutionResult {
+	ret, gasUsed, err := a.runInputCall(c.Caller, c.Input, c.Gas, c.Static, config)
+
+	result := &runtime.ExecutionResult{
+		ReturnValue: ret,
+		GasLeft:     c.Gas - gasUsed,
+		Err:         err,
+	}
+
+	if result.Failed() {
+		result.GasLeft = 0
+		result.ReturnValue = nil
+	}
+
+	return result
+}
+
+func (a *AddressList) runInputCall(
+	caller types.Address,
+	input []byte,
+	gas uint64,
+	isStatic bool,
+	config *chain.ForksInTime,
+) ([]byte, uint64, error) {
+	if len(input) < signatureSize {
+		return nil, 0, errNoFunctionSignature
+	}
+
+	sig, inputBytes := input[:signatureSize], input[signatureSize:]
+
+	if bytes.Equal(sig, ListAddressesFunc.ID()) {
+		addrs := a.List()
+
+		cost := readAddressListCost + listAddressCost*uint64(len(addrs))
+		if gas < cost {
+			return nil, 0, runtime.ErrOutOfGas
+		}
+
+		encoded := make([]ethgo.Address, len(addrs))
+		for idx, addr := range addrs {
+			encoded[idx] = ethgo.Address(addr)
+		}
+
+		ret, err := ListAddressesFunc.Outputs.Encode([]interface{}{encoded})
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return ret, cost, nil
+	}
+
+	// all the other methods have a single address argument
+	if len(inputBytes) != types.HashLength {
+		return nil, 0, errInvalidInputSize
+	}
+
+	inputAddr := types.BytesToAddress(inputBytes)
+
+	if bytes.Equal(sig, ReadAddressListFunc.ID()) {
+		if gas < readAddressListCost {
+			return nil, 0, runtime.ErrOutOfGas
+		}
+
+		return a.GetRole(inputAddr).Bytes(), readAddressListCost, nil
+	}
+
+	var role Role
+
+	switch {
+	case bytes.Equal(sig, SetAdminFunc.ID()):
+		role = AdminRole
+	case bytes.Equal(sig, SetEnabledFunc.ID()):
+		role = EnabledRole
+	case bytes.Equal(sig, SetNoneFunc.ID()):
+		role = NoRole
+	default:
+		return nil, 0, errFunctionNotFound
+	}
+
+	if isStatic {
+		return nil, 0, errWriteProtection
+	}
+
+	// only the admins can modify the roles
+	if a.GetRole(caller) != AdminRole {
+		return nil, 0, ErrNotAuth
+	}
+
+	if gas < writeAddressListCost {
+		return nil, 0, runtime.ErrOutOfGas
+	}
+
+	a.SetRole(inputAddr, role, config)
+
+	return nil, writeAddressListCost, nil
+}
+
+// GetRole returns the role of the account
+func (a *AddressList) GetRole(addr types.Address) Role {
+	return Role(a.state.GetStorage(a.addr, RoleKey(addr)))
+}
+
+// SetRole sets the role of the account, the account is removed from the list by NoRole
+func (a *AddressList) SetRole(addr types.Address, role Role, config *chain.ForksInTime) {
+	a.state.SetStorage(a.addr, RoleKey(addr), types.Hash(role), config)
+
+	if role == NoRole {
+		a.remove(addr, config)
+	} else {
+		a.add(addr, config)
+	}
+}
+
+// List returns the accounts having a role in the list
+func (a *AddressList) List() []types.Address {
+	length := a.getUint64(lengthKey)
+	addrs := make([]types.Address, 0, length)
+
+	for idx := uint64(0); idx < length; idx++ {
+		addrs = append(addrs, types.BytesToAddress(a.state.GetStorage(a.addr, memberKey(idx)).Bytes()))
+	}
+
+	return addrs
+}
+
+// add appends the account to the enumerable members if it's not there yet
+func (a *AddressList) add(addr types.Address, config *chain.ForksInTime) {
+	if a.getUint64(indexKey(addr)) != 0 {
+		return
+	}
+
+	length := a.getUint64(lengthKey)
+
+	a.state.SetStorage(a.addr, memberKey(length), types.BytesToHash(addr.Bytes()), config)
+	a.setUint64(indexKey(addr), length+1, config)
+	a.setUint64(lengthKey, length+1, config)
+}
+
+// remove removes the account from the enumerable members by moving the last one to its position
+func (a *AddressList) remove(addr types.Address, config *chain.ForksInTime) {
+	position := a.getUint64(indexKey(addr))
+	if position == 0 {
+		return
+	}
+
+	lastIdx := a.getUint64(lengthKey) - 1
+
+	if position-1 != lastIdx {
+		last := a.state.GetStorage(a.addr, memberKey(lastIdx))
+
+		a.state.SetStorage(a.addr, memberKey(position-1), last, config)
+		a.setUint64(indexKey(types.BytesToAddress(last.Bytes())), position, config)
+	}
+
+	a.state.SetStorage(a.addr, memberKey(lastIdx), types.ZeroHash, config)
+	a.setUint64(indexKey(addr), 0, config)
+	a.setUint64(lengthKey, lastIdx, config)
+}
+
+func (a *AddressList) getUint64(key types.Hash) uint64 {
+	return new(big.Int).SetBytes(a.state.GetStorage(a.addr, key).Bytes()).Uint64()
+}
+
+func (a *AddressList) setUint64(key types.Hash, value uint64, config *chain.ForksInTime) {
+	a.state.SetStorage(a.addr, key, types.BytesToHash(new(big.Int).SetUint64(value).Bytes()), config)
+}
+
+// RoleKey returns the storage key of the role of the account
+func RoleKey(addr types.Address) types.Hash {
+	return types.BytesToHash(addr.Bytes())
+}
+
+// indexKey returns the storage key of the 1-based position of the account in the members
+func indexKey(addr types.Address) types.Hash {
+	return types.BytesToHash(crypto.Keccak256(RoleKey(addr).Bytes(), lengthKey.Bytes()))
+}
+
+// memberKey returns the storage key of the member at the index
+func memberKey(idx uint64) types.Hash {
+	return types.BytesToHash(
+		new(big.Int).Add(new(big.Int).SetBytes(membersKey.Bytes()), new(big.Int).SetUint64(idx)).Bytes(),
+	)
+}
@@ -0,0 +1,174 @@
+package addresslist
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/abi"
+)
+
+var (
+	listAddr = types.StringToAddress("0x0200000000000000000000000000000000000000")
+
+	addr1 = types.StringToAddress("1")
+	addr2 = types.StringToAddress("2")
+	addr3 = types.StringToAddress("3")
+)
+
+func newTestAddressList(t *testing.T, admins ...types.Address) *AddressList {
+	t.Helper()
+
+	genesis := &chain.Genesis{}
+
+	ApplyGenesisAllocs(genesis, listAddr, &chain.AddressListConfig{
+		AdminAddresses: admins,
+	})
+
+	return NewAddressList(genesisState(genesis.Alloc[listAddr].Storage), listAddr)
+}
+
+func call(list *AddressList, caller types.Address, isStatic bool, input []byte) *runtime.ExecutionResult {
+	return list.Run(&runtime.Contract{
+		Caller:      caller,
+		CodeAddress: listAddr,
+		Input:       input,
+		Gas:         100000,
+		Static:      isStatic,
+	}, nil, &chain.ForksInTime{})
+}
+
+func encode(t *testing.T, method *abi.Method, args ...interface{}) []byte {
+	t.Helper()
+
+	input, err := method.Encode(args)
+	assert.NoError(t, err)
+
+	return input
+}
+
+func TestApplyGenesisAllocs(t *testing.T) {
+	t.Parallel()
+
+	genesis := &chain.Genesis{}
+
+	ApplyGenesisAllocs(genesis, listAddr, &chain.AddressListConfig{
+		AdminAddresses:   []types.Address{addr1},
+		EnabledAddresses: []types.Address{addr1, addr2},
+	})
+
+	account := genesis.Alloc[listAddr]
+	assert.NotEmpty(t, account.Code)
+
+	list := NewAddressList(genesisState(account.Storage), listAddr)
+
+	assert.Equal(t, AdminRole, list.GetRole(addr1))
+	assert.Equal(t, EnabledRole, list.GetRole(addr2))
+	assert.Equal(t, NoRole, list.GetRole(addr3))
+	assert.Equal(t, []types.Address{addr1, addr2}, list.List())
+}
+
+func TestAddressList_SetRole(t *testing.T) {
+	t.Parallel()
+
+	list := newTestAddressList(t)
+	config := &chain.ForksInTime{}
+
+	list.SetRole(addr1, EnabledRole, config)
+	list.SetRole(addr2, AdminRole, config)
+	list.SetRole(addr3, EnabledRole, config)
+
+	// changing the role keeps the position
+	list.SetRole(addr1, AdminRole, config)
+	assert.Equal(t, []types.Address{addr1, addr2, addr3}, list.List())
+
+	// the last account is moved to the position of the removed one
+	list.SetRole(addr1, NoRole, config)
+	assert.Equal(t, []types.Address{addr3, addr2}, list.List())
+	assert.Equal(t, NoRole, list.GetRole(addr1))
+
+	list.SetRole(addr2, NoRole, config)
+	list.SetRole(addr3, NoRole, config)
+	assert.Empty(t, list.List())
+
+	// removing an account not in the list does nothing
+	list.SetRole(addr1, NoRole, config)
+	assert.Empty(t, list.List())
+
+	// the storage is cleared after all the accounts are removed
+	assert.Empty(t, list.state)
+}
+
+func TestAddressList_Run(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should modify the roles by the admin", func(t *testing.T) {
+		t.Parallel()
+
+		list := newTestAddressList(t, addr1)
+
+		res := call(list, addr1, false, encode(t, SetEnabledFunc, ethgo.Address(addr2)))
+		assert.NoError(t, res.Err)
+		assert.Equal(t, uint64(100000)-writeAddressListCost, res.GasLeft)
+		assert.Equal(t, EnabledRole, list.GetRole(addr2))
+
+		res = call(list, addr1, false, encode(t, SetAdminFunc, ethgo.Address(addr3)))
+		assert.NoError(t, res.Err)
+		assert.Equal(t, AdminRole, list.GetRole(addr3))
+
+		res = call(list, addr3, false, encode(t, SetNoneFunc, ethgo.Address(addr2)))
+		assert.NoError(t, res.Err)
+		assert.Equal(t, NoRole, list.GetRole(addr2))
+	})
+
+	t.Run("should read the role and the list", func(t *testing.T) {
+		t.Parallel()
+
+		list := newTestAddressList(t, addr1)
+
+		res := call(list, addr2, true, encode(t, ReadAddressListFunc, ethgo.Address(addr1)))
+		assert.NoError(t, res.Err)
+		assert.Equal(t, AdminRole.Bytes(), res.ReturnValue)
+
+		res = call(list, addr2, true, ListAddressesFunc.ID())
+		assert.NoError(t, res.Err)
+
+		decoded, err := ListAddressesFunc.Outputs.Decode(res.ReturnValue)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"0": []ethgo.Address{ethgo.Address(addr1)}}, decoded)
+	})
+
+	t.Run("should fail to modify the roles by non admin", func(t *testing.T) {
+		t.Parallel()
+
+		list := newTestAddressList(t, addr1)
+		list.SetRole(addr2, EnabledRole, &chain.ForksInTime{})
+
+		res := call(list, addr2, false, encode(t, SetEnabledFunc, ethgo.Address(addr3)))
+		assert.ErrorIs(t, res.Err, ErrNotAuth)
+		assert.Zero(t, res.GasLeft)
+		assert.Equal(t, NoRole, list.GetRole(addr3))
+	})
+
+	t.Run("should fail to modify the roles in static call", func(t *testing.T) {
+		t.Parallel()
+
+		list := newTestAddressList(t, addr1)
+
+		res := call(list, addr1, true, encode(t, SetEnabledFunc, ethgo.Address(addr2)))
+		assert.ErrorIs(t, res.Err, errWriteProtection)
+	})
+
+	t.Run("should fail with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		list := newTestAddressList(t, addr1)
+
+		assert.ErrorIs(t, call(list, addr1, false, []byte{0x1}).Err, errNoFunctionSignature)
+		assert.ErrorIs(t, call(list, addr1, false, SetEnabledFunc.ID()).Err, errInvalidInputSize)
+		assert.ErrorIs(t, call(list, addr1, false, make([]byte, 36)).Err, errFunctionNotFound)
+	})
+}
@@ -0,0 +1,60 @@
+package addresslist
+
+import (
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// genesisCode is the placeholder code of the list account,
+// the list is run natively but the account must not be empty
+// so that the storage is kept and the contracts can call it
+var genesisCode = []byte{0xfe}
+
+// genesisState is the storage of the list account in the genesis
+type genesisState map[types.Hash]types.Hash
+
+func (g genesisState) GetStorage(_ types.Address, key types.Hash) types.Hash {
+	return g[key]
+}
+
+func (g genesisState) SetStorage(
+	_ types.Address,
+	key types.Hash,
+	value types.Hash,
+	_ *chain.ForksInTime,
+) runtime.StorageStatus {
+	if value == types.ZeroHash {
+		delete(g, key)
+
+		return runtime.StorageDeleted
+	}
+
+	g[key] = value
+
+	return runtime.StorageModified
+}
+
+// ApplyGenesisAllocs adds the account of the list with the initial roles to the genesis allocs
+func ApplyGenesisAllocs(genesis *chain.Genesis, addr types.Address, config *chain.AddressListConfig) {
+	if genesis.Alloc == nil {
+		genesis.Alloc = map[types.Address]*chain.GenesisAccount{}
+	}
+
+	storage := genesisState{}
+	list := NewAddressList(storage, addr)
+
+	for _, enabled := range config.EnabledAddresses {
+		list.SetRole(enabled, EnabledRole, &chain.ForksInTime{})
+	}
+
+	// the admin role overrides the enabled one if an address is in both
+	for _, admin := range config.AdminAddresses {
+		list.SetRole(admin, AdminRole, &chain.ForksInTime{})
+	}
+
+	genesis.Alloc[addr] = &chain.GenesisAccount{
+		Code:    genesisCode,
+		Storage: storage,
+	}
+}
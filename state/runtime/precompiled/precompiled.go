@@ -88,6 +88,16 @@ func (p *Precompiled) CanRun(c *runtime.Contract, _ runtime.Host, config *chain.
 	return true
 }
 
+// Addresses returns the addresses of the precompiled contracts
+func (p *Precompiled) Addresses() []types.Address {
+	addrs := make([]types.Address, 0, len(p.contracts))
+	for addr := range p.contracts {
+		addrs = append(addrs, addr)
+	}
+
+	return addrs
+}
+
 // Name implements the runtime interface
 func (p *Precompiled) Name() string {
 	return "precompiled"
@@ -141,6 +141,7 @@ var (
 	ErrDepth                    = errors.New("max call depth exceeded")
 	ErrExecutionReverted        = errors.New("execution was reverted")
 	ErrCodeStoreOutOfGas        = errors.New("contract creation code storage out of gas")
+	ErrNotAuth                  = errors.New("not in allow list")
 )
 
 type CallType int
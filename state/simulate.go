@@ -0,0 +1,148 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// ErrSimulationGasLimitReached is returned if the gas of a simulated call exceeds the gas left in the simulation
+var ErrSimulationGasLimitReached = errors.New("gas limit of the simulation reached")
+
+// CallBundle is a set of the calls executed in order in a simulated block
+type CallBundle struct {
+	BlockOverrides *types.BlockOverrides
+	StateOverride  types.StateOverride
+	Calls          []*SimulatedCall
+
+	// Header is the header of the simulated block, set by the simulation.
+	// The miner of the header is the coinbase the fees are paid to
+	Header *types.Header
+}
+
+// SimulatedCall is a call executed in a simulation
+type SimulatedCall struct {
+	Msg    *types.Transaction // the nonce and the gas limit are set by the simulation
+	Tracer runtime.EVMLogger  // traces the call if set
+
+	// the outcome of the call, set by the simulation
+	Result *runtime.ExecutionResult
+	Logs   []*types.Log
+}
+
+// Simulate executes the bundles of the calls in order on top of the current state,
+// every call sees the state changes of the previous ones.
+// The bundle i is executed in the block i+1 after the header, unless the block is overridden by the bundle.
+// The gas used by all the calls is limited by the given gas limit
+func (t *Transition) Simulate(
+	header *types.Header,
+	coinbase types.Address,
+	bundles []*CallBundle,
+	gasLimit uint64,
+) error {
+	var (
+		parent = header
+
+		// the hashes of the simulated blocks, the hashes of the blocks in the chain are read by the executor
+		hashes    = map[uint64]types.Hash{header.Number: header.Hash}
+		chainHash = t.r.GetHash(header)
+	)
+
+	getHash := func(number uint64) types.Hash {
+		if hash, ok := hashes[number]; ok {
+			return hash
+		}
+
+		return chainHash(number)
+	}
+
+	for _, bundle := range bundles {
+		blockHeader := parent.Copy()
+		blockHeader.ParentHash = parent.Hash
+		blockHeader.Number = parent.Number + 1
+		blockHeader.Timestamp = parent.Timestamp + 1
+		blockHeader = bundle.BlockOverrides.Apply(blockHeader)
+
+		blockCoinbase := coinbase
+		if bundle.BlockOverrides != nil && bundle.BlockOverrides.Coinbase != nil {
+			blockCoinbase = *bundle.BlockOverrides.Coinbase
+		}
+
+		blockHeader.Miner = blockCoinbase.Bytes()
+		blockHeader.GasUsed = 0
+
+		t.setBlockContext(blockHeader, blockCoinbase)
+		t.getHash = getHash
+
+		if err := t.ApplyStateOverride(bundle.StateOverride); err != nil {
+			return fmt.Errorf("block %d: %w", blockHeader.Number, err)
+		}
+
+		for j, call := range bundle.Calls {
+			result, err := t.simulateCall(call, gasLimit)
+			if err != nil {
+				return fmt.Errorf("call %d of block %d: %w", j, blockHeader.Number, err)
+			}
+
+			call.Result = result
+			call.Logs = t.state.Logs()
+
+			// The suicided accounts are set as deleted for the next call
+			t.state.CleanDeleteObjects(true)
+
+			blockHeader.GasUsed += result.GasUsed
+			gasLimit -= result.GasUsed
+		}
+
+		bundle.Header = blockHeader.ComputeHash()
+		hashes[blockHeader.Number] = blockHeader.Hash
+		parent = blockHeader
+	}
+
+	return nil
+}
+
+// simulateCall executes the call with the nonce of the sender in the simulated state,
+// the call is given the remaining gas of the block and of the simulation if it doesn't set the gas limit.
+// The message of the call is updated to the one executed
+func (t *Transition) simulateCall(call *SimulatedCall, gasLimit uint64) (*runtime.ExecutionResult, error) {
+	call.Msg.Nonce = t.state.GetNonce(call.Msg.From)
+
+	if call.Msg.Gas == 0 {
+		call.Msg.Gas = common.Min(t.gasPool, gasLimit)
+	}
+
+	if call.Msg.Gas > gasLimit {
+		return nil, ErrSimulationGasLimitReached
+	}
+
+	call.Msg.ComputeHash()
+
+	if call.Tracer != nil {
+		t.traceConfig = runtime.TraceConfig{Debug: true, Tracer: call.Tracer, NoBaseFee: true}
+
+		defer func() {
+			t.traceConfig = runtime.TraceConfig{}
+		}()
+	}
+
+	return t.Apply(call.Msg.Copy())
+}
+
+// setBlockContext sets the block the next transactions are executed in,
+// the gas pool is refilled to the gas limit of the block
+func (t *Transition) setBlockContext(header *types.Header, coinbase types.Address) {
+	t.config = t.r.config.Forks.At(header.Number)
+	t.getHash = t.r.GetHash(header)
+	t.gasPool = header.GasLimit
+
+	t.ctx.Coinbase = coinbase
+	t.ctx.Timestamp = int64(header.Timestamp)
+	t.ctx.Number = int64(header.Number)
+	t.ctx.Difficulty = types.BytesToHash(new(big.Int).SetUint64(header.Difficulty).Bytes())
+	t.ctx.GasLimit = int64(header.GasLimit)
+}
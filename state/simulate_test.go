@@ -0,0 +1,214 @@
+package state
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/state/runtime/precompiled"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransition_Simulate(t *testing.T) {
+	t.Parallel()
+
+	contractAddr := types.StringToAddress("a2")
+
+	// counter increments the value in the slot 0, logs it and returns it
+	counter := []byte{
+		0x60, 0x00, 0x54, // SLOAD(0)
+		0x60, 0x01, 0x01, // ADD(1)
+		0x80,             // DUP1
+		0x60, 0x00, 0x55, // SSTORE(0)
+		0x60, 0x00, 0x52, // MSTORE(0)
+		0x60, 0x20, 0x60, 0x00, 0xa0, // LOG0(0, 32)
+		0x60, 0x20, 0x60, 0x00, 0xf3, // RETURN(0, 32)
+	}
+
+	executor := &Executor{
+		config: &chain.Params{Forks: chain.AllForksEnabled},
+		GetHash: func(*types.Header) GetHashByNumber {
+			return func(uint64) types.Hash {
+				return types.Hash{}
+			}
+		},
+	}
+
+	transition := &Transition{
+		logger:      hclog.NewNullLogger(),
+		r:           executor,
+		state:       newTestTxn(map[types.Address]*PreState{addr1: {Balance: 1000}}),
+		evm:         evm.NewEVM(),
+		precompiles: precompiled.NewPrecompiled(),
+	}
+
+	newCall := func() *SimulatedCall {
+		return &SimulatedCall{
+			Msg: &types.Transaction{
+				From:     addr1,
+				To:       &contractAddr,
+				GasPrice: big.NewInt(0),
+				Value:    big.NewInt(0),
+			},
+		}
+	}
+
+	var (
+		header = &types.Header{Number: 10, Timestamp: 100, GasLimit: 1000000, Hash: types.StringToHash("10")}
+		number = uint64(20)
+
+		bundles = []*CallBundle{
+			{
+				StateOverride: types.StateOverride{
+					contractAddr: {Code: counter},
+				},
+				Calls: []*SimulatedCall{newCall(), newCall()},
+			},
+			{
+				Calls: []*SimulatedCall{newCall()},
+			},
+			{
+				BlockOverrides: &types.BlockOverrides{Number: &number},
+				Calls:          []*SimulatedCall{newCall()},
+			},
+		}
+	)
+
+	assert.NoError(t, transition.Simulate(header, addr2, bundles, 10000000))
+
+	// every call sees the state changes of the previous ones
+	value := uint64(0)
+
+	for _, bundle := range bundles {
+		for _, call := range bundle.Calls {
+			value++
+
+			assert.NoError(t, call.Result.Err)
+			assert.Equal(t, types.BytesToHash(new(big.Int).SetUint64(value).Bytes()).Bytes(), call.Result.ReturnValue)
+			assert.Len(t, call.Logs, 1)
+		}
+	}
+
+	// the senders nonce is increased by every call
+	assert.Equal(t, uint64(4), transition.GetNonce(addr1))
+	assert.Equal(t, uint64(1), bundles[0].Calls[1].Msg.Nonce)
+	assert.NotEqual(t, bundles[0].Calls[0].Msg.Hash, bundles[0].Calls[1].Msg.Hash)
+
+	// the blocks follow the header unless overridden
+	assert.Equal(t, uint64(11), bundles[0].Header.Number)
+	assert.Equal(t, uint64(101), bundles[0].Header.Timestamp)
+	assert.Equal(t, uint64(12), bundles[1].Header.Number)
+	assert.Equal(t, uint64(102), bundles[1].Header.Timestamp)
+	assert.Equal(t, uint64(20), bundles[2].Header.Number)
+
+	assert.Equal(t, header.Hash, bundles[0].Header.ParentHash)
+	assert.Equal(t, bundles[0].Header.Hash, bundles[1].Header.ParentHash)
+	assert.Equal(t, bundles[1].Header.Hash, bundles[2].Header.ParentHash)
+
+	assert.Equal(t, bundles[0].Calls[0].Result.GasUsed+bundles[0].Calls[1].Result.GasUsed, bundles[0].Header.GasUsed)
+	assert.Equal(t, addr2.Bytes(), bundles[0].Header.Miner)
+
+	// the header is not modified
+	assert.Equal(t, uint64(10), header.Number)
+}
+
+func TestTransition_Simulate_Limits(t *testing.T) {
+	t.Parallel()
+
+	var (
+		contractAddr = types.StringToAddress("a2")
+		header       = &types.Header{Number: 10, Timestamp: 100, GasLimit: 1000000, Hash: types.StringToHash("10")}
+	)
+
+	// blockHash returns the hash of the block of the number in the input
+	blockHash := []byte{
+		0x60, 0x00, 0x35, // CALLDATALOAD(0)
+		0x40,             // BLOCKHASH
+		0x60, 0x00, 0x52, // MSTORE(0)
+		0x60, 0x20, 0x60, 0x00, 0xf3, // RETURN(0, 32)
+	}
+
+	newTransition := func() *Transition {
+		executor := &Executor{
+			config: &chain.Params{Forks: chain.AllForksEnabled},
+			GetHash: func(*types.Header) GetHashByNumber {
+				return func(uint64) types.Hash {
+					return types.StringToHash("chain")
+				}
+			},
+		}
+
+		txn := newTestTxn(map[types.Address]*PreState{addr1: {Balance: 1000}})
+		txn.SetCode(contractAddr, blockHash)
+
+		return &Transition{
+			logger:      hclog.NewNullLogger(),
+			r:           executor,
+			state:       txn,
+			evm:         evm.NewEVM(),
+			precompiles: precompiled.NewPrecompiled(),
+		}
+	}
+
+	newCall := func(number uint64, gas uint64) *SimulatedCall {
+		return &SimulatedCall{
+			Msg: &types.Transaction{
+				From:     addr1,
+				To:       &contractAddr,
+				Gas:      gas,
+				GasPrice: big.NewInt(0),
+				Value:    big.NewInt(0),
+				Input:    types.BytesToHash(new(big.Int).SetUint64(number).Bytes()).Bytes(),
+			},
+		}
+	}
+
+	t.Run("should return the hashes of the simulated blocks", func(t *testing.T) {
+		t.Parallel()
+
+		bundles := []*CallBundle{
+			{Calls: []*SimulatedCall{newCall(9, 0)}},
+			{Calls: []*SimulatedCall{newCall(10, 0), newCall(11, 0)}},
+		}
+
+		assert.NoError(t, newTransition().Simulate(header, addr2, bundles, 10000000))
+
+		assert.Equal(t, types.StringToHash("chain").Bytes(), bundles[0].Calls[0].Result.ReturnValue)
+		assert.Equal(t, header.Hash.Bytes(), bundles[1].Calls[0].Result.ReturnValue)
+		assert.Equal(t, bundles[0].Header.Hash.Bytes(), bundles[1].Calls[1].Result.ReturnValue)
+	})
+
+	t.Run("should limit the gas of the calls", func(t *testing.T) {
+		t.Parallel()
+
+		bundles := []*CallBundle{
+			{Calls: []*SimulatedCall{newCall(10, 0), newCall(10, 0)}},
+		}
+
+		// the call is given the gas left in the simulation
+		err := newTransition().Simulate(header, addr2, bundles, 30000)
+		assert.Error(t, err)
+		assert.NoError(t, bundles[0].Calls[0].Result.Err)
+		assert.Equal(t, uint64(30000), bundles[0].Calls[0].Msg.Gas)
+		assert.Less(t, bundles[0].Calls[1].Msg.Gas, uint64(TxGas))
+
+		bundles = []*CallBundle{
+			{Calls: []*SimulatedCall{newCall(10, 30001)}},
+		}
+
+		assert.ErrorIs(t, newTransition().Simulate(header, addr2, bundles, 30000), ErrSimulationGasLimitReached)
+	})
+
+	t.Run("should return the error of the traced call", func(t *testing.T) {
+		t.Parallel()
+
+		call := newCall(10, TxGas-1)
+		call.Tracer = &mockTxTracer{}
+
+		err := newTransition().Simulate(header, addr2, []*CallBundle{{Calls: []*SimulatedCall{call}}}, 10000000)
+		assert.ErrorContains(t, err, ErrNotEnoughIntrinsicGas.Error())
+	})
+}
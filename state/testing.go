@@ -101,6 +101,32 @@ func TestState(t *testing.T, buildPreState buildPreState) {
 
 		testDeleteCommonStateRoot(t, buildPreState)
 	})
+	t.Run("", func(t *testing.T) {
+		t.Parallel()
+
+		testSetFullStorage(t, buildPreState)
+	})
+}
+
+func testSetFullStorage(t *testing.T, buildPreState buildPreState) {
+	t.Helper()
+
+	// The storage of the account in the prestate is replaced by the given one
+	snap := buildPreState(defaultPreState)
+
+	txn := newTxn(snap)
+	txn.SetFullStorage(addr1, map[types.Hash]types.Hash{
+		hash2: hash2,
+	})
+
+	assert.Equal(t, types.Hash{}, txn.GetState(addr1, hash1))
+	assert.Equal(t, hash2, txn.GetState(addr1, hash2))
+
+	snap, _ = snap.Commit(txn.Commit(false))
+
+	txn = newTxn(snap)
+	assert.Equal(t, types.Hash{}, txn.GetState(addr1, hash1))
+	assert.Equal(t, hash2, txn.GetState(addr1, hash2))
 }
 
 func testDeleteCommonStateRoot(t *testing.T, buildPreState buildPreState) {
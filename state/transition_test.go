@@ -3,8 +3,13 @@ package state
 import (
 	"math/big"
 	"testing"
+	"time"
 
+	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/addresslist"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/state/runtime/precompiled"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
@@ -159,3 +164,490 @@ func TestTransfer(t *testing.T) {
 		})
 	}
 }
+
+func TestAddressListsCheck(t *testing.T) {
+	t.Parallel()
+
+	var (
+		deploymentListAddr  = types.StringToAddress("0x0200000000000000000000000000000000000000")
+		transactionListAddr = types.StringToAddress("0x0200000000000000000000000000000000000002")
+
+		addr3 = types.StringToAddress("3")
+
+		enabled = types.BytesToHash([]byte{1})
+		admin   = types.BytesToHash([]byte{2})
+	)
+
+	preState := map[types.Address]*PreState{
+		deploymentListAddr: {
+			State: map[types.Hash]types.Hash{
+				types.BytesToHash(addr1.Bytes()): admin,
+			},
+		},
+		transactionListAddr: {
+			State: map[types.Hash]types.Hash{
+				types.BytesToHash(addr1.Bytes()): enabled,
+				types.BytesToHash(addr2.Bytes()): enabled,
+			},
+		},
+	}
+
+	tests := []struct {
+		name                string
+		deploymentAllowList bool
+		txnAllowList        bool
+		from                types.Address
+		to                  *types.Address
+		expectedErr         error
+	}{
+		{
+			name:        "should succeed if the lists are not enabled",
+			from:        addr3,
+			to:          nil,
+			expectedErr: nil,
+		},
+		{
+			name:                "should succeed if the deployer is allowed",
+			deploymentAllowList: true,
+			from:                addr1,
+			to:                  nil,
+			expectedErr:         nil,
+		},
+		{
+			name:                "should fail if the deployer is not allowed",
+			deploymentAllowList: true,
+			from:                addr2,
+			to:                  nil,
+			expectedErr:         ErrDeploymentNotAllowed,
+		},
+		{
+			name:                "should succeed to call by the sender not allowed to deploy",
+			deploymentAllowList: true,
+			from:                addr2,
+			to:                  &addr1,
+			expectedErr:         nil,
+		},
+		{
+			name:         "should succeed if the sender is allowed",
+			txnAllowList: true,
+			from:         addr2,
+			to:           &addr1,
+			expectedErr:  nil,
+		},
+		{
+			name:         "should fail if the sender is not allowed",
+			txnAllowList: true,
+			from:         addr3,
+			to:           &addr1,
+			expectedErr:  ErrTransactionNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			transition := newTestTransition(preState)
+
+			if tt.deploymentAllowList {
+				transition.deploymentAllowList = addresslist.NewAddressList(transition, deploymentListAddr)
+			}
+
+			if tt.txnAllowList {
+				transition.txnAllowList = addresslist.NewAddressList(transition, transactionListAddr)
+			}
+
+			assert.Equal(t, tt.expectedErr, transition.addressListsCheck(&types.Transaction{
+				From: tt.from,
+				To:   tt.to,
+			}))
+		})
+	}
+}
+
+func TestApplyCreate_DeploymentAllowList(t *testing.T) {
+	t.Parallel()
+
+	var (
+		deploymentListAddr = types.StringToAddress("0x0200000000000000000000000000000000000000")
+
+		factory = types.StringToAddress("f1")
+
+		enabled = types.BytesToHash([]byte{1})
+	)
+
+	// factoryCode creates an empty contract and stores its address to the slot 0:
+	// PUSH1 0, PUSH1 0, PUSH1 0, CREATE, PUSH1 0, SSTORE, STOP
+	factoryCode := []byte{0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0xf0, 0x60, 0x00, 0x55, 0x00}
+
+	tests := []struct {
+		name                string
+		deploymentAllowList bool
+		allowed             []types.Address
+		caller              types.Address
+		expectedErr         error
+		expectedCreated     bool
+	}{
+		{
+			name:            "should create if the list is not enabled",
+			caller:          addr1,
+			expectedErr:     nil,
+			expectedCreated: true,
+		},
+		{
+			name:                "should create if the sender and the factory are allowed",
+			deploymentAllowList: true,
+			allowed:             []types.Address{addr1, factory},
+			caller:              addr1,
+			expectedErr:         nil,
+			expectedCreated:     true,
+		},
+		{
+			name:                "should not create by the factory not allowed",
+			deploymentAllowList: true,
+			allowed:             []types.Address{addr1},
+			caller:              addr1,
+			expectedErr:         nil,
+			expectedCreated:     false,
+		},
+		{
+			name:                "should fail to deploy by the sender not allowed",
+			deploymentAllowList: true,
+			allowed:             []types.Address{factory},
+			caller:              addr2,
+			expectedErr:         runtime.ErrNotAuth,
+			expectedCreated:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			roles := map[types.Hash]types.Hash{}
+			for _, addr := range tt.allowed {
+				roles[types.BytesToHash(addr.Bytes())] = enabled
+			}
+
+			transition := newTestTransition(map[types.Address]*PreState{
+				addr1:              {Balance: 1000},
+				addr2:              {Balance: 1000},
+				deploymentListAddr: {State: roles},
+			})
+			transition.config = chain.AllForksEnabled.At(0)
+			transition.evm = evm.NewEVM()
+			transition.precompiles = precompiled.NewPrecompiled()
+			transition.state.SetCode(factory, factoryCode)
+
+			if tt.deploymentAllowList {
+				transition.deploymentAllowList = addresslist.NewAddressList(transition, deploymentListAddr)
+			}
+
+			// the deployment by the transaction
+			result := transition.Create2(tt.caller, nil, big.NewInt(0), 10000000)
+			assert.Equal(t, tt.expectedErr, result.Err)
+
+			if tt.expectedErr != nil {
+				return
+			}
+
+			// the deployment by the contract
+			result = transition.Call2(tt.caller, factory, nil, big.NewInt(0), 10000000)
+			assert.NoError(t, result.Err)
+
+			created := transition.state.GetState(factory, types.ZeroHash)
+			assert.Equal(t, tt.expectedCreated, created != types.ZeroHash)
+		})
+	}
+}
+
+// mockTxTracer records the transaction level events of the tracer
+type mockTxTracer struct {
+	runtime.EVMLogger
+
+	txStarts int
+	txEnds   int
+}
+
+func (m *mockTxTracer) CaptureTxStart(uint64) {
+	m.txStarts++
+}
+
+func (m *mockTxTracer) CaptureTxEnd(uint64) {
+	m.txEnds++
+}
+
+func (m *mockTxTracer) CaptureStart(interface{}, types.Address, types.Address, bool, []byte, uint64, *big.Int) {
+}
+
+func (m *mockTxTracer) CaptureEnd([]byte, uint64, time.Duration, error) {}
+
+func TestApply_Traced(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		gas         uint64
+		expectedErr error
+		traced      bool
+	}{
+		{
+			name:        "should trace the valid message",
+			gas:         TxGas,
+			expectedErr: nil,
+			traced:      true,
+		},
+		{
+			name:        "should not trace the message rejected by the checks",
+			gas:         TxGas - 1,
+			expectedErr: ErrNotEnoughIntrinsicGas,
+			traced:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tracer := &mockTxTracer{}
+
+			transition := newTestTransition(map[types.Address]*PreState{
+				addr1: {Balance: 1000},
+			})
+			transition.config = chain.AllForksEnabled.At(0)
+			transition.evm = evm.NewEVM()
+			transition.precompiles = precompiled.NewPrecompiled()
+			transition.gasPool = tt.gas
+			transition.traceConfig = runtime.TraceConfig{Debug: true, Tracer: tracer}
+
+			_, err := transition.apply(&types.Transaction{
+				From:     addr1,
+				To:       &addr2,
+				Gas:      tt.gas,
+				GasPrice: big.NewInt(0),
+				Value:    big.NewInt(1),
+			})
+
+			if tt.expectedErr != nil {
+				assert.Equal(t, NewTransitionApplicationError(tt.expectedErr, false), err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			expected := 0
+			if tt.traced {
+				expected = 1
+			}
+
+			assert.Equal(t, expected, tracer.txStarts)
+			assert.Equal(t, expected, tracer.txEnds)
+		})
+	}
+}
+
+type mockChainParameters struct {
+	minGasPrice *big.Int
+	whitelist   map[types.Address]bool
+}
+
+func (m *mockChainParameters) MinGasPrice(*Txn) *big.Int {
+	return m.minGasPrice
+}
+
+func (m *mockChainParameters) IsDeploymentAllowed(_ *Txn, addr types.Address) (bool, bool) {
+	return m.whitelist[addr], len(m.whitelist) > 0
+}
+
+func TestChainParamsCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		params      ChainParameters
+		from        types.Address
+		to          *types.Address
+		gasPrice    int64
+		expectedErr error
+	}{
+		{
+			name:        "should succeed if the chain parameters are not enabled",
+			params:      nil,
+			from:        addr2,
+			to:          nil,
+			gasPrice:    1,
+			expectedErr: nil,
+		},
+		{
+			name:        "should succeed if the parameters are not set",
+			params:      &mockChainParameters{},
+			from:        addr2,
+			to:          nil,
+			gasPrice:    1,
+			expectedErr: nil,
+		},
+		{
+			name:        "should fail if the gas price is lower than the min gas price",
+			params:      &mockChainParameters{minGasPrice: big.NewInt(10)},
+			from:        addr1,
+			to:          &addr2,
+			gasPrice:    9,
+			expectedErr: ErrGasPriceTooLow,
+		},
+		{
+			name:        "should succeed if the gas price equals the min gas price",
+			params:      &mockChainParameters{minGasPrice: big.NewInt(10)},
+			from:        addr1,
+			to:          &addr2,
+			gasPrice:    10,
+			expectedErr: nil,
+		},
+		{
+			name:        "should succeed if the deployer is in the whitelist",
+			params:      &mockChainParameters{whitelist: map[types.Address]bool{addr1: true}},
+			from:        addr1,
+			to:          nil,
+			gasPrice:    1,
+			expectedErr: nil,
+		},
+		{
+			name:        "should fail if the deployer is not in the whitelist",
+			params:      &mockChainParameters{whitelist: map[types.Address]bool{addr1: true}},
+			from:        addr2,
+			to:          nil,
+			gasPrice:    1,
+			expectedErr: ErrDeploymentNotAllowed,
+		},
+		{
+			name:        "should succeed to call by the sender not in the whitelist",
+			params:      &mockChainParameters{whitelist: map[types.Address]bool{addr1: true}},
+			from:        addr2,
+			to:          &addr1,
+			gasPrice:    1,
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			transition := newTestTransition(nil)
+			transition.chainParams = tt.params
+
+			assert.Equal(t, tt.expectedErr, transition.chainParamsCheck(&types.Transaction{
+				From:     tt.from,
+				To:       tt.to,
+				GasPrice: big.NewInt(tt.gasPrice),
+			}))
+		})
+	}
+}
+
+func TestApplyCreate_ChainParams(t *testing.T) {
+	t.Parallel()
+
+	factory := types.StringToAddress("f1")
+
+	// factoryCode creates an empty contract and stores its address to the slot 0:
+	// PUSH1 0, PUSH1 0, PUSH1 0, CREATE, PUSH1 0, SSTORE, STOP
+	factoryCode := []byte{0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0xf0, 0x60, 0x00, 0x55, 0x00}
+
+	tests := []struct {
+		name            string
+		params          *mockChainParameters
+		expectedCreated bool
+	}{
+		{
+			name:            "should create if the parameters are not set",
+			params:          &mockChainParameters{},
+			expectedCreated: true,
+		},
+		{
+			name:            "should create by the factory in the whitelist",
+			params:          &mockChainParameters{whitelist: map[types.Address]bool{factory: true}},
+			expectedCreated: true,
+		},
+		{
+			name:            "should not create by the factory not in the whitelist",
+			params:          &mockChainParameters{whitelist: map[types.Address]bool{addr1: true}},
+			expectedCreated: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			transition := newTestTransition(map[types.Address]*PreState{
+				addr1: {Balance: 1000},
+			})
+			transition.config = chain.AllForksEnabled.At(0)
+			transition.evm = evm.NewEVM()
+			transition.precompiles = precompiled.NewPrecompiled()
+			transition.chainParams = tt.params
+			transition.state.SetCode(factory, factoryCode)
+
+			// the deployment by the contract
+			result := transition.Call2(addr1, factory, nil, big.NewInt(0), 10000000)
+			assert.NoError(t, result.Err)
+
+			created := transition.state.GetState(factory, types.ZeroHash)
+			assert.Equal(t, tt.expectedCreated, created != types.ZeroHash)
+		})
+	}
+}
+
+func TestApplyStateOverride(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should override the accounts", func(t *testing.T) {
+		t.Parallel()
+
+		transition := newTestTransition(nil)
+
+		nonce := uint64(5)
+		code := []byte{0x1, 0x2}
+
+		assert.NoError(t, transition.ApplyStateOverride(types.StateOverride{
+			addr1: {
+				Nonce:     &nonce,
+				Balance:   big.NewInt(100),
+				StateDiff: map[types.Hash]types.Hash{hash2: hash2},
+			},
+			addr2: {
+				Code:  code,
+				State: map[types.Hash]types.Hash{hash1: hash2},
+			},
+		}))
+
+		assert.Equal(t, nonce, transition.GetNonce(addr1))
+		assert.Equal(t, big.NewInt(100), transition.GetBalance(addr1))
+
+		// the storage not in the diff is kept
+		assert.Equal(t, hash1, transition.GetStorage(addr1, hash1))
+		assert.Equal(t, hash2, transition.GetStorage(addr1, hash2))
+
+		assert.Equal(t, code, transition.GetCode(addr2))
+		assert.Equal(t, hash2, transition.GetStorage(addr2, hash1))
+	})
+
+	t.Run("should fail if both state and stateDiff are overridden", func(t *testing.T) {
+		t.Parallel()
+
+		transition := newTestTransition(nil)
+
+		err := transition.ApplyStateOverride(types.StateOverride{
+			addr1: {
+				State:     map[types.Hash]types.Hash{},
+				StateDiff: map[types.Hash]types.Hash{},
+			},
+		})
+
+		assert.ErrorIs(t, err, ErrStateOverrideConflict)
+	})
+}
@@ -319,6 +319,20 @@ func (txn *Txn) SetState(
 	})
 }
 
+// SetFullStorage replaces the whole storage of an address with the given one
+func (txn *Txn) SetFullStorage(addr types.Address, storage map[types.Hash]types.Hash) {
+	txn.upsertAccount(addr, true, func(object *StateObject) {
+		object.Account.Root = emptyStateHash
+		object.Txn = iradix.New().Txn()
+
+		for key, value := range storage {
+			if value != zeroHash {
+				object.Txn.Insert(key.Bytes(), value.Bytes())
+			}
+		}
+	})
+}
+
 // GetState returns the state of the address at a given key
 func (txn *Txn) GetState(addr types.Address, key types.Hash) types.Hash {
 	object, exists := txn.getStateObject(addr)
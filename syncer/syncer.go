@@ -8,6 +8,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/helper/progress"
 	"github.com/0xPolygon/polygon-edge/network/event"
 	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/armon/go-metrics"
 	"github.com/hashicorp/go-hclog"
 	"github.com/libp2p/go-libp2p/core/peer"
 )
@@ -15,6 +16,8 @@ import (
 const (
 	syncerName  = "syncer"
 	syncerProto = "/syncer/0.2"
+
+	syncerMetrics = "syncer"
 )
 
 var (
@@ -186,9 +189,13 @@ func (s *syncer) Sync(callback func(*types.Block) bool) error {
 			continue
 		}
 
+		metrics.SetGauge([]string{syncerMetrics, "target_block"}, float32(bestPeer.Number))
+
 		// fetch block from the peer
 		lastNumber, shouldTerminate, err := s.bulkSyncWithPeer(bestPeer.ID, callback)
 		if err != nil {
+			metrics.IncrCounter([]string{syncerMetrics, "failed_syncs"}, 1)
+
 			s.logger.Warn("failed to complete bulk sync with peer, try to next one", "peer ID", "error", bestPeer.ID, err)
 		}
 
@@ -249,6 +256,9 @@ func (s *syncer) bulkSyncWithPeer(peerID peer.ID, newBlockCallback func(*types.B
 			shouldTerminate = newBlockCallback(block)
 
 			lastReceivedNumber = block.Number()
+
+			metrics.SetGauge([]string{syncerMetrics, "current_block"}, float32(lastReceivedNumber))
+			metrics.IncrCounter([]string{syncerMetrics, "synced_blocks"}, 1)
 		case <-time.After(s.blockTimeout):
 			return lastReceivedNumber, shouldTerminate, errTimeout
 		}
@@ -133,7 +133,7 @@ type jsTracer struct {
 // The methods `result` and `fault` are required to be present.
 // The methods `step`, `enter`, and `exit` are optional, but note that
 // `enter` and `exit` always go together.
-func newJsTracer(code string, ctx *tracers.Context) (tracers.Tracer, error) {
+func newJsTracer(code string, ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error) {
 	if c, ok := assetTracers[code]; ok {
 		code = c
 	}
@@ -171,6 +171,16 @@ func newJsTracer(code string, ctx *tracers.Context) (tracers.Tracer, error) {
 	if !ok {
 		return nil, errors.New("trace object must expose a function fault()")
 	}
+	// Pass in tracer config
+	if setup, ok := goja.AssertFunction(obj.Get("setup")); ok {
+		cfgStr := "{}"
+		if cfg != nil {
+			cfgStr = string(cfg)
+		}
+		if _, err := setup(obj, vm.ToValue(cfgStr)); err != nil {
+			return nil, err
+		}
+	}
 	step, ok := goja.AssertFunction(obj.Get("step"))
 	t.traceStep = ok
 	enter, hasEnter := goja.AssertFunction(obj.Get("enter"))
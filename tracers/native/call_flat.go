@@ -0,0 +1,257 @@
+// Copyright 2023 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package native
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/state/runtime/precompiled"
+	"github.com/0xPolygon/polygon-edge/tracers"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func init() {
+	register("flatCallTracer", newFlatCallTracer)
+}
+
+// names of the call frame types, as reported by the callTracer
+var (
+	callType         = evm.OpCode(evm.CALL).String()
+	staticCallType   = evm.OpCode(evm.STATICCALL).String()
+	callCodeType     = evm.OpCode(evm.CALLCODE).String()
+	delegateCallType = evm.OpCode(evm.DELEGATECALL).String()
+	createType       = evm.OpCode(evm.CREATE).String()
+	create2Type      = evm.OpCode(evm.CREATE2).String()
+)
+
+// flatCallFrame is a standalone callframe in the parity format.
+type flatCallFrame struct {
+	Action              flatCallAction  `json:"action"`
+	BlockHash           *types.Hash     `json:"blockHash"`
+	Error               string          `json:"error,omitempty"`
+	Result              *flatCallResult `json:"result,omitempty"`
+	Subtraces           int             `json:"subtraces"`
+	TraceAddress        []int           `json:"traceAddress"`
+	TransactionHash     *types.Hash     `json:"transactionHash"`
+	TransactionPosition uint64          `json:"transactionPosition"`
+	Type                string          `json:"type"`
+}
+
+type flatCallAction struct {
+	CallType string `json:"callType,omitempty"`
+	From     string `json:"from"`
+	Gas      string `json:"gas"`
+	Init     string `json:"init,omitempty"`
+	Input    string `json:"input,omitempty"`
+	To       string `json:"to,omitempty"`
+	Value    string `json:"value,omitempty"`
+}
+
+type flatCallResult struct {
+	Address string `json:"address,omitempty"`
+	Code    string `json:"code,omitempty"`
+	GasUsed string `json:"gasUsed"`
+	Output  string `json:"output,omitempty"`
+}
+
+// flatCallTracer reports call frame information of a tx in a flat format, i.e.
+// as opposed to the nested format of `callTracer`.
+type flatCallTracer struct {
+	*callTracer
+	config      flatCallTracerConfig
+	ctx         *tracers.Context // Holds tracer context data
+	precompiles map[string]bool  // Hex addresses of the precompiled contracts
+}
+
+type flatCallTracerConfig struct {
+	IncludePrecompiles bool `json:"includePrecompiles"` // If true, calls to precompiles will be recorded
+}
+
+// newFlatCallTracer returns a new flatCallTracer.
+func newFlatCallTracer(ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error) {
+	var config flatCallTracerConfig
+	if cfg != nil {
+		if err := json.Unmarshal(cfg, &config); err != nil {
+			return nil, err
+		}
+	}
+	if ctx == nil {
+		ctx = new(tracers.Context)
+	}
+
+	precompiles := make(map[string]bool)
+	for _, addr := range precompiled.NewPrecompiled().Addresses() {
+		precompiles[addrToHex(addr)] = true
+	}
+
+	// The inner call tracer collects the nested call frames
+	tracer, err := newCallTracer(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &flatCallTracer{
+		callTracer:  tracer.(*callTracer),
+		config:      config,
+		ctx:         ctx,
+		precompiles: precompiles,
+	}, nil
+}
+
+// CaptureExit is called when EVM exits a scope, even if the scope didn't
+// execute any code. The calls to the precompiles are removed unless they are included
+// by the config, parity traces don't include them.
+func (t *flatCallTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	t.callTracer.CaptureExit(output, gasUsed, err)
+
+	if t.config.IncludePrecompiles {
+		return
+	}
+
+	// the call has been nested in the parent
+	parent := &t.callstack[len(t.callstack)-1]
+	if len(parent.Calls) == 0 {
+		return
+	}
+
+	call := parent.Calls[len(parent.Calls)-1]
+	if (call.Type == callType || call.Type == staticCallType) && t.precompiles[call.To] {
+		parent.Calls = parent.Calls[:len(parent.Calls)-1]
+	}
+}
+
+// GetResult returns the json-encoded list of the flattened call traces, and any
+// error arising from the encoding or forceful termination (via `Stop`).
+func (t *flatCallTracer) GetResult() (json.RawMessage, error) {
+	if len(t.callstack) != 1 {
+		return nil, errors.New("incorrect number of top-level calls")
+	}
+
+	flat, err := flatFromNested(&t.callstack[0], []int{}, t.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := json.Marshal(flat)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, t.reason
+}
+
+// flatFromNested flattens the call frame and its subcalls in the depth first order,
+// the trace address is the path of the frame in the call tree
+func flatFromNested(input *callFrame, traceAddress []int, ctx *tracers.Context) ([]flatCallFrame, error) {
+	var frame *flatCallFrame
+
+	switch {
+	case input.Type == createType || input.Type == create2Type:
+		frame = newFlatCreate(input)
+	case input.Type == callType || input.Type == staticCallType ||
+		input.Type == callCodeType || input.Type == delegateCallType:
+		frame = newFlatCall(input)
+	default:
+		return nil, fmt.Errorf("unrecognized call frame type: %s", input.Type)
+	}
+
+	frame.Error = input.Error
+	frame.Subtraces = len(input.Calls)
+	frame.TraceAddress = traceAddress
+	fillCallFrameFromContext(frame, ctx)
+
+	// nullify result on error
+	if input.Error != "" {
+		frame.Result = nil
+	}
+
+	output := []flatCallFrame{*frame}
+
+	for i := range input.Calls {
+		childAddr := childTraceAddress(traceAddress, i)
+
+		flat, err := flatFromNested(&input.Calls[i], childAddr, ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, flat...)
+	}
+
+	return output, nil
+}
+
+func newFlatCreate(input *callFrame) *flatCallFrame {
+	return &flatCallFrame{
+		Type: strings.ToLower(createType),
+		Action: flatCallAction{
+			From:  input.From,
+			Gas:   input.Gas,
+			Value: input.Value,
+			Init:  input.Input,
+		},
+		Result: &flatCallResult{
+			GasUsed: input.GasUsed,
+			Address: input.To,
+			Code:    input.Output,
+		},
+	}
+}
+
+func newFlatCall(input *callFrame) *flatCallFrame {
+	return &flatCallFrame{
+		Type: strings.ToLower(callType),
+		Action: flatCallAction{
+			From:     input.From,
+			To:       input.To,
+			Gas:      input.Gas,
+			Value:    input.Value,
+			CallType: strings.ToLower(input.Type),
+			Input:    input.Input,
+		},
+		Result: &flatCallResult{
+			Output:  input.Output,
+			GasUsed: input.GasUsed,
+		},
+	}
+}
+
+func fillCallFrameFromContext(callFrame *flatCallFrame, ctx *tracers.Context) {
+	if ctx.BlockHash != (types.Hash{}) {
+		blockHash := ctx.BlockHash
+		callFrame.BlockHash = &blockHash
+	}
+
+	if ctx.TxHash != (types.Hash{}) {
+		txHash := ctx.TxHash
+		callFrame.TransactionHash = &txHash
+	}
+
+	callFrame.TransactionPosition = uint64(ctx.TxIndex)
+}
+
+func childTraceAddress(a []int, i int) []int {
+	child := make([]int, 0, len(a)+1)
+	child = append(child, a...)
+	child = append(child, i)
+
+	return child
+}
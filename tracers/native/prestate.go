@@ -17,15 +17,16 @@
 package native
 
 import (
+	"bytes"
 	"encoding/json"
 	"math/big"
 	"sync/atomic"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/state"
-	"github.com/0xPolygon/polygon-edge/types/hexutil"
-
 	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
 	"github.com/0xPolygon/polygon-edge/tracers"
 	"github.com/0xPolygon/polygon-edge/types"
 )
@@ -36,93 +37,167 @@ func init() {
 
 type prestate = map[types.Address]*account
 type account struct {
-	Balance string                    `json:"balance"`
-	Nonce   uint64                    `json:"nonce"`
-	Code    string                    `json:"code"`
-	Storage map[types.Hash]types.Hash `json:"storage"`
+	Balance *big.Int
+	Code    []byte
+	Nonce   uint64
+	Storage map[types.Hash]types.Hash
+}
+
+func (a *account) exists() bool {
+	return a.Nonce > 0 || len(a.Code) > 0 || len(a.Storage) > 0 || (a.Balance != nil && a.Balance.Sign() != 0)
+}
+
+// MarshalJSON encodes the account with the hex encoded balance and code,
+// the empty fields are omitted.
+func (a *account) MarshalJSON() ([]byte, error) {
+	enc := struct {
+		Balance string                    `json:"balance,omitempty"`
+		Code    string                    `json:"code,omitempty"`
+		Nonce   uint64                    `json:"nonce,omitempty"`
+		Storage map[types.Hash]types.Hash `json:"storage,omitempty"`
+	}{
+		Balance: bigToHex(a.Balance),
+		Nonce:   a.Nonce,
+		Storage: a.Storage,
+	}
+	if len(a.Code) > 0 {
+		enc.Code = bytesToHex(a.Code)
+	}
+	return json.Marshal(enc)
 }
 
 type prestateTracer struct {
 	// env       *vm.EVM
 	txn       *state.Transition
-	prestate  prestate
+	pre       prestate
+	post      prestate
 	create    bool
 	to        types.Address
 	gasLimit  uint64 // Amount of gas bought for the whole tx
+	config    prestateTracerConfig
 	interrupt uint32 // Atomic flag to signal execution interruption
 	reason    error  // Textual reason for the interruption
+	created   map[types.Address]bool
+	deleted   map[types.Address]bool
+}
+
+type prestateTracerConfig struct {
+	DiffMode bool `json:"diffMode"` // If true, this tracer will return state modifications
 }
 
-func newPrestateTracer(ctx *tracers.Context) tracers.Tracer {
-	// First callframe contains tx context info
-	// and is populated on start and end.
-	return &prestateTracer{prestate: prestate{}}
+func newPrestateTracer(ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error) {
+	var config prestateTracerConfig
+	if cfg != nil {
+		if err := json.Unmarshal(cfg, &config); err != nil {
+			return nil, err
+		}
+	}
+	return &prestateTracer{
+		pre:     prestate{},
+		post:    prestate{},
+		config:  config,
+		created: make(map[types.Address]bool),
+		deleted: make(map[types.Address]bool),
+	}, nil
 }
 
 // CaptureStart implements the EVMLogger interface to initialize the tracing operation.
 func (t *prestateTracer) CaptureStart(txr interface{}, from types.Address, to types.Address, create bool, input []byte, gas uint64, value *big.Int) {
-	// t.env = env
-	_, ok := txr.(*state.Transition)
+	txn, ok := txr.(*state.Transition)
 	if !ok {
 		return
 	}
+	t.txn = txn
 	t.create = create
 	t.to = to
 
 	t.lookupAccount(from)
 	t.lookupAccount(to)
+	t.lookupAccount(txn.GetTxContext().Coinbase)
 
 	// The recipient balance includes the value transferred.
-	toBal := hexutil.MustDecodeBig(t.prestate[to].Balance)
-	toBal = new(big.Int).Sub(toBal, value)
-	t.prestate[to].Balance = hexutil.EncodeBig(toBal)
+	toBal := new(big.Int).Sub(t.pre[to].Balance, value)
+	t.pre[to].Balance = toBal
 
 	// The sender balance is after reducing: value and gasLimit.
 	// We need to re-add them to get the pre-tx balance.
-	fromBal := hexutil.MustDecodeBig(t.prestate[from].Balance)
-	// gasPrice := env.TxContext.GasPrice
-	gasPrice := new(big.Int)
+	fromBal := new(big.Int).Set(t.pre[from].Balance)
+	gasPrice := new(big.Int).SetBytes(txn.GetTxContext().GasPrice.Bytes())
 	consumedGas := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(t.gasLimit))
 	fromBal.Add(fromBal, new(big.Int).Add(value, consumedGas))
-	t.prestate[from].Balance = hexutil.EncodeBig(fromBal)
-	t.prestate[from].Nonce--
+	t.pre[from].Balance = fromBal
+	t.pre[from].Nonce--
+
+	if create {
+		// The created account has no code and nonce before the creation,
+		// it is initialized before the tracing is started
+		t.pre[to].Code = nil
+		t.pre[to].Nonce = 0
+
+		if t.config.DiffMode {
+			t.created[to] = true
+		}
+	}
 }
 
 // CaptureEnd is called after the call finishes to finalize the tracing.
 func (t *prestateTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) {
+	if t.config.DiffMode {
+		return
+	}
+
 	if t.create {
-		// Exclude created contract.
-		delete(t.prestate, t.to)
+		// Keep existing account prior to contract creation at that address
+		if s := t.pre[t.to]; s != nil && !s.exists() {
+			// Exclude newly created contract.
+			delete(t.pre, t.to)
+		}
 	}
 }
 
 // CaptureState implements the EVMLogger interface to trace a single step of VM execution.
 func (t *prestateTracer) CaptureState(pc uint64, op int, gas, cost uint64, scope *runtime.ScopeContext, rData []byte, depth int, err error) {
-	// stack := scope.Stack
-	// stackData := stack.Data()
-	// stackLen := len(stackData)
-	// switch {
-	// case stackLen >= 1 && (op == vm.SLOAD || op == vm.SSTORE):
-	// 	slot := types.Hash(stackData[stackLen-1].Bytes32())
-	// 	t.lookupStorage(scope.Contract.Address(), slot)
-	// case stackLen >= 1 && (op == vm.EXTCODECOPY || op == vm.EXTCODEHASH || op == vm.EXTCODESIZE || op == vm.BALANCE || op == vm.SELFDESTRUCT):
-	// 	addr := types.Address(stackData[stackLen-1].Bytes20())
-	// 	t.lookupAccount(addr)
-	// case stackLen >= 5 && (op == vm.DELEGATECALL || op == vm.CALL || op == vm.STATICCALL || op == vm.CALLCODE):
-	// 	addr := types.Address(stackData[stackLen-2].Bytes20())
-	// 	t.lookupAccount(addr)
-	// case op == vm.CREATE:
-	// 	addr := scope.Contract.Address()
-	// 	nonce := t.env.StateDB.GetNonce(addr)
-	// 	t.lookupAccount(crypto.CreateAddress(addr, nonce))
-	// case stackLen >= 4 && op == vm.CREATE2:
-	// 	offset := stackData[stackLen-2]
-	// 	size := stackData[stackLen-3]
-	// 	init := scope.Memory.GetCopy(int64(offset.Uint64()), int64(size.Uint64()))
-	// 	inithash := crypto.Keccak256(init)
-	// 	salt := stackData[stackLen-4]
-	// 	t.lookupAccount(crypto.CreateAddress2(scope.Contract.Address(), salt.Bytes32(), inithash))
-	// }
+	if err != nil || t.txn == nil {
+		return
+	}
+	// Skip if tracing was interrupted
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		return
+	}
+	stack := scope.Stack
+	stackData := stack.Data()
+	stackLen := len(stackData)
+	caller := scope.Contract.Address
+	switch opCode := evm.OpCode(op); {
+	case stackLen >= 1 && (opCode == evm.SLOAD || opCode == evm.SSTORE):
+		slot := types.Hash(stackData[stackLen-1].Bytes32())
+		t.lookupStorage(caller, slot)
+	case stackLen >= 1 && (opCode == evm.EXTCODECOPY || opCode == evm.EXTCODEHASH || opCode == evm.EXTCODESIZE || opCode == evm.BALANCE || opCode == evm.SELFDESTRUCT):
+		addr := types.Address(stackData[stackLen-1].Bytes20())
+		t.lookupAccount(addr)
+		if opCode == evm.SELFDESTRUCT {
+			t.deleted[caller] = true
+		}
+	case stackLen >= 5 && (opCode == evm.DELEGATECALL || opCode == evm.CALL || opCode == evm.STATICCALL || opCode == evm.CALLCODE):
+		addr := types.Address(stackData[stackLen-2].Bytes20())
+		t.lookupAccount(addr)
+	case opCode == evm.CREATE:
+		nonce := t.txn.GetNonce(caller)
+		addr := crypto.CreateAddress(caller, nonce)
+		t.lookupAccount(addr)
+		t.created[addr] = true
+	case stackLen >= 4 && opCode == evm.CREATE2:
+		offset := stackData[stackLen-2]
+		size := stackData[stackLen-3]
+		init, err := tracers.GetMemoryCopyPadded(scope.Memory, int64(offset.Uint64()), int64(size.Uint64()))
+		if err != nil {
+			return
+		}
+		salt := stackData[stackLen-4]
+		addr := crypto.CreateAddress2(caller, salt.Bytes32(), init)
+		t.lookupAccount(addr)
+		t.created[addr] = true
+	}
 }
 
 // CaptureFault implements the EVMLogger interface to trace an execution fault.
@@ -142,12 +217,87 @@ func (t *prestateTracer) CaptureTxStart(gasLimit uint64) {
 	t.gasLimit = gasLimit
 }
 
-func (t *prestateTracer) CaptureTxEnd(restGas uint64) {}
+// CaptureTxEnd computes the state modifications of the transaction in the diff mode,
+// the unmodified accounts and slots are removed from the prestate.
+func (t *prestateTracer) CaptureTxEnd(restGas uint64) {
+	if !t.config.DiffMode || t.txn == nil {
+		return
+	}
+
+	for addr, state := range t.pre {
+		// The deleted account's state is pruned from `post` but kept in `pre`
+		if _, ok := t.deleted[addr]; ok {
+			continue
+		}
+		modified := false
+		postAccount := &account{Storage: make(map[types.Hash]types.Hash)}
+		newBalance := t.txn.GetBalance(addr)
+		newNonce := t.txn.GetNonce(addr)
+		newCode := t.txn.GetCode(addr)
+
+		if newBalance.Cmp(t.pre[addr].Balance) != 0 {
+			modified = true
+			postAccount.Balance = newBalance
+		}
+		if newNonce != t.pre[addr].Nonce {
+			modified = true
+			postAccount.Nonce = newNonce
+		}
+		if !bytes.Equal(newCode, t.pre[addr].Code) {
+			modified = true
+			postAccount.Code = newCode
+		}
+
+		for key, val := range state.Storage {
+			// don't include the empty slot
+			if val == (types.Hash{}) {
+				delete(t.pre[addr].Storage, key)
+			}
+
+			newVal := t.txn.GetStorage(addr, key)
+			if val == newVal {
+				// Omit unchanged slots
+				delete(t.pre[addr].Storage, key)
+			} else {
+				modified = true
+				if newVal != (types.Hash{}) {
+					postAccount.Storage[key] = newVal
+				}
+			}
+		}
 
-// GetResult returns the json-encoded nested list of call traces, and any
-// error arising from the encoding or forceful termination (via `Stop`).
+		if modified {
+			t.post[addr] = postAccount
+		} else {
+			// if state is not modified, then no need to include into the pre state
+			delete(t.pre, addr)
+		}
+	}
+	// the new created contracts' prestate were empty, so delete them
+	for a := range t.created {
+		// the created contract maybe exists in statedb before the creating tx
+		if s := t.pre[a]; s != nil && !s.exists() {
+			delete(t.pre, a)
+		}
+	}
+}
+
+// GetResult returns the json-encoded prestate of the touched accounts,
+// or the prestate and the poststate of the modified accounts in the diff mode,
+// and any error arising from the encoding or forceful termination (via `Stop`).
 func (t *prestateTracer) GetResult() (json.RawMessage, error) {
-	res, err := json.Marshal(t.prestate)
+	var (
+		res []byte
+		err error
+	)
+	if t.config.DiffMode {
+		res, err = json.Marshal(struct {
+			Post prestate `json:"post"`
+			Pre  prestate `json:"pre"`
+		}{t.post, t.pre})
+	} else {
+		res, err = json.Marshal(t.pre)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -163,23 +313,23 @@ func (t *prestateTracer) Stop(err error) {
 // lookupAccount fetches details of an account and adds it to the prestate
 // if it doesn't exist there.
 func (t *prestateTracer) lookupAccount(addr types.Address) {
-	// if _, ok := t.prestate[addr]; ok {
-	// 	return
-	// }
-	// t.prestate[addr] = &account{
-	// 	Balance: bigToHex(t.env.StateDB.GetBalance(addr)),
-	// 	Nonce:   t.env.StateDB.GetNonce(addr),
-	// 	Code:    bytesToHex(t.env.StateDB.GetCode(addr)),
-	// 	Storage: make(map[types.Hash]types.Hash),
-	// }
+	if _, ok := t.pre[addr]; ok {
+		return
+	}
+	t.pre[addr] = &account{
+		Balance: new(big.Int).Set(t.txn.GetBalance(addr)),
+		Nonce:   t.txn.GetNonce(addr),
+		Code:    t.txn.GetCode(addr),
+		Storage: make(map[types.Hash]types.Hash),
+	}
 }
 
 // lookupStorage fetches the requested storage slot and adds
-// it to the prestate of the given contract. It assumes `lookupAccount`
-// has been performed on the contract before.
+// it to the prestate of the given contract.
 func (t *prestateTracer) lookupStorage(addr types.Address, key types.Hash) {
-	if _, ok := t.prestate[addr].Storage[key]; ok {
+	t.lookupAccount(addr)
+	if _, ok := t.pre[addr].Storage[key]; ok {
 		return
 	}
-	// t.prestate[addr].Storage[key] = t.env.StateDB.GetState(addr, key)
+	t.pre[addr].Storage[key] = t.txn.GetStorage(addr, key)
 }
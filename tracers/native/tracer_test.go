@@ -0,0 +1,323 @@
+package native
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/tracers"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	addr1 = types.StringToAddress("a1")
+	addr2 = types.StringToAddress("a2")
+	addr3 = types.StringToAddress("a3")
+
+	// precompiledAddr is the address of the sha256 precompiled contract
+	precompiledAddr = types.StringToAddress("2")
+)
+
+// captureCalls traces a call from addr1 to addr2 which calls the precompiled contract
+// and creates a contract
+func captureCalls(tracer tracers.Tracer) {
+	tracer.CaptureTxStart(100000)
+	tracer.CaptureStart(nil, addr1, addr2, false, []byte{0x1}, 100000, big.NewInt(0))
+
+	tracer.CaptureEnter(int(evm.STATICCALL), addr2, precompiledAddr, []byte{0x2}, 1000, big.NewInt(0))
+	tracer.CaptureExit([]byte{0x3}, 100, nil)
+
+	tracer.CaptureEnter(int(evm.CREATE), addr2, addr1, []byte{0x4}, 5000, big.NewInt(1))
+	tracer.CaptureExit([]byte{0x5}, 400, nil)
+
+	tracer.CaptureEnd([]byte{0x6}, 1000, 0, nil)
+	tracer.CaptureTxEnd(99000)
+}
+
+func TestMuxTracer(t *testing.T) {
+	t.Parallel()
+
+	tracer, err := tracers.New(
+		"muxTracer",
+		new(tracers.Context),
+		json.RawMessage(`{"callTracer": {}, "noopTracer": {}}`),
+	)
+	assert.NoError(t, err)
+
+	captureCalls(tracer)
+
+	res, err := tracer.GetResult()
+	assert.NoError(t, err)
+
+	var results map[string]json.RawMessage
+
+	assert.NoError(t, json.Unmarshal(res, &results))
+	assert.Len(t, results, 2)
+	assert.JSONEq(t, `{}`, string(results["noopTracer"]))
+
+	var call callFrame
+
+	assert.NoError(t, json.Unmarshal(results["callTracer"], &call))
+	assert.Equal(t, "CALL", call.Type)
+	assert.Len(t, call.Calls, 2)
+
+	_, err = tracers.New("muxTracer", new(tracers.Context), json.RawMessage(`{"unknown": {}}`))
+	assert.Error(t, err)
+}
+
+func TestFlatCallTracer(t *testing.T) {
+	t.Parallel()
+
+	ctx := &tracers.Context{
+		BlockHash: types.StringToHash("1"),
+		TxHash:    types.StringToHash("2"),
+		TxIndex:   3,
+	}
+
+	testTable := []struct {
+		name   string
+		config json.RawMessage
+		types  []string
+	}{
+		{
+			"excludes the calls to the precompiled contracts",
+			nil,
+			[]string{"call", "create"},
+		},
+		{
+			"includes the calls to the precompiled contracts",
+			json.RawMessage(`{"includePrecompiles": true}`),
+			[]string{"call", "call", "create"},
+		},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			tracer, err := tracers.New("flatCallTracer", ctx, testCase.config)
+			assert.NoError(t, err)
+
+			captureCalls(tracer)
+
+			res, err := tracer.GetResult()
+			assert.NoError(t, err)
+
+			var frames []flatCallFrame
+
+			assert.NoError(t, json.Unmarshal(res, &frames))
+			assert.Len(t, frames, len(testCase.types))
+
+			for i, frame := range frames {
+				assert.Equal(t, testCase.types[i], frame.Type)
+				assert.Equal(t, ctx.BlockHash, *frame.BlockHash)
+				assert.Equal(t, ctx.TxHash, *frame.TransactionHash)
+				assert.Equal(t, uint64(ctx.TxIndex), frame.TransactionPosition)
+			}
+
+			top := frames[0]
+			assert.Equal(t, len(testCase.types)-1, top.Subtraces)
+			assert.Equal(t, []int{}, top.TraceAddress)
+			assert.Equal(t, "call", top.Action.CallType)
+			assert.Equal(t, "0x06", top.Result.Output)
+
+			create := frames[len(frames)-1]
+			assert.Equal(t, []int{len(testCase.types) - 2}, create.TraceAddress)
+			assert.Equal(t, addrToHex(addr1), create.Result.Address)
+			assert.Equal(t, "0x04", create.Action.Init)
+			assert.Equal(t, "0x05", create.Result.Code)
+		})
+	}
+}
+
+// traceTransaction executes a transaction from addr1 to addr2 traced by the tracer,
+// addr2 stores 1 in the slot 0, emits a log and calls addr3 which emits a log too
+func traceTransaction(t *testing.T, tracer tracers.Tracer) {
+	t.Helper()
+
+	ex := state.NewExecutor(&chain.Params{
+		Forks: chain.AllForksEnabled,
+	}, itrie.NewState(itrie.NewMemoryStorage()), hclog.NewNullLogger())
+
+	rootHash := ex.WriteGenesis(map[types.Address]*chain.GenesisAccount{
+		addr1: {Balance: big.NewInt(1000000)},
+		addr2: {
+			Code: []byte{
+				0x60, 0x01, 0x60, 0x00, 0x55, // SSTORE(0, 1)
+				0x60, 0x2a, 0x60, 0x00, 0x60, 0x00, 0xa1, // LOG1(0, 0, 0x2a)
+				0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0xa3, 0x5a, 0xf1, // CALL(gas, addr3, 0, 0, 0, 0, 0)
+				0x50, 0x00, // POP, STOP
+			},
+			Storage: map[types.Hash]types.Hash{
+				types.ZeroHash: types.StringToHash("7"),
+			},
+		},
+		addr3: {
+			Code: []byte{
+				0x60, 0x00, 0x60, 0x00, 0xa0, // LOG0(0, 0)
+				0x00, // STOP
+			},
+		},
+	})
+	ex.GetHash = func(h *types.Header) state.GetHashByNumber {
+		return func(i uint64) types.Hash {
+			return rootHash
+		}
+	}
+
+	transition, err := ex.BeginTxn(rootHash, &types.Header{GasLimit: 1000000}, types.ZeroAddress)
+	assert.NoError(t, err)
+
+	transition.SetTracerConfig(runtime.TraceConfig{Debug: true, Tracer: tracer})
+
+	result, err := transition.Apply(&types.Transaction{
+		From:     addr1,
+		To:       &addr2,
+		Gas:      100000,
+		GasPrice: big.NewInt(1),
+		Value:    big.NewInt(0),
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, result.Err)
+}
+
+func TestCallTracer_Config(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name   string
+		config json.RawMessage
+		calls  int
+		logs   []int // the number of the logs of the top call and its subcalls
+	}{
+		{
+			"collects the subcalls without the logs by default",
+			nil,
+			1,
+			[]int{0, 0},
+		},
+		{
+			"collects the logs of the calls",
+			json.RawMessage(`{"withLog": true}`),
+			1,
+			[]int{1, 1},
+		},
+		{
+			"collects the top call only",
+			json.RawMessage(`{"withLog": true, "onlyTopCall": true}`),
+			0,
+			[]int{1},
+		},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			tracer, err := tracers.New("callTracer", new(tracers.Context), testCase.config)
+			assert.NoError(t, err)
+
+			traceTransaction(t, tracer)
+
+			res, err := tracer.GetResult()
+			assert.NoError(t, err)
+
+			var call callFrame
+
+			assert.NoError(t, json.Unmarshal(res, &call))
+			assert.Len(t, call.Calls, testCase.calls)
+			assert.Len(t, call.Logs, testCase.logs[0])
+
+			if testCase.logs[0] > 0 {
+				assert.Equal(t, addrToHex(addr2), call.Logs[0].Address)
+				assert.Equal(t, []types.Hash{types.StringToHash("2a")}, call.Logs[0].Topics)
+			}
+
+			for i, subcall := range call.Calls {
+				assert.Len(t, subcall.Logs, testCase.logs[i+1])
+			}
+		})
+	}
+}
+
+func TestPrestateTracer(t *testing.T) {
+	t.Parallel()
+
+	type tracedAccount struct {
+		Balance string                    `json:"balance"`
+		Nonce   uint64                    `json:"nonce"`
+		Storage map[types.Hash]types.Hash `json:"storage"`
+	}
+
+	t.Run("returns the prestate of the touched accounts", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, err := tracers.New("prestateTracer", new(tracers.Context), nil)
+		assert.NoError(t, err)
+
+		traceTransaction(t, tracer)
+
+		res, err := tracer.GetResult()
+		assert.NoError(t, err)
+
+		var pre map[types.Address]tracedAccount
+
+		assert.NoError(t, json.Unmarshal(res, &pre))
+		assert.Len(t, pre, 4) // the sender, the called contracts and the coinbase
+
+		assert.Equal(t, "0xf4240", pre[addr1].Balance)
+		assert.Equal(t, uint64(0), pre[addr1].Nonce)
+		assert.Equal(t, map[types.Hash]types.Hash{
+			types.ZeroHash: types.StringToHash("7"),
+		}, pre[addr2].Storage)
+	})
+
+	t.Run("returns the modified state in the diff mode", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, err := tracers.New("prestateTracer", new(tracers.Context), json.RawMessage(`{"diffMode": true}`))
+		assert.NoError(t, err)
+
+		traceTransaction(t, tracer)
+
+		res, err := tracer.GetResult()
+		assert.NoError(t, err)
+
+		var diff struct {
+			Pre  map[types.Address]tracedAccount `json:"pre"`
+			Post map[types.Address]tracedAccount `json:"post"`
+		}
+
+		assert.NoError(t, json.Unmarshal(res, &diff))
+
+		// addr3 is not modified
+		assert.Len(t, diff.Pre, 3)
+		assert.Len(t, diff.Post, 3)
+		assert.NotContains(t, diff.Pre, addr3)
+
+		assert.Equal(t, "0xf4240", diff.Pre[addr1].Balance)
+		assert.Equal(t, uint64(1), diff.Post[addr1].Nonce)
+
+		gasUsed, ok := new(big.Int).SetString(diff.Post[types.ZeroAddress].Balance[2:], 16)
+		assert.True(t, ok)
+		assert.Equal(t, bigToHex(new(big.Int).Sub(big.NewInt(1000000), gasUsed)), diff.Post[addr1].Balance)
+
+		assert.Equal(t, map[types.Hash]types.Hash{
+			types.ZeroHash: types.StringToHash("7"),
+		}, diff.Pre[addr2].Storage)
+		assert.Equal(t, map[types.Hash]types.Hash{
+			types.ZeroHash: types.StringToHash("1"),
+		}, diff.Post[addr2].Storage)
+	})
+}
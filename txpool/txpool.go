@@ -1,14 +1,18 @@
 package txpool
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/txpool/proto"
@@ -17,6 +21,7 @@ import (
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/hashicorp/go-hclog"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc"
 )
 
@@ -38,6 +43,8 @@ const (
 	txPoolMetrics = "txpool"
 )
 
+var otelTracer = tracing.Tracer("txpool")
+
 // errors
 var (
 	ErrIntrinsicGas            = errors.New("intrinsic gas too low")
@@ -55,6 +62,7 @@ var (
 	ErrMaxEnqueuedLimitReached = errors.New("maximum number of enqueued transactions reached")
 	ErrRejectFutureTx          = errors.New("rejected future tx due to low slots")
 	ErrSmartContractRestricted = errors.New("smart contract deployment restricted")
+	ErrTransactionRestricted   = errors.New("transaction sender restricted")
 )
 
 // indicates origin of a transaction
@@ -91,6 +99,28 @@ type signer interface {
 	Sender(tx *types.Transaction) (types.Address, error)
 }
 
+// chainParameters is the interface of the chain parameters governed on chain
+type chainParameters interface {
+	MinGasPrice(header *types.Header) (uint64, bool, error)
+	DeploymentWhitelist(header *types.Header) ([]types.Address, error)
+}
+
+// governedParams are the chain parameters governed on chain at a head
+type governedParams struct {
+	head types.Hash
+
+	// minGasPrice is 0 if not set
+	minGasPrice uint64
+	// deploymentWhitelist is empty if not governed
+	deploymentWhitelist deploymentWhitelist
+}
+
+// addressLists is the interface of the allow lists enforced in the state
+type addressLists interface {
+	IsContractDeployerAllowed(root types.Hash, addr types.Address) (bool, error)
+	IsTransactionSenderAllowed(root types.Hash, addr types.Address) (bool, error)
+}
+
 type Config struct {
 	PriceLimit          uint64
 	MaxSlots            uint64
@@ -179,6 +209,15 @@ type TxPool struct {
 	// deploymentWhitelist map
 	deploymentWhitelist deploymentWhitelist
 
+	// chain parameters governed on chain, nil if not enabled
+	chainParams chainParameters
+	// chain parameters read at the latest head, they are read once per head
+	governedParams     *governedParams
+	governedParamsLock sync.Mutex
+
+	// allow lists enforced in the state, nil if not enabled
+	addressLists addressLists
+
 	// indicates which txpool operator commands should be implemented
 	proto.UnimplementedTxnPoolOperatorServer
 
@@ -330,6 +369,16 @@ func (p *TxPool) SetSigner(s signer) {
 	p.signer = s
 }
 
+// SetChainParameters sets the chain parameters governed on chain
+func (p *TxPool) SetChainParameters(c chainParameters) {
+	p.chainParams = c
+}
+
+// SetAddressLists sets the allow lists enforced in the state
+func (p *TxPool) SetAddressLists(l addressLists) {
+	p.addressLists = l
+}
+
 // SetSealing sets the sealing flag
 func (p *TxPool) SetSealing(sealing bool) {
 	newValue := uint32(0)
@@ -601,6 +650,97 @@ func (p *TxPool) processEvent(event *blockchain.Event) {
 	}
 }
 
+// isDeploymentAllowed returns whether the address can deploy contracts.
+// The deployment whitelist governed on chain takes precedence over the one in the config
+func (p *TxPool) isDeploymentAllowed(addr types.Address) bool {
+	if params := p.getGovernedParams(); params != nil && len(params.deploymentWhitelist.addresses) > 0 {
+		return params.deploymentWhitelist.allowed(addr)
+	}
+
+	return p.deploymentWhitelist.allowed(addr)
+}
+
+// getGovernedParams returns the chain parameters governed on chain at the head,
+// they are read from the state once per head.
+// It returns nil if they are not enabled or can't be read
+func (p *TxPool) getGovernedParams() *governedParams {
+	if p.chainParams == nil {
+		return nil
+	}
+
+	header := p.store.Header()
+
+	p.governedParamsLock.Lock()
+	defer p.governedParamsLock.Unlock()
+
+	if p.governedParams != nil && p.governedParams.head == header.Hash {
+		return p.governedParams
+	}
+
+	minGasPrice, _, err := p.chainParams.MinGasPrice(header)
+	if err != nil {
+		p.logger.Error("failed to read min gas price from chain", "err", err)
+
+		return nil
+	}
+
+	whitelist, err := p.chainParams.DeploymentWhitelist(header)
+	if err != nil {
+		p.logger.Error("failed to read deployment whitelist from chain", "err", err)
+
+		return nil
+	}
+
+	p.governedParams = &governedParams{
+		head:                header.Hash,
+		minGasPrice:         minGasPrice,
+		deploymentWhitelist: newDeploymentWhitelist(whitelist),
+	}
+
+	return p.governedParams
+}
+
+// checkAddressLists returns error if the sender is not allowed by the allow lists in the state,
+// such transaction would make the block invalid
+func (p *TxPool) checkAddressLists(root types.Hash, tx *types.Transaction) error {
+	if p.addressLists == nil {
+		return nil
+	}
+
+	allowed, err := p.addressLists.IsTransactionSenderAllowed(root, tx.From)
+	if err != nil {
+		return ErrInvalidAccountState
+	}
+
+	if !allowed {
+		return ErrTransactionRestricted
+	}
+
+	if !tx.IsContractCreation() {
+		return nil
+	}
+
+	if allowed, err = p.addressLists.IsContractDeployerAllowed(root, tx.From); err != nil {
+		return ErrInvalidAccountState
+	}
+
+	if !allowed {
+		return ErrSmartContractRestricted
+	}
+
+	return nil
+}
+
+// getPriceLimit returns the lower threshold for gas price,
+// the minimum gas price governed on chain raises the one in the config
+func (p *TxPool) getPriceLimit() uint64 {
+	if params := p.getGovernedParams(); params != nil {
+		return common.Max(p.priceLimit, params.minGasPrice)
+	}
+
+	return p.priceLimit
+}
+
 // validateTx ensures the transaction conforms to specific
 // constraints before entering the pool.
 func (p *TxPool) validateTx(tx *types.Transaction) error {
@@ -635,18 +775,23 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 	}
 
 	// Check if transaction can deploy smart contract
-	if tx.IsContractCreation() && !p.deploymentWhitelist.allowed(tx.From) {
+	if tx.IsContractCreation() && !p.isDeploymentAllowed(tx.From) {
 		return ErrSmartContractRestricted
 	}
 
 	// Reject underpriced transactions
-	if tx.IsUnderpriced(p.priceLimit) {
+	if tx.IsUnderpriced(p.getPriceLimit()) {
 		return ErrUnderpriced
 	}
 
 	// Grab the state root for the latest block
 	stateRoot := p.store.Header().StateRoot
 
+	// Check if the sender is allowed by the allow lists
+	if err := p.checkAddressLists(stateRoot, tx); err != nil {
+		return err
+	}
+
 	// Check nonce ordering
 	if p.store.GetNonce(stateRoot, tx.From) > tx.Nonce {
 		return ErrNonceTooLow
@@ -721,12 +866,22 @@ func (p *TxPool) pruneAccountsWithNonceHoles() {
 // for all new transactions. If the call is
 // successful, an account is created for this address
 // (only once) and an enqueueRequest is signaled.
-func (p *TxPool) addTx(origin txOrigin, tx *types.Transaction) error {
+func (p *TxPool) addTx(origin txOrigin, tx *types.Transaction) (err error) {
 	p.logger.Debug("add tx",
 		"origin", origin.String(),
 		"hash", tx.Hash.String(),
 	)
 
+	_, span := otelTracer.Start(context.Background(), "TxPool.addTx")
+	span.SetAttributes(
+		tracing.TxHash(tx.Hash),
+		attribute.String("tx.origin", origin.String()),
+	)
+
+	defer func() {
+		tracing.EndSpan(span, err)
+	}()
+
 	// validate incoming tx
 	if err := p.validateTx(tx); err != nil {
 		return err
@@ -806,10 +961,18 @@ func (p *TxPool) handlePromoteRequest(req promoteRequest) {
 	addr := req.account
 	account := p.accounts.get(addr)
 
+	_, span := otelTracer.Start(context.Background(), "TxPool.promote")
+	defer span.End()
+
 	// promote enqueued txs
 	promoted, pruned := account.promote()
 	p.logger.Debug("promote request", "promoted", promoted, "addr", addr.String())
 
+	span.SetAttributes(
+		attribute.String("account", addr.String()),
+		tracing.TxHashes(promoted),
+	)
+
 	p.index.remove(pruned...)
 	p.gauge.decrease(slotsRequired(pruned...))
 
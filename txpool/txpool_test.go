@@ -6,6 +6,7 @@ import (
 	"crypto/rand"
 	"fmt"
 	"math/big"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -65,6 +66,37 @@ func newTx(addr types.Address, nonce, slots uint64) *types.Transaction {
 	}
 }
 
+type mockChainParameters struct {
+	minGasPrice uint64
+	whitelist   []types.Address
+
+	// reads is the number of the reads of the state
+	reads uint64
+}
+
+func (m *mockChainParameters) MinGasPrice(*types.Header) (uint64, bool, error) {
+	atomic.AddUint64(&m.reads, 1)
+
+	return m.minGasPrice, m.minGasPrice != 0, nil
+}
+
+func (m *mockChainParameters) DeploymentWhitelist(*types.Header) ([]types.Address, error) {
+	return m.whitelist, nil
+}
+
+type mockAddressLists struct {
+	deployers map[types.Address]bool
+	senders   map[types.Address]bool
+}
+
+func (m *mockAddressLists) IsContractDeployerAllowed(_ types.Hash, addr types.Address) (bool, error) {
+	return m.deployers == nil || m.deployers[addr], nil
+}
+
+func (m *mockAddressLists) IsTransactionSenderAllowed(_ types.Hash, addr types.Address) (bool, error) {
+	return m.senders == nil || m.senders[addr], nil
+}
+
 // returns a new txpool with default test config
 func newTestPool(mockStore ...store) (*TxPool, error) {
 	return newTestPoolWithSlots(defaultMaxSlots, mockStore...)
@@ -207,6 +239,40 @@ func TestAddTxErrors(t *testing.T) {
 		)
 	})
 
+	t.Run("ErrUnderpriced by min gas price governed on chain", func(t *testing.T) {
+		t.Parallel()
+		pool := setupPool()
+		pool.SetChainParameters(&mockChainParameters{
+			minGasPrice: 1000000,
+		})
+
+		tx := newTx(defaultAddr, 0, 1) // gasPrice == 1
+		tx = signTx(tx)
+
+		assert.ErrorIs(t,
+			pool.addTx(local, tx),
+			ErrUnderpriced,
+		)
+	})
+
+	t.Run("governed parameters are read once per head", func(t *testing.T) {
+		t.Parallel()
+		pool := setupPool()
+		params := &mockChainParameters{
+			minGasPrice: 1000000,
+		}
+		pool.SetChainParameters(params)
+
+		for i := 0; i < 3; i++ {
+			assert.ErrorIs(t,
+				pool.validateTx(signTx(newTx(defaultAddr, 0, 1))),
+				ErrUnderpriced,
+			)
+		}
+
+		assert.Equal(t, uint64(1), atomic.LoadUint64(&params.reads))
+	})
+
 	t.Run("ErrInvalidAccountState", func(t *testing.T) {
 		t.Parallel()
 		pool := setupPool()
@@ -1711,6 +1777,73 @@ func TestPermissionSmartContractDeployment(t *testing.T) {
 			ErrSmartContractRestricted,
 		)
 	})
+	t.Run("Governed whitelist takes precedence over the one in the config", func(t *testing.T) {
+		t.Parallel()
+		pool := setupPool()
+		pool.deploymentWhitelist.add(defaultAddr)
+		pool.SetChainParameters(&mockChainParameters{
+			whitelist: []types.Address{addr1},
+		})
+
+		tx := newTx(defaultAddr, 0, 1)
+		tx.To = nil
+
+		assert.ErrorIs(t,
+			pool.validateTx(signTx(tx)),
+			ErrSmartContractRestricted,
+		)
+	})
+	t.Run("Whitelist in the config is used if not governed", func(t *testing.T) {
+		t.Parallel()
+		pool := setupPool()
+		pool.deploymentWhitelist.add(defaultAddr)
+		pool.SetChainParameters(&mockChainParameters{})
+
+		tx := newTx(defaultAddr, 0, 1)
+		tx.To = nil
+
+		assert.NoError(t, pool.validateTx(signTx(tx)))
+	})
+	t.Run("Deployer not allowed by the allow list in the state", func(t *testing.T) {
+		t.Parallel()
+		pool := setupPool()
+		pool.SetAddressLists(&mockAddressLists{
+			deployers: map[types.Address]bool{addr1: true},
+		})
+
+		tx := newTx(defaultAddr, 0, 1)
+		tx.To = nil
+
+		assert.ErrorIs(t,
+			pool.validateTx(signTx(tx)),
+			ErrSmartContractRestricted,
+		)
+	})
+	t.Run("Sender not allowed by the allow list in the state", func(t *testing.T) {
+		t.Parallel()
+		pool := setupPool()
+		pool.SetAddressLists(&mockAddressLists{
+			senders: map[types.Address]bool{addr1: true},
+		})
+
+		assert.ErrorIs(t,
+			pool.validateTx(signTx(newTx(defaultAddr, 0, 1))),
+			ErrTransactionRestricted,
+		)
+	})
+	t.Run("Sender allowed by the allow lists in the state", func(t *testing.T) {
+		t.Parallel()
+		pool := setupPool()
+		pool.SetAddressLists(&mockAddressLists{
+			deployers: map[types.Address]bool{defaultAddr: true},
+			senders:   map[types.Address]bool{defaultAddr: true},
+		})
+
+		tx := newTx(defaultAddr, 0, 1)
+		tx.To = nil
+
+		assert.NoError(t, pool.validateTx(signTx(tx)))
+	})
 }
 
 /* "Integrated" tests */
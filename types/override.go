@@ -0,0 +1,51 @@
+package types
+
+import (
+	"math/big"
+)
+
+// OverrideAccount is the fields of an account overridden before a call is executed,
+// the nil fields are left unchanged
+type OverrideAccount struct {
+	Nonce     *uint64
+	Code      []byte
+	Balance   *big.Int
+	State     map[Hash]Hash // replaces the whole storage of the account
+	StateDiff map[Hash]Hash // replaces the given storage slots of the account
+}
+
+// StateOverride is the set of the accounts overridden before a call is executed
+type StateOverride map[Address]OverrideAccount
+
+// BlockOverrides is the fields of the block header overridden before a call is executed,
+// the nil fields are left unchanged
+type BlockOverrides struct {
+	Number    *uint64
+	Timestamp *uint64
+	Coinbase  *Address // replaces the block creator the fees are paid to
+	GasLimit  *uint64
+}
+
+// Apply returns the copy of the header with the fields overridden,
+// the coinbase is not a field of the header and is left to the caller
+func (o *BlockOverrides) Apply(header *Header) *Header {
+	header = header.Copy()
+
+	if o == nil {
+		return header
+	}
+
+	if o.Number != nil {
+		header.Number = *o.Number
+	}
+
+	if o.Timestamp != nil {
+		header.Timestamp = *o.Timestamp
+	}
+
+	if o.GasLimit != nil {
+		header.GasLimit = *o.GasLimit
+	}
+
+	return header
+}
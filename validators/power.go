@@ -0,0 +1,30 @@
+package validators
+
+import (
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// VotingPowers is the map of the voting power by validator address
+type VotingPowers map[types.Address]*big.Int
+
+// Get returns the voting power of the validator, zero if unknown
+func (p VotingPowers) Get(addr types.Address) *big.Int {
+	if power, ok := p[addr]; ok && power != nil {
+		return power
+	}
+
+	return big.NewInt(0)
+}
+
+// Total returns the sum of the voting powers of the validators in the set
+func (p VotingPowers) Total(set Validators) *big.Int {
+	total := big.NewInt(0)
+
+	for idx := 0; idx < set.Len(); idx++ {
+		total.Add(total, p.Get(set.At(uint64(idx)).Addr()))
+	}
+
+	return total
+}
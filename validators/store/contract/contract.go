@@ -21,6 +21,7 @@ const (
 var (
 	ErrSignerNotFound                 = errors.New("signer not found")
 	ErrInvalidValidatorsTypeAssertion = errors.New("invalid type assertion for Validators")
+	ErrInvalidVotingPowersAssertion   = errors.New("invalid type assertion for VotingPowers")
 )
 
 type ContractValidatorStore struct {
@@ -30,6 +31,9 @@ type ContractValidatorStore struct {
 
 	// LRU cache for the validators
 	validatorSetCache *lru.Cache
+
+	// LRU cache for the voting powers of the validators
+	votingPowersCache *lru.Cache
 }
 
 type Executor interface {
@@ -44,6 +48,7 @@ func NewContractValidatorStore(
 ) (*ContractValidatorStore, error) {
 	var (
 		validatorsCache *lru.Cache
+		powersCache     *lru.Cache
 		err             error
 	)
 
@@ -51,6 +56,10 @@ func NewContractValidatorStore(
 		if validatorsCache, err = lru.New(validatorSetCacheSize); err != nil {
 			return nil, fmt.Errorf("unable to create validator set cache, %w", err)
 		}
+
+		if powersCache, err = lru.New(validatorSetCacheSize); err != nil {
+			return nil, fmt.Errorf("unable to create voting powers cache, %w", err)
+		}
 	}
 
 	return &ContractValidatorStore{
@@ -58,6 +67,7 @@ func NewContractValidatorStore(
 		blockchain:        blockchain,
 		executor:          executor,
 		validatorSetCache: validatorsCache,
+		votingPowersCache: powersCache,
 	}, nil
 }
 
@@ -93,6 +103,41 @@ func (s *ContractValidatorStore) GetValidatorsByHeight(
 	return fetchedValidators, nil
 }
 
+// GetVotingPowersByHeight returns the voting powers of the validators at the given height,
+// the voting power of a validator is the amount it has staked in the contract
+func (s *ContractValidatorStore) GetVotingPowersByHeight(
+	validatorType validators.ValidatorType,
+	height uint64,
+) (validators.VotingPowers, error) {
+	cachedPowers, err := s.loadCachedVotingPowers(height)
+	if err != nil {
+		return nil, err
+	}
+
+	if cachedPowers != nil {
+		return cachedPowers, nil
+	}
+
+	validatorSet, err := s.GetValidatorsByHeight(validatorType, height)
+	if err != nil {
+		return nil, err
+	}
+
+	transition, err := s.getTransitionForQuery(height)
+	if err != nil {
+		return nil, err
+	}
+
+	fetchedPowers, err := FetchVotingPowers(transition, types.ZeroAddress, validatorSet)
+	if err != nil {
+		return nil, err
+	}
+
+	s.saveToVotingPowersCache(height, fetchedPowers)
+
+	return fetchedPowers, nil
+}
+
 func (s *ContractValidatorStore) getTransitionForQuery(height uint64) (*state.Transition, error) {
 	header, ok := s.blockchain.GetHeaderByNumber(height)
 	if !ok {
@@ -125,3 +170,31 @@ func (s *ContractValidatorStore) saveToValidatorSetCache(height uint64, validato
 
 	return s.validatorSetCache.Add(height, validators)
 }
+
+// loadCachedVotingPowers loads voting powers from votingPowersCache
+func (s *ContractValidatorStore) loadCachedVotingPowers(height uint64) (validators.VotingPowers, error) {
+	if s.votingPowersCache == nil {
+		return nil, nil
+	}
+
+	cachedRawPowers, ok := s.votingPowersCache.Get(height)
+	if !ok {
+		return nil, nil
+	}
+
+	powers, ok := cachedRawPowers.(validators.VotingPowers)
+	if !ok {
+		return nil, ErrInvalidVotingPowersAssertion
+	}
+
+	return powers, nil
+}
+
+// saveToVotingPowersCache saves voting powers to votingPowersCache
+func (s *ContractValidatorStore) saveToVotingPowersCache(height uint64, powers validators.VotingPowers) bool {
+	if s.votingPowersCache == nil {
+		return false
+	}
+
+	return s.votingPowersCache.Add(height, powers)
+}
@@ -176,6 +176,7 @@ func TestNewContractValidatorStore(t *testing.T) {
 				blockchain:        blockchain,
 				executor:          executor,
 				validatorSetCache: newTestCache(t, 1),
+				votingPowersCache: newTestCache(t, 1),
 			},
 			expectedErr: nil,
 		},
@@ -84,3 +84,25 @@ func FetchBLSValidators(
 
 	return blsValidators, nil
 }
+
+// FetchVotingPowers queries a contract for the stake of the validators and returns their voting powers
+func FetchVotingPowers(
+	transition *state.Transition,
+	from types.Address,
+	set validators.Validators,
+) (validators.VotingPowers, error) {
+	powers := make(validators.VotingPowers, set.Len())
+
+	for idx := 0; idx < set.Len(); idx++ {
+		addr := set.At(uint64(idx)).Addr()
+
+		stake, err := staking.QueryAccountStake(transition, from, addr)
+		if err != nil {
+			return nil, err
+		}
+
+		powers[addr] = stake
+	}
+
+	return powers, nil
+}
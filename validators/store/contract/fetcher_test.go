@@ -3,8 +3,10 @@ package contract
 import (
 	"errors"
 	"fmt"
+	"math/big"
 	"testing"
 
+	stakingHelper "github.com/0xPolygon/polygon-edge/helper/staking"
 	testHelper "github.com/0xPolygon/polygon-edge/helper/tests"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/types"
@@ -140,3 +142,61 @@ func TestFetchBLSValidators(t *testing.T) {
 		})
 	}
 }
+
+func TestFetchVotingPowers(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ecdsaValidators = validators.NewECDSAValidatorSet(
+			validators.NewECDSAValidator(addr1),
+			validators.NewECDSAValidator(addr2),
+		)
+
+		defaultStake, _ = new(big.Int).SetString(stakingHelper.DefaultStakedBalance[2:], 16)
+	)
+
+	tests := []struct {
+		name        string
+		transition  *state.Transition
+		expectedRes validators.VotingPowers
+		expectedErr error
+	}{
+		{
+			name: "should return error if QueryAccountStake failed",
+			transition: newTestTransition(
+				t,
+			),
+			expectedRes: nil,
+			expectedErr: errors.New("empty input"),
+		},
+		{
+			name: "should return the stakes of the validators",
+			transition: newTestTransitionWithPredeployedStakingContract(
+				t,
+				ecdsaValidators,
+			),
+			expectedRes: validators.VotingPowers{
+				addr1: defaultStake,
+				addr2: defaultStake,
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := FetchVotingPowers(
+				test.transition,
+				types.ZeroAddress,
+				ecdsaValidators,
+			)
+
+			assert.Equal(t, test.expectedRes, res)
+			testHelper.AssertErrorMessageContains(t, test.expectedErr, err)
+		})
+	}
+}